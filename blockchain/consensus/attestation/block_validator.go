@@ -13,7 +13,6 @@ import (
 	"github.com/raidoNetwork/RDO_v2/shared/types"
 	"github.com/raidoNetwork/RDO_v2/utils/hash"
 	"github.com/raidoNetwork/RDO_v2/utils/serialize"
-	vtypes "github.com/raidoNetwork/RDO_v2/validator/types"
 )
 
 var (
@@ -109,7 +108,7 @@ func (cv *CryspValidator) ValidateBlock(block *prototype.Block, countSign bool)
 
 	// Validate size of the block
 	// We are only counting transactions' size (except the fee transactions)
-	size := getTransactionsSize(block)
+	size := types.BlockTxSize(block)
 	if size > cv.cfg.BlockSize {
 		return nil, errors.Errorf("The block size is: %d, exceeds: %d", block.SizeSSZ(), cv.cfg.BlockSize)
 	}
@@ -132,7 +131,7 @@ func (cv *CryspValidator) ValidateBlock(block *prototype.Block, countSign bool)
 		return nil, err
 	}
 
-	blockHash := hash.BlockHash(block.Num, block.Slot, block.Version, block.Parent, block.Txroot, block.Timestamp, block.Proposer.Address)
+	blockHash := hash.BlockHash(block.Num, block.Slot, block.Version, block.Parent, block.Txroot, block.Seed, types.CheckpointVotesRoot(block.CheckpointVotes), block.Timestamp, block.Proposer.Address)
 	if !bytes.Equal(blockHash, block.Hash) {
 		return nil, errors.Errorf("Bad block hash given. Expected: %s. Given: %s", common.Encode(blockHash), common.Encode(block.Hash))
 	}
@@ -204,16 +203,31 @@ func (cv *CryspValidator) ValidateBlock(block *prototype.Block, countSign bool)
 		return nil, errors.Errorf("ValidateBlock: Too big block num difference. Need: 1. Current: %d", blockNumDiff)
 	}
 
-	if countSign {
-		approversCount := cv.countValidSigns(block, block.Approvers, vtypes.Approve)
-		slashersCount := cv.countValidSigns(block, block.Slashers, vtypes.Reject)
+	err = cv.validateBlockSeed(block, prevBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "Block seed error")
+	}
 
-		err = consensus.IsEnoughVotes(approversCount, slashersCount)
+	err = cv.validateBaseFee(block, prevBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cv.validateTxOrder(block)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cv.validateCheckpointVotes(block, prevBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "Checkpoint votes error")
+	}
+
+	if countSign {
+		err = cv.validateVotes(block, prevBlock)
 		if err != nil {
-			return nil, errors.Wrap(err, "Block voting error")
+			return nil, err
 		}
-
-		log.Infof("Approvers %d, slashers %d", approversCount, slashersCount)
 	}
 
 	failedTx, err := cv.verifyTransactions(block)
@@ -225,13 +239,73 @@ func (cv *CryspValidator) ValidateBlock(block *prototype.Block, countSign bool)
 		return failedTx, errors.Wrap(err, "There are failed transactions in the block")
 	}
 
+	if countSign {
+		cv.reportMissedReveals(block, prevBlock)
+	}
+
 	return nil, nil
 }
 
+// ValidateForkBlock validates block of the side branch with given parent. Only data independent of the chain state
+// is checked: header, hash, proposer signature and votes. Transactions are validated when branch becomes canonical.
+func (cv *CryspValidator) ValidateForkBlock(block, parent *prototype.Block) error {
+	if block.Num != parent.Num+1 {
+		return errors.Errorf("Wrong fork block num. Expected: %d. Given: %d.", parent.Num+1, block.Num)
+	}
+
+	if block.Slot <= parent.Slot {
+		return errors.Errorf("Wrong fork block slot %d. Parent slot: %d.", block.Slot, parent.Slot)
+	}
+
+	if parent.Timestamp >= block.Timestamp {
+		return errors.Errorf("Timestamp is too small. Previous: %d. Current: %d.", parent.Timestamp, block.Timestamp)
+	}
+
+	err := cv.validateBlockHeader(block)
+	if err != nil {
+		return err
+	}
+
+	blockHash := hash.BlockHash(block.Num, block.Slot, block.Version, block.Parent, block.Txroot, block.Seed, types.CheckpointVotesRoot(block.CheckpointVotes), block.Timestamp, block.Proposer.Address)
+	if !bytes.Equal(blockHash, block.Hash) {
+		return errors.Errorf("Bad block hash given. Expected: %s. Given: %s", common.Encode(blockHash), common.Encode(block.Hash))
+	}
+
+	err = cv.verifyBlockSign(block, block.Proposer)
+	if err != nil {
+		return errors.New("Wrong block proposer signature")
+	}
+
+	err = cv.validateBaseFee(block, parent)
+	if err != nil {
+		return err
+	}
+
+	err = cv.validateTxOrder(block)
+	if err != nil {
+		return err
+	}
+
+	err = cv.validateCheckpointVotes(block, parent)
+	if err != nil {
+		return errors.Wrap(err, "Checkpoint votes error")
+	}
+
+	return cv.validateVotes(block, parent)
+}
+
 func (cv *CryspValidator) verifyTransactions(block *prototype.Block) ([]*types.Transaction, error) {
 	failedTx := make([]*types.Transaction, 0)
 	rewardRecord := make(map[string]uint64)
+	slashed := make(map[string]struct{})
 	standardTxCount := 0
+
+	// successful transactions of the block senders in the nonce order
+	var senderTxs map[string][]*types.Transaction
+	if types.SenderNoncesEnabled(block.Num) {
+		senderTxs = make(map[string][]*types.Transaction)
+	}
+
 	for _, txpb := range block.Transactions {
 		tx := types.NewTransaction(txpb)
 
@@ -251,6 +325,9 @@ func (cv *CryspValidator) verifyTransactions(block *prototype.Block) ([]*types.T
 			case common.ValidatorsUnstakeTxType:
 				err = cv.validateSystemUnstakeTx(tx, block)
 				txType = "SystemUnstakeTx"
+			case common.SlashTxType:
+				err = cv.validateSlashTx(tx, block, slashed)
+				txType = "SlashTx"
 			}
 
 			if err != nil {
@@ -265,18 +342,23 @@ func (cv *CryspValidator) verifyTransactions(block *prototype.Block) ([]*types.T
 		if err != nil {
 			log.Error(err)
 			failedTx = append(failedTx, tx)
-			tx.SetStatus(types.TxFailed)
+			tx.SetFailed(err)
 			continue
 		}
 
-		err = cv.ValidateTransaction(tx)
+		sender := tx.From().Hex()
+		err = cv.ValidateNextTransaction(tx, senderTxs[sender])
 		if err != nil {
 			log.Error(err)
 			failedTx = append(failedTx, tx)
-			tx.SetStatus(types.TxFailed)
+			tx.SetFailed(err)
 			continue
 		}
 
+		if senderTxs != nil {
+			senderTxs[sender] = append(senderTxs[sender], tx)
+		}
+
 		tx.SetStatus(types.TxSuccess)
 	}
 
@@ -294,28 +376,22 @@ func (cv *CryspValidator) verifyBlockSign(block *prototype.Block, sign *prototyp
 	return types.GetBlockSigner().Verify(header, sign)
 }
 
-func (cv *CryspValidator) countValidSigns(block *prototype.Block, signatures []*prototype.Sign, attestationType vtypes.AttestationType) int {
-	validCount := 0
-	header := types.NewHeader(block)
-	for _, sign := range signatures {
-		err := vtypes.VerifyBlockSign(header, attestationType, sign)
-		if err != nil {
-			continue
-		}
-
-		validCount += 1
+// validateBaseFee checks block base fee is derived from the parent and all block transactions pay it
+func (cv *CryspValidator) validateBaseFee(block, parent *prototype.Block) error {
+	expected := types.NextBaseFee(parent)
+	if block.BaseFee != expected {
+		return errors.Errorf("Wrong block base fee. Expected: %d. Given: %d.", expected, block.BaseFee)
 	}
 
-	return validCount
-}
+	for _, tx := range block.Transactions {
+		if common.IsSystemTx(tx) {
+			continue
+		}
 
-// Summing all transactoins' size and returning the result
-func getTransactionsSize(block *prototype.Block) int {
-	var total int
-	for _, tx := range block.GetTransactions() {
-		if tx.Type != common.FeeTxType {
-			total += tx.SizeSSZ()
+		if tx.Fee < block.BaseFee {
+			return errors.Errorf("Transaction %s fee price %d is lower than the base fee %d.", common.Encode(tx.Hash), tx.Fee, block.BaseFee)
 		}
 	}
-	return total
+
+	return nil
 }
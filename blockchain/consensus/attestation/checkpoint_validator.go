@@ -0,0 +1,124 @@
+package attestation
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+// maxCheckpoints is the maximum count of cached block checkpoints
+const maxCheckpoints = 1024
+
+// EpochCheckpoint returns checkpoint of the given block epoch, which is the first block of the epoch in the block branch
+func (cv *CryspValidator) EpochCheckpoint(block *prototype.Block) (*types.Checkpoint, error) {
+	cv.mu.Lock()
+	defer cv.mu.Unlock()
+
+	epoch := types.EpochOf(block.Slot)
+
+	var checkpoint *types.Checkpoint
+	first := block
+	for {
+		if cached, exists := cv.checkpoints[common.Encode(first.Hash)]; exists {
+			checkpoint = cached
+			break
+		}
+
+		if first.Num == 0 {
+			break
+		}
+
+		prev, err := cv.bc.GetBlockByHash(first.Parent)
+		if err != nil {
+			return nil, err
+		}
+
+		if prev == nil {
+			return nil, errors.Errorf("Not found block %s for the epoch %d checkpoint", common.Encode(first.Parent), epoch)
+		}
+
+		if prev.Num == 0 || types.EpochOf(prev.Slot) != epoch {
+			break
+		}
+
+		first = prev
+	}
+
+	if checkpoint == nil {
+		checkpoint = &types.Checkpoint{
+			Epoch: epoch,
+			Num:   first.Num,
+			Hash:  first.Hash,
+		}
+	}
+
+	if len(cv.checkpoints) >= maxCheckpoints {
+		cv.checkpoints = map[string]*types.Checkpoint{}
+	}
+	cv.checkpoints[common.Encode(block.Hash)] = checkpoint
+
+	return checkpoint, nil
+}
+
+// ValidateCheckpointVote checks vote signature of the active validator and link to the given target checkpoint
+func (cv *CryspValidator) ValidateCheckpointVote(vote *prototype.CheckpointVote, target *types.Checkpoint) error {
+	if vote.Voter == nil {
+		return errors.New("Checkpoint vote has no signature")
+	}
+
+	voter := common.BytesToAddress(vote.Voter.Address).Hex()
+	if vote.TargetEpoch != target.Epoch || !bytes.Equal(vote.TargetHash, target.Hash) {
+		return errors.Errorf("Wrong checkpoint vote target of %s. Expected: %d %s. Given: %d %s.", voter, target.Epoch, common.Encode(target.Hash), vote.TargetEpoch, common.Encode(vote.TargetHash))
+	}
+
+	if vote.SourceEpoch >= vote.TargetEpoch {
+		return errors.Errorf("Checkpoint vote source epoch %d of %s is not before the target", vote.SourceEpoch, voter)
+	}
+
+	if !cv.stakeValidator.IsActiveValidator(voter, target.Epoch) {
+		return errors.Errorf("Checkpoint voter %s is not an active validator", voter)
+	}
+
+	err := types.VerifyCheckpointVote(vote)
+	if err != nil {
+		return errors.Wrapf(err, "Bad checkpoint vote signature of %s", voter)
+	}
+
+	return nil
+}
+
+// validateCheckpointVotes checks that block votes are given for the checkpoint of the block epoch by different validators.
+// Votes are included only after the checkpoint block, so the block of the previous epoch can't be the parent.
+func (cv *CryspValidator) validateCheckpointVotes(block, parent *prototype.Block) error {
+	if len(block.CheckpointVotes) == 0 {
+		return nil
+	}
+
+	if parent.Num == 0 || types.EpochOf(parent.Slot) != types.EpochOf(block.Slot) {
+		return errors.Errorf("Block #%d includes checkpoint votes before the epoch checkpoint", block.Num)
+	}
+
+	target, err := cv.EpochCheckpoint(parent)
+	if err != nil {
+		return err
+	}
+
+	voters := make(map[string]struct{}, len(block.CheckpointVotes))
+	for _, vote := range block.CheckpointVotes {
+		err := cv.ValidateCheckpointVote(vote, target)
+		if err != nil {
+			return err
+		}
+
+		voter := common.BytesToAddress(vote.Voter.Address).Hex()
+		if _, exists := voters[voter]; exists {
+			return errors.Errorf("Duplicate checkpoint vote of %s", voter)
+		}
+		voters[voter] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package attestation
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/blockchain/consensus"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	vtypes "github.com/raidoNetwork/RDO_v2/validator/types"
+)
+
+// maxCommitteeMixes is the maximum count of cached epoch mixes
+const maxCommitteeMixes = 1024
+
+// Committee returns validators committee voting for the block with given parent and slot.
+// Committee is sampled once per epoch with the seed mix of the last block before the epoch.
+func (cv *CryspValidator) Committee(parent *prototype.Block, slot uint64) ([]string, error) {
+	epoch := types.EpochOf(slot)
+
+	mix, err := cv.epochMix(parent, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return cv.stakeValidator.Committee(types.CommitteeSeed(mix, epoch), params.RaidoConfig().CommitteeSize, epoch), nil
+}
+
+// epochMix finds seed mix of the last block before the epoch walking back from the given block
+func (cv *CryspValidator) epochMix(parent *prototype.Block, epoch uint64) ([]byte, error) {
+	cv.mu.Lock()
+	defer cv.mu.Unlock()
+
+	var mix []byte
+
+	block := parent
+	for {
+		if types.EpochOf(block.Slot) < epoch || block.Num == 0 {
+			mix = types.BlockSeed(block)
+			break
+		}
+
+		if cached, exists := cv.committeeMixes[mixKey(block.Parent, epoch)]; exists {
+			mix = cached
+			break
+		}
+
+		prev, err := cv.bc.GetBlockByHash(block.Parent)
+		if err != nil {
+			return nil, err
+		}
+
+		if prev == nil {
+			return nil, errors.Errorf("Not found block %s for the epoch %d committee", common.Encode(block.Parent), epoch)
+		}
+
+		block = prev
+	}
+
+	if len(cv.committeeMixes) >= maxCommitteeMixes {
+		cv.committeeMixes = map[string][]byte{}
+	}
+	cv.committeeMixes[mixKey(parent.Hash, epoch)] = mix
+
+	return mix, nil
+}
+
+// countCommitteeSigns counts valid signatures of the unique committee members
+func (cv *CryspValidator) countCommitteeSigns(block *prototype.Block, signatures []*prototype.Sign, attestationType vtypes.AttestationType, committee []string) int {
+	members := make(map[string]bool, len(committee))
+	for _, validator := range committee {
+		members[validator] = false
+	}
+
+	validCount := 0
+	header := types.NewHeader(block)
+	for _, sign := range signatures {
+		validator := common.BytesToAddress(sign.Address).Hex()
+		if voted, exists := members[validator]; !exists || voted {
+			continue
+		}
+
+		err := vtypes.VerifyBlockSign(header, attestationType, sign)
+		if err != nil {
+			continue
+		}
+
+		members[validator] = true
+		validCount += 1
+	}
+
+	return validCount
+}
+
+// countValidSigns counts valid signatures of the block votes listed before the committee activation
+func (cv *CryspValidator) countValidSigns(block *prototype.Block, signatures []*prototype.Sign, attestationType vtypes.AttestationType) int {
+	validCount := 0
+	header := types.NewHeader(block)
+	for _, sign := range signatures {
+		err := vtypes.VerifyBlockSign(header, attestationType, sign)
+		if err != nil {
+			continue
+		}
+
+		validCount += 1
+	}
+
+	return validCount
+}
+
+// validateLegacyVotes checks votes of the block produced before the committee activation
+func (cv *CryspValidator) validateLegacyVotes(block *prototype.Block) error {
+	approversCount := cv.countValidSigns(block, block.Approvers, vtypes.Approve)
+	slashersCount := cv.countValidSigns(block, block.Slashers, vtypes.Reject)
+
+	err := consensus.IsEnoughVotes(approversCount, slashersCount, params.RaidoConfig().CommitteeSize)
+	if err != nil {
+		return errors.Wrap(err, "Block voting error")
+	}
+
+	log.Infof("Approvers %d, slashers %d", approversCount, slashersCount)
+
+	return nil
+}
+
+// validateVotes checks that the committee of the block slot voted for the block.
+// Blocks before COMMITTEE_BLOCK keep the legacy rule with votes of any validators.
+func (cv *CryspValidator) validateVotes(block, parent *prototype.Block) error {
+	if !types.CommitteeEnabled(block.Num) {
+		return cv.validateLegacyVotes(block)
+	}
+
+	committee, err := cv.Committee(parent, block.Slot)
+	if err != nil {
+		return errors.Wrap(err, "Committee error")
+	}
+
+	approvers, err := vtypes.BlockSigns(block, committee, vtypes.Approve)
+	if err != nil {
+		return errors.Wrap(err, "Bad block approvals")
+	}
+
+	slashers, err := vtypes.BlockSigns(block, committee, vtypes.Reject)
+	if err != nil {
+		return errors.Wrap(err, "Bad block rejections")
+	}
+
+	approversCount := cv.countCommitteeSigns(block, approvers, vtypes.Approve, committee)
+	slashersCount := cv.countCommitteeSigns(block, slashers, vtypes.Reject, committee)
+
+	err = consensus.IsEnoughVotes(approversCount, slashersCount, len(committee))
+	if err != nil {
+		return errors.Wrap(err, "Block voting error")
+	}
+
+	log.Infof("Approvers %d, slashers %d, committee %d", approversCount, slashersCount, len(committee))
+
+	return nil
+}
+
+func mixKey(hash []byte, epoch uint64) string {
+	return common.Encode(hash) + "_" + strconv.FormatUint(epoch, 10)
+}
@@ -3,6 +3,7 @@ package attestation
 import (
 	"bytes"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -41,6 +42,7 @@ type CryspValidatorConfig struct {
 	EnableMetrics          bool          // EnableMetrics enables time statistic log entries.
 	ValidatorRegistryLimit int           // ValidatorRegistryLimit defines validator slots count
 	BlockSize              int           // BlockSize defines the maximum size of the block
+	LegacyTxDeadline       uint64        // LegacyTxDeadline defines block number until which legacy transactions are accepted
 }
 
 func NewCryspValidator(bc consensus.BlockchainReader, stakeValidator consensus.StakePool, cfg *CryspValidatorConfig) *CryspValidator {
@@ -48,6 +50,8 @@ func NewCryspValidator(bc consensus.BlockchainReader, stakeValidator consensus.S
 		bc:             bc,
 		stakeValidator: stakeValidator,
 		cfg:            cfg,
+		committeeMixes: map[string][]byte{},
+		checkpoints:    map[string]*types.Checkpoint{},
 	}
 
 	return &v
@@ -57,13 +61,31 @@ type CryspValidator struct {
 	bc             consensus.BlockchainReader
 	stakeValidator consensus.StakePool
 	cfg            *CryspValidatorConfig
+
+	// epoch seed mixes by parent hash and epoch
+	committeeMixes map[string][]byte
+
+	// epoch checkpoints by block hash
+	checkpoints map[string]*types.Checkpoint
+	mu          sync.Mutex
 }
 
 // ValidateTransaction validate transaction and return an error if something is wrong
 func (cv *CryspValidator) ValidateTransaction(tx *types.Transaction) error {
+	return cv.ValidateNextTransaction(tx, nil)
+}
+
+// ValidateNextTransaction validates transaction following the given sender transactions ordered by nonce.
+// Transaction nonce should follow the last given transaction and inputs spent by them can't be spent again.
+func (cv *CryspValidator) ValidateNextTransaction(tx *types.Transaction, prev []*types.Transaction) error {
+	err := cv.checkValidityWindow(tx)
+	if err != nil {
+		return err
+	}
+
 	switch tx.Type() {
 	case common.UnstakeTxType:
-		return cv.validateUnstakeTx(tx)
+		return cv.validateUnstakeTx(tx, prev)
 	case common.StakeTxType:
 		st, err := cv.checkStakeType(tx)
 		if err != nil {
@@ -76,7 +98,7 @@ func (cv *CryspValidator) ValidateTransaction(tx *types.Transaction) error {
 
 		fallthrough
 	case common.NormalTxType:
-		return cv.validateTxInputs(tx)
+		return cv.validateTxInputs(tx, prev)
 	default:
 		return consensus.ErrBadTxType
 	}
@@ -108,8 +130,13 @@ func (cv *CryspValidator) ValidateTransactionStruct(tx *types.Transaction) error
 		return consensus.ErrSmallFee
 	}
 
+	err := cv.checkVersion(tx)
+	if err != nil {
+		return err
+	}
+
 	// check tx hash
-	err := cv.checkHash(tx)
+	err = cv.checkHash(tx)
 	if err != nil {
 		return err
 	}
@@ -132,10 +159,38 @@ func (cv *CryspValidator) ValidateTransactionStruct(tx *types.Transaction) error
 	return nil
 }
 
+// checkVersion rejects transactions with unknown version and legacy transactions after the migration deadline
+func (cv *CryspValidator) checkVersion(tx *types.Transaction) error {
+	if tx.Version() > types.CurrentTxVersion {
+		return consensus.ErrTxVersion
+	}
+
+	if tx.Version() == types.LegacyTxVersion && cv.bc.GetBlockCount() > cv.cfg.LegacyTxDeadline {
+		return consensus.ErrLegacyTx
+	}
+
+	return nil
+}
+
+// checkValidityWindow rejects transactions that can't be included in the next block
+func (cv *CryspValidator) checkValidityWindow(tx *types.Transaction) error {
+	num := cv.bc.GetBlockCount()
+	if tx.Expired(num) {
+		return consensus.ErrTxExpired
+	}
+
+	if !tx.ValidAt(num) {
+		return consensus.ErrTxNotValidYet
+	}
+
+	return nil
+}
+
 // validateTxInputs check that address has given inputs and enough balance.
 // If given normal transaction (send coins from one user to another)
 // makes sure that all address inputs are spent in this transaction.
-func (cv *CryspValidator) validateTxInputs(tx *types.Transaction) error {
+// Nonce and inputs of the given previous sender transactions are counted as already forged.
+func (cv *CryspValidator) validateTxInputs(tx *types.Transaction, prev []*types.Transaction) error {
 	if tx.Num() == 0 {
 		return consensus.ErrBadNonce
 	}
@@ -144,9 +199,15 @@ func (cv *CryspValidator) validateTxInputs(tx *types.Transaction) error {
 	from := tx.From()
 
 	// get address nonce
-	nonce, err := cv.bc.GetTransactionsCount(from.Bytes())
-	if err != nil {
-		return err
+	var nonce uint64
+	var err error
+	if len(prev) > 0 {
+		nonce = prev[len(prev)-1].Num()
+	} else {
+		nonce, err = cv.bc.GetTransactionsCount(from.Bytes())
+		if err != nil {
+			return err
+		}
 	}
 
 	if tx.Num() != nonce+1 {
@@ -169,15 +230,26 @@ func (cv *CryspValidator) validateTxInputs(tx *types.Transaction) error {
 	// create spentOutputs map
 	spentOutputsMap := map[string]*types.Input{}
 
-	// count balance and create spent map
-	var balance uint64
+	// create spent map
 	for _, uo := range utxo {
 		input := uo.ToInput()
 		inputKey := serialize.GenKeyFromInput(input)
 
 		// fill map with outputs from db
 		spentOutputsMap[inputKey] = input
-		balance += uo.Amount
+	}
+
+	// outputs spent by the previous sender transactions
+	for _, ptx := range prev {
+		for _, in := range ptx.Inputs() {
+			delete(spentOutputsMap, serialize.GenKeyFromInput(in))
+		}
+	}
+
+	// count balance
+	var balance uint64
+	for _, input := range spentOutputsMap {
+		balance += input.Amount()
 	}
 
 	// if balance is equal to zero try to create new transaction
@@ -316,23 +388,25 @@ func (cv *CryspValidator) validateFeeTx(tx *types.Transaction, block *prototype.
 		return errors.Errorf("Transaction has wrong type: %d.", tx.Type())
 	}
 
-	if len(tx.Outputs()) != 1 {
-		return errors.New("Wrong tx fee outputs size.")
-	}
-
 	// fee tx num should be equal to the block num
 	if tx.Num() != block.Num {
 		return errors.New("Wrong tx fee num.")
 	}
 
-	// check fee tx amount
-	var amount uint64 = 0
-	for _, pbtx := range block.Transactions {
-		amount += pbtx.GetRealFee()
+	// base fee is burned and tips are paid to the proposer
+	expected := types.FeeOutputs(block.Transactions, block.Num, block.BaseFee, block.Proposer.Address)
+	if len(expected) != len(tx.Outputs()) {
+		return errors.New("Wrong tx fee outputs size.")
 	}
 
-	if amount != tx.Outputs()[0].Amount() {
-		return errors.New("Wrong tx fee amount.")
+	for i, out := range tx.Outputs() {
+		if !bytes.Equal(out.Address(), expected[i].Address) || len(out.Node()) != 0 {
+			return errors.New("Wrong tx fee receiver.")
+		}
+
+		if out.Amount() != expected[i].Amount {
+			return errors.New("Wrong tx fee amount.")
+		}
 	}
 
 	return nil
@@ -579,7 +653,7 @@ func (cv *CryspValidator) checkHash(tx *types.Transaction) error {
 }
 
 // validateUnstakeTx check unstake tx
-func (cv *CryspValidator) validateUnstakeTx(tx *types.Transaction) error {
+func (cv *CryspValidator) validateUnstakeTx(tx *types.Transaction, prev []*types.Transaction) error {
 	stakeNode := ""
 	for _, in := range tx.Inputs() {
 		node := in.Node().Hex()
@@ -597,7 +671,7 @@ func (cv *CryspValidator) validateUnstakeTx(tx *types.Transaction) error {
 		}
 	}
 
-	return cv.validateTxInputs(tx)
+	return cv.validateTxInputs(tx, prev)
 }
 
 // checkSystemUnstakeTx verifies SystemUnstake transactions
@@ -0,0 +1,115 @@
+package attestation
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	"github.com/raidoNetwork/RDO_v2/utils/serialize"
+	vtypes "github.com/raidoNetwork/RDO_v2/validator/types"
+)
+
+var ErrAlreadySlashed = errors.New("Validator is already slashed for the given slot")
+
+// ValidateEvidence checks that slashing evidence can be included in the block with given slot
+func (cv *CryspValidator) ValidateEvidence(evidence *prototype.SlashingEvidence, slot uint64) error {
+	err := vtypes.VerifyEvidence(evidence)
+	if err != nil {
+		return err
+	}
+
+	offender := types.EvidenceOffender(evidence)
+	// exited validators are punished while they have the stake
+	if !cv.stakeValidator.IsActiveValidator(offender.Hex(), types.EpochOf(slot)) && !cv.stakeValidator.HasValidator(offender.Hex()) {
+		return errors.Errorf("Offender %s is not a validator", offender.Hex())
+	}
+
+	evidenceSlot := evidence.First.Slot
+	if evidenceSlot > slot {
+		return errors.Errorf("Evidence slot %d is greater than block slot %d", evidenceSlot, slot)
+	}
+
+	if types.IsEvidenceExpired(evidenceSlot, slot) {
+		return errors.Errorf("Evidence for slot %d is expired", evidenceSlot)
+	}
+
+	slashed, err := cv.bc.IsSlashed(offender, evidenceSlot)
+	if err != nil {
+		return err
+	}
+
+	if slashed {
+		return ErrAlreadySlashed
+	}
+
+	return nil
+}
+
+// validateSlashTx checks that slash transaction has valid evidence, spends all validator stake deposits of the offender
+// and burns the penalty according to the network config.
+func (cv *CryspValidator) validateSlashTx(tx *types.Transaction, block *prototype.Block, slashed map[string]struct{}) error {
+	// slash tx num should be equal to the block num
+	if tx.Num() != block.Num {
+		return errors.New("Wrong slash tx num.")
+	}
+
+	if tx.Fee() != 0 {
+		return errors.New("Slash tx should have zero fee.")
+	}
+
+	evidence := new(prototype.SlashingEvidence)
+	err := evidence.UnmarshalSSZ(tx.GetTx().Data)
+	if err != nil {
+		return errors.Wrap(err, "Bad slashing evidence")
+	}
+
+	err = cv.ValidateEvidence(evidence, block.Slot)
+	if err != nil {
+		return err
+	}
+
+	key := types.EvidenceKey(evidence)
+	if _, exists := slashed[key]; exists {
+		return errors.Errorf("Offence %s is already slashed in the block", key)
+	}
+	slashed[key] = struct{}{}
+
+	offender := types.EvidenceOffender(evidence)
+	deposits, err := cv.bc.FindStakeDepositsOfAddress(offender.Hex(), common.BlackHoleAddress)
+	if err != nil {
+		return err
+	}
+
+	if len(deposits) == 0 || len(tx.Inputs()) != len(deposits) {
+		return errors.New("Slash tx should spend all validator deposits")
+	}
+
+	var deposit uint64
+	spentOutputsMap := map[string]*types.Input{}
+	for _, uo := range deposits {
+		input := uo.ToInput()
+		spentOutputsMap[serialize.GenKeyFromInput(input)] = input
+		deposit += uo.Amount
+	}
+
+	_, err = cv.checkInputsData(tx, spentOutputsMap)
+	if err != nil {
+		log.Errorf("validateSlashTx: Error checking inputs: %s.", err)
+		return err
+	}
+
+	expected := types.SlashOutputs(offender, deposit)
+	if len(expected) != len(tx.Outputs()) {
+		return errors.New("Wrong slash tx outputs size.")
+	}
+
+	for i, out := range tx.Outputs() {
+		if !bytes.Equal(out.Address(), expected[i].Address) || !bytes.Equal(out.Node(), expected[i].Node) || out.Amount() != expected[i].Amount {
+			return errors.Errorf("Wrong slash tx output %d.", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package attestation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	missedRevealsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "seed_missed_reveals_total",
+		Help: "Count of seed commitments that were not revealed in the next block",
+	}, []string{"validator"})
+)
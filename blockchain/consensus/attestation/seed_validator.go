@@ -0,0 +1,140 @@
+package attestation
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+// validateBlockSeed checks block reveals and randomness mix against the parent block
+// and verifies that block proposer was chosen with the parent mix. Blocks before SeedBlock are not checked.
+func (cv *CryspValidator) validateBlockSeed(block, parent *prototype.Block) error {
+	if !types.SeedEnabled(block.Num) {
+		return nil
+	}
+
+	parentSeed := types.BlockSeed(parent)
+
+	err := cv.validateProposer(block, parentSeed)
+	if err != nil {
+		return err
+	}
+
+	err = cv.validateReveals(parent, block.Slot, block.Reveals)
+	if err != nil {
+		return err
+	}
+
+	mix := types.SeedMix(parentSeed, block.Slot, block.Reveals)
+	if !bytes.Equal(mix, block.Seed) {
+		return errors.Errorf("Seed mix mismatch. Expected: %s. Given: %s.", common.Encode(mix), common.Encode(block.Seed))
+	}
+
+	return nil
+}
+
+// validateProposer checks that block proposer is ranked for the block slot with the parent mix
+// and block timestamp is in the proposer time window. Synced blocks can be checked only with the timestamp,
+// committee members check live blocks with the local arrival time before the approval.
+func (cv *CryspValidator) validateProposer(block *prototype.Block, parentSeed []byte) error {
+	ranking := cv.stakeValidator.RankProposers(types.ProposerSeed(parentSeed, block.Slot), types.EpochOf(block.Slot), types.ProposerRanks())
+	if len(ranking) == 0 {
+		return errors.Errorf("No proposers for slot %d", block.Slot)
+	}
+
+	blockProposer := common.BytesToAddress(block.Proposer.Address).Hex()
+	rank := -1
+	for i, proposer := range ranking {
+		if proposer == blockProposer {
+			rank = i
+			break
+		}
+	}
+
+	if rank < 0 {
+		return errors.Errorf("Wrong block proposer for slot %d. Expected: %s. Given: %s.", block.Slot, ranking[0], blockProposer)
+	}
+
+	from, to := types.ProposerWindow(cv.bc.GetGenesis().Timestamp, block.Slot, rank)
+	if block.Timestamp < from || block.Timestamp >= to {
+		return errors.Errorf("Block of the slot %d proposer with rank %d is out of the time window. Timestamp: %d. Window: %d - %d.", block.Slot, rank, block.Timestamp, from, to)
+	}
+
+	return nil
+}
+
+// reportMissedReveals reports validators which parent commitments expired because the slot after the parent was skipped.
+// Such validators are excluded from the mix by the reveals rules.
+func (cv *CryspValidator) reportMissedReveals(block, parent *prototype.Block) {
+	for _, validator := range types.ExpiredCommitments(parent, block.Slot) {
+		log.Warnf("Validator %s didn't reveal seed for block #%d", validator, block.Num)
+		missedRevealsCounter.WithLabelValues(validator).Inc()
+	}
+}
+
+// validateReveals checks that reveals are ordered by validator address, belong to the given slot
+// and open all commitments of the parent block, so the proposer can't choose the mix by omitting reveals.
+func (cv *CryspValidator) validateReveals(parent *prototype.Block, slot uint64, reveals []*prototype.Seed) error {
+	commitments := types.RevealCommitments(parent, slot)
+
+	var prevAddr []byte
+	for _, seed := range reveals {
+		err := cv.ValidateSeed(seed, parent.Num+1, slot)
+		if err != nil {
+			return err
+		}
+
+		if prevAddr != nil && bytes.Compare(prevAddr, seed.Proposer.Address) >= 0 {
+			return errors.New("Reveals are not ordered by validator address")
+		}
+		prevAddr = seed.Proposer.Address
+
+		err = types.CheckReveal(seed, commitments)
+		if err != nil {
+			return errors.Wrapf(err, "Bad reveal of %s", common.BytesToAddress(seed.Proposer.Address).Hex())
+		}
+	}
+
+	missed := types.MissedReveals(commitments, reveals)
+	if len(missed) > 0 {
+		return errors.Errorf("Block misses reveals of %s", strings.Join(missed, ", "))
+	}
+
+	return nil
+}
+
+// ValidateSeed checks signature of the seed revealed in the block with given num and binding to the given slot
+func (cv *CryspValidator) ValidateSeed(seed *prototype.Seed, num, slot uint64) error {
+	if seed.Proposer == nil {
+		return errors.New("Seed has no signature")
+	}
+
+	validator := common.BytesToAddress(seed.Proposer.Address).Hex()
+	if !cv.stakeValidator.IsActiveValidator(validator, types.EpochOf(slot)) {
+		return errors.Errorf("Seed sender %s is not an active validator", validator)
+	}
+
+	if seed.Slot != slot {
+		return errors.Errorf("Wrong seed slot. Expected: %d. Given: %d.", slot, seed.Slot)
+	}
+
+	if seed.Epoch != slot/params.RaidoConfig().SlotsPerEpoch {
+		return errors.Errorf("Wrong seed epoch %d for slot %d", seed.Epoch, slot)
+	}
+
+	if len(seed.Commitment) != types.SeedSecretSize || len(seed.Reveal) != types.SeedSecretSize {
+		return errors.New("Wrong seed commitment or reveal size")
+	}
+
+	err := types.GetSeedSigner().Verify(seed, num)
+	if err != nil {
+		return errors.Wrapf(err, "Bad seed signature of %s", validator)
+	}
+
+	return nil
+}
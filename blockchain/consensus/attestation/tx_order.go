@@ -0,0 +1,56 @@
+package attestation
+
+import (
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/blockchain/consensus"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+)
+
+// txOrderGroups defines the canonical position of the transaction types in the block:
+// rewards, user transactions, collapses, validator unstakes, slashes and the fee transaction.
+// Transactions of the same group can go in any order.
+var txOrderGroups = map[uint32]int{
+	common.RewardTxType:            0,
+	common.NormalTxType:            1,
+	common.StakeTxType:             1,
+	common.UnstakeTxType:           1,
+	common.CollapseTxType:          2,
+	common.ValidatorsUnstakeTxType: 3,
+	common.SlashTxType:             4,
+	common.FeeTxType:               5,
+}
+
+// validateTxOrder checks that block transactions follow the canonical order and the block has at most one fee transaction.
+// Fee and reward transactions are not required: forger omits the fee transaction when the block collects no fee,
+// and rewards are split into several transactions by the outputs limit or missed when there are no stakers.
+// Their amounts are checked by the transactions verification.
+func (cv *CryspValidator) validateTxOrder(block *prototype.Block) error {
+	if block.Num < params.RaidoConfig().TxOrderBlock {
+		return nil
+	}
+
+	var prev *prototype.Transaction
+	for i, tx := range block.Transactions {
+		group, exists := txOrderGroups[tx.Type]
+		if !exists {
+			return errors.Wrapf(consensus.ErrTxOrder, "Transaction %s at index %d has type %d not allowed in the block", common.Encode(tx.Hash), i, tx.Type)
+		}
+
+		if prev != nil {
+			prevGroup := txOrderGroups[prev.Type]
+			if group < prevGroup {
+				return errors.Wrapf(consensus.ErrTxOrder, "Transaction %s of type %d at index %d follows transaction of type %d", common.Encode(tx.Hash), tx.Type, i, prev.Type)
+			}
+
+			if tx.Type == common.FeeTxType && prev.Type == common.FeeTxType {
+				return errors.Wrap(consensus.ErrTxOrder, "Block has more than one fee transaction")
+			}
+		}
+
+		prev = tx
+	}
+
+	return nil
+}
@@ -1,55 +1,50 @@
 package pos
 
 import (
-	"sort"
-	"sync"
-
 	"github.com/raidoNetwork/RDO_v2/blockchain/consensus"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
-	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
 )
 
 type Config struct {
 	AttestationPool *consensus.AttestationPool
 }
 
+// Backend takes validators from the stake pool validator set
 type Backend struct {
-	validators []common.Address
-	att        *consensus.AttestationPool
-	mu         sync.Mutex
+	att *consensus.AttestationPool
 }
 
 func New(cfg Config) *Backend {
-	config := params.ConsensusConfig()
-	validators := make([]common.Address, 0, len(config.Proposers))
-
-	sort.Strings(config.Proposers)
-
-	for _, proposer := range config.Proposers {
-		validators = append(validators, common.HexToAddress(proposer))
-	}
 	backend := Backend{
-		validators: validators,
-		att:        cfg.AttestationPool,
+		att: cfg.AttestationPool,
 	}
 	return &backend
 }
 
-func (b *Backend) IsLeader(address common.Address, seed int64) bool {
-	proposer := (*b.att).StakePool().DetermineProposer(seed)
+func (b *Backend) IsLeader(address common.Address, seed int64, epoch uint64) bool {
+	proposer := (*b.att).StakePool().DetermineProposer(seed, epoch)
 	hexAddress := address.Hex()
 	return hexAddress == proposer
 }
 
-func (b *Backend) IsValidator(address common.Address) bool {
-	b.mu.Lock()
-	defer b.mu.Unlock()
-	hex := address.Hex()
-	for _, addr := range b.validators {
-		if hex == addr.Hex() {
-			return true
+// ProposerRank returns rank of the address in the slot proposers ranking or -1 if address can't propose in the slot
+func (b *Backend) ProposerRank(address common.Address, seed int64, epoch uint64) int {
+	ranking := (*b.att).StakePool().RankProposers(seed, epoch, types.ProposerRanks())
+	hexAddress := address.Hex()
+	for rank, proposer := range ranking {
+		if proposer == hexAddress {
+			return rank
 		}
 	}
 
-	return false
+	return -1
+}
+
+// IsValidator returns true if address has validator stake or is in the active validator set.
+// Zero epoch resolves to the last processed validator set.
+func (b *Backend) IsValidator(address common.Address) bool {
+	pool := (*b.att).StakePool()
+	hex := address.Hex()
+	return pool.HasValidator(hex) || pool.IsActiveValidator(hex, 0)
 }
@@ -3,7 +3,6 @@ package consensus
 import (
 	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
-	"github.com/raidoNetwork/RDO_v2/shared/params"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -16,13 +15,20 @@ type PoA interface {
 }
 
 type PoS interface {
-	IsLeader(address common.Address, seed int64) bool
+	IsLeader(address common.Address, seed int64, epoch uint64) bool
+
+	ProposerRank(address common.Address, seed int64, epoch uint64) int
 
 	IsValidator(address common.Address) bool
 }
 
-func IsEnoughVotes(approvers, slashers int) error {
-	commiteeSize := float64(params.RaidoConfig().CommitteeSize)
+// IsEnoughVotes checks that committee of the given size voted for the block
+func IsEnoughVotes(approvers, slashers, committee int) error {
+	if committee == 0 {
+		return errors.New("Empty validators committee")
+	}
+
+	commiteeSize := float64(committee)
 	votedCount := approvers + slashers
 	votedPercent := float64(votedCount) / commiteeSize * 100
 
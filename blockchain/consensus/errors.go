@@ -11,6 +11,11 @@ var (
 	ErrBadNonce       = errors.New("Wrong transaction nonce.")
 	ErrBadTxType      = errors.New("Undefined tx type.")
 	ErrBadInputOwner  = errors.New("Bad input owner")
+	ErrTxVersion      = errors.New("Unsupported transaction version.")
+	ErrLegacyTx       = errors.New("Legacy transactions are not accepted anymore.")
+	ErrTxNotValidYet  = errors.New("Transaction is not valid yet.")
+	ErrTxExpired      = errors.New("Transaction is expired.")
+	ErrTxOrder        = errors.New("Wrong block transactions order.")
 
 	ErrKnownBlock     = errors.New("Block already exists in the database")
 )
@@ -6,7 +6,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/blockchain/consensus"
 	"github.com/raidoNetwork/RDO_v2/blockchain/consensus/backend/pos"
-	"github.com/raidoNetwork/RDO_v2/blockchain/core/slot"
 	"github.com/raidoNetwork/RDO_v2/keystore"
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
@@ -52,12 +51,20 @@ type Forger struct {
 	skipAddr map[string]struct{}
 }
 
-// ForgeBlock create block from tx pool data
-func (m *Forger) ForgeBlock() (*prototype.Block, error) {
+// ForgeBlock create block for the given slot from tx pool data, validators seeds, checkpoint votes and slashing evidence
+func (m *Forger) ForgeBlock(slotNum uint64, reveals []*prototype.Seed, votes []*prototype.CheckpointVote, evidence []*prototype.SlashingEvidence) (*prototype.Block, error) {
 	start := time.Now()
 	bn := m.bf.GetBlockCount()
 	totalSize := 0 // current size of block in bytes
 
+	head, err := m.bf.GetHeadBlock()
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading head block")
+	}
+
+	// transactions should pay at least the base fee of the block
+	baseFee := types.NextBaseFee(head)
+
 	// lock pool
 	m.att.TxPool().LockPool()
 	defer m.att.TxPool().UnlockPool()
@@ -77,7 +84,7 @@ func (m *Forger) ForgeBlock() (*prototype.Block, error) {
 
 	// create reward transactions for current block
 	// txBatch, collapseBatch, totalSize, err := m.addRewardTxToBatch(m.cfg.Proposer.Addr().Hex(), txBatch, collapseBatch, totalSize, bn)
-	txBatch, collapseBatch, totalSize, err := m.addRewardsTxToBatch(m.cfg.Proposer.Addr().Hex(), txBatch, collapseBatch, totalSize, bn)
+	txBatch, collapseBatch, totalSize, err = m.addRewardsTxToBatch(m.cfg.Proposer.Addr().Hex(), txBatch, collapseBatch, totalSize, bn)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +98,9 @@ func (m *Forger) ForgeBlock() (*prototype.Block, error) {
 		txCountPerBlock = txBatchLimit - len(txBatch) - len(collapseBatch) - feeTxCount
 	}
 
+	// next nonces of the senders included to the block
+	nonces := make(map[string]uint64)
+
 	var size int
 	txType := "StandardTx"
 	for _, tx := range txQueue {
@@ -103,6 +113,44 @@ func (m *Forger) ForgeBlock() (*prototype.Block, error) {
 			continue
 		}
 
+		if tx.Expired(bn) {
+			if err := m.att.TxPool().DeleteTransaction(tx); err != nil {
+				return nil, errors.Wrap(err, "Error creating block")
+			}
+
+			log.Debugf("Skip expired tx %s", tx.Hash().Hex())
+			continue
+		}
+
+		// keep tx in the pool until its validity window is opened
+		if !tx.ValidAt(bn) {
+			log.Debugf("Skip tx %s valid after block %d", tx.Hash().Hex(), tx.ValidAfter())
+			continue
+		}
+
+		// keep tx in the pool until base fee falls
+		if tx.FeePrice() < baseFee {
+			log.Debugf("Skip tx %s with fee price lower than the base fee %d", tx.Hash().Hex(), baseFee)
+			continue
+		}
+
+		// skip sender transactions following the skipped one
+		sender := tx.From().Hex()
+		nonce, exists := nonces[sender]
+		if !exists {
+			nonce, err = m.bf.GetTransactionsCount(tx.From().Bytes())
+			if err != nil {
+				return nil, errors.Wrap(err, "Error reading sender nonce")
+			}
+
+			nonce++
+		}
+
+		if tx.Num() != nonce {
+			log.Debugf("Skip tx %s with nonce %d. Expected nonce: %d.", tx.Hash().Hex(), tx.Num(), nonce)
+			continue
+		}
+
 		size = tx.Size()
 		totalSize += size
 
@@ -123,13 +171,9 @@ func (m *Forger) ForgeBlock() (*prototype.Block, error) {
 					hasStake = true
 				}
 
+				// validator slots reservation puts sender to the activation queue
 				if out.Node().Hex() == common.BlackHoleAddress {
-					if m.cfg.Engine.IsValidator(tx.From()) {
-						amount += out.Amount()
-					} else {
-						err = errors.New("Unauthorized slot reservation")
-						break
-					}
+					amount += out.Amount()
 				}
 
 				// a validator cannot stake on any validator (including itself)
@@ -159,6 +203,7 @@ func (m *Forger) ForgeBlock() (*prototype.Block, error) {
 		}
 
 		txBatch = append(txBatch, tx.GetTx())
+		nonces[sender] = nonce + 1
 		tx.Forge()
 		log.Debugf("Add %s %s to the block %d", txType, hash, bn)
 		txType = "StandardTx"
@@ -202,9 +247,16 @@ func (m *Forger) ForgeBlock() (*prototype.Block, error) {
 
 	txBatch = append(txBatch, systemUnstakes...)
 
+	slashTxs, err := m.createSlashTxs(evidence, txBatch, totalSize+countTXsSize(systemUnstakes), m.bf.GetBlockCount())
+	if err != nil {
+		return nil, err
+	}
+
+	txBatch = append(txBatch, slashTxs...)
+
 	// generate fee tx for block
 	if len(txBatch) > 0 {
-		txFee, err := m.createFeeTx(txBatch)
+		txFee, err := m.createFeeTx(txBatch, baseFee)
 
 		if err != nil {
 			if !errors.Is(err, ErrZeroFeeAmount) {
@@ -221,8 +273,12 @@ func (m *Forger) ForgeBlock() (*prototype.Block, error) {
 	// clear collapse list
 	m.skipAddr = map[string]struct{}{}
 
+	// mix validators reveals with the head block seed
+	seed := types.SeedMix(types.BlockSeed(head), slotNum, reveals)
+
 	// get block instance
-	block := types.NewBlock(m.bf.GetBlockCount(), slot.Ticker().Slot(), m.bf.ParentHash(), txBatch, m.cfg.Proposer)
+	block := types.NewBlock(m.bf.GetBlockCount(), slotNum, m.bf.ParentHash(), seed, reveals, votes, txBatch, m.cfg.Proposer)
+	block.BaseFee = baseFee
 
 	end := time.Since(start)
 	log.Warnf("Generate block with transactions count: %d. TxPool transactions count: %d. Size: %d kB. Time: %s", len(txBatch), txQueueLen, totalSize/1024, common.StatFmt(end))
@@ -272,24 +328,18 @@ func (m *Forger) addRewardsTxToBatch(proposer string, txBatch []*prototype.Trans
 	return txBatch, collapseBatch, totalSize, nil
 }
 
-func (m *Forger) createFeeTx(txarr []*prototype.Transaction) (*prototype.Transaction, error) {
-	var feeAmount uint64
-
-	for _, tx := range txarr {
-		feeAmount += tx.GetRealFee()
-	}
-
-	if feeAmount == 0 {
+// createFeeTx creates transaction burning the base fee and paying tips to the proposer
+func (m *Forger) createFeeTx(txarr []*prototype.Transaction, baseFee uint64) (*prototype.Transaction, error) {
+	outputs := types.FeeOutputs(txarr, m.bf.GetBlockCount(), baseFee, m.cfg.Proposer.Addr().Bytes())
+	if len(outputs) == 0 {
 		return nil, ErrZeroFeeAmount
 	}
 
 	opts := types.TxOptions{
-		Outputs: []*prototype.TxOutput{
-			types.NewOutput(common.HexToAddress(common.BlackHoleAddress), feeAmount, nil),
-		},
-		Type: common.FeeTxType,
-		Fee:  0,
-		Num:  m.bf.GetBlockCount(),
+		Outputs: outputs,
+		Type:    common.FeeTxType,
+		Fee:     0,
+		Num:     m.bf.GetBlockCount(),
 	}
 
 	ntx, err := types.NewPbTransaction(opts, nil)
@@ -510,6 +560,78 @@ func (m *Forger) generateSystemUnstakes(txBatch []*prototype.Transaction, totalS
 	return unstakeTxs, nil
 }
 
+// createSlashTxs creates slash transactions for the offenders with the given evidence.
+// Slash tx spends all validator stake deposits of the offender, burns the penalty and stakes the rest back.
+func (m *Forger) createSlashTxs(evidence []*prototype.SlashingEvidence, txBatch []*prototype.Transaction, totalSize int, blockNum uint64) ([]*prototype.Transaction, error) {
+	slashTxs := make([]*prototype.Transaction, 0, len(evidence))
+	slashSize := 0
+
+	// validators which stake deposits are spent in the block
+	busy := map[string]struct{}{}
+	for _, t := range txBatch {
+		for _, in := range t.Inputs {
+			if common.BytesToAddress(in.Node).Hex() == common.BlackHoleAddress {
+				busy[common.BytesToAddress(in.Address).Hex()] = struct{}{}
+			}
+		}
+	}
+
+	for _, ev := range evidence {
+		offender := types.EvidenceOffender(ev).Hex()
+		if _, exists := busy[offender]; exists {
+			log.Debugf("Skip slashing of %s: stake deposits are already spent in the block", offender)
+			continue
+		}
+
+		deposits, err := m.bf.FindStakeDepositsOfAddress(offender, common.BlackHoleAddress)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error reading offender stake deposits")
+		}
+
+		if len(deposits) == 0 {
+			log.Debugf("Skip slashing of %s: no stake deposits", offender)
+			continue
+		}
+
+		data, err := ev.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrap(err, "Error marshaling slashing evidence")
+		}
+
+		inputs := make([]*prototype.TxInput, 0, len(deposits))
+		var deposit uint64
+		for _, uo := range deposits {
+			inputs = append(inputs, uo.ToPbInput())
+			deposit += uo.Amount
+		}
+
+		opts := types.TxOptions{
+			Inputs:  inputs,
+			Outputs: types.SlashOutputs(common.HexToAddress(offender), deposit),
+			Data:    data,
+			Num:     blockNum,
+			Type:    common.SlashTxType,
+		}
+
+		slashTx, err := types.NewPbTransaction(opts, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error when generating slash tx")
+		}
+
+		if totalSize+slashSize+slashTx.SizeSSZ() > m.cfg.BlockSize {
+			break
+		}
+
+		slashSize += slashTx.SizeSSZ()
+		slashTxs = append(slashTxs, slashTx)
+		busy[offender] = struct{}{}
+
+		log.Warnf("Add SlashTx %s for validator %s to the block", common.Encode(slashTx.Hash), offender)
+	}
+
+	return slashTxs, nil
+}
+
 func countTXsSize(txs []*prototype.Transaction) int {
 	size := 0
 	for _, tx := range txs {
@@ -10,6 +10,9 @@ type TxValidator interface {
 	// ValidateTransaction validate transaction and return an error if something is wrong
 	ValidateTransaction(*types.Transaction) error
 
+	// ValidateNextTransaction validates transaction following the given sender transactions ordered by nonce
+	ValidateNextTransaction(*types.Transaction, []*types.Transaction) error
+
 	// ValidateStakeTransaction validates stake transaction and return an error if something is wrong
 	CheckMaxStakers(*types.Transaction, int) error
 
@@ -22,8 +25,26 @@ type BlockValidator interface {
 	// ValidateBlock validate block and return an error if something is wrong
 	ValidateBlock(*prototype.Block, bool) ([]*types.Transaction, error)
 
+	// ValidateForkBlock validates block of the side branch with given parent without the chain state
+	ValidateForkBlock(*prototype.Block, *prototype.Block) error
+
 	// ValidateGenesis compare given Genesis with local
 	ValidateGenesis(*prototype.Block) error
+
+	// Committee returns validators committee voting for the block with given parent and slot
+	Committee(*prototype.Block, uint64) ([]string, error)
+
+	// ValidateSeed checks signature of the seed revealed in the block with given num and binding to the given slot
+	ValidateSeed(*prototype.Seed, uint64, uint64) error
+
+	// ValidateEvidence checks that slashing evidence can be included in the block with given slot
+	ValidateEvidence(*prototype.SlashingEvidence, uint64) error
+
+	// EpochCheckpoint returns checkpoint of the given block epoch
+	EpochCheckpoint(*prototype.Block) (*types.Checkpoint, error)
+
+	// ValidateCheckpointVote checks vote signature and link to the given target checkpoint
+	ValidateCheckpointVote(*prototype.CheckpointVote, *types.Checkpoint) error
 }
 
 // Validator checks if block or transaction is correct according to the engine rules
@@ -62,6 +83,12 @@ type BlockchainReader interface {
 	// GetTransactionsCount returns address nonce
 	GetTransactionsCount([]byte) (uint64, error)
 
+	// IsSlashed returns true if validator has been already slashed for the offence in the given slot
+	IsSlashed([]byte, uint64) (bool, error)
+
+	// GetValidatorSet returns validator set of the latest processed epoch or nil if no epoch was processed
+	GetValidatorSet() (*types.ValidatorSet, error)
+
 	GenesisReader
 }
 
@@ -76,18 +103,54 @@ type BlockFinalizer interface {
 	// FindAllUTxO find all address unspent outputs
 	FindAllUTxO(string) ([]*types.UTxO, error)
 
+	// GetTransactionsCount returns address nonce
+	GetTransactionsCount([]byte) (uint64, error)
+
 	// FindStakeDepositsOfAddress returns all stake deposits of an address on a specified node
 	FindStakeDepositsOfAddress(address string, node string) ([]*types.UTxO, error)
 
 	// ParentHash return parent block hash for current block
 	ParentHash() []byte
 
+	// GetBlockByHash return block with given hash from blockchain if exists
+	GetBlockByHash([]byte) (*prototype.Block, error)
+
+	// GetBlockByNum return block with given num from blockchain if exists
+	GetBlockByNum(uint64) (*prototype.Block, error)
+
+	// RevertBlock removes head block from the blockchain
+	RevertBlock(*prototype.Block) error
+
+	// GetHeadBlock returns the last block in the blockchain
+	GetHeadBlock() (*prototype.Block, error)
+
 	// SyncData syncs data in the KV with data in the SQL.
 	SyncData() error
 
 	// CheckBalance check if system balance is correct
 	CheckBalance() error
 
+	// IsSlashed returns true if validator has been already slashed for the offence in the given slot
+	IsSlashed([]byte, uint64) (bool, error)
+
+	// SaveJustifiedCheckpoint saves justified checkpoint of the epoch
+	SaveJustifiedCheckpoint(*types.Checkpoint) error
+
+	// GetJustifiedCheckpoint returns justified checkpoint of the given epoch or nil if epoch is not justified
+	GetJustifiedCheckpoint(uint64) (*types.Checkpoint, error)
+
+	// SaveFinalizedCheckpoint saves the last finalized checkpoint
+	SaveFinalizedCheckpoint(*types.Checkpoint) error
+
+	// GetFinalityStatus returns the last justified and finalized checkpoints
+	GetFinalityStatus() (*types.Checkpoint, *types.Checkpoint, error)
+
+	// SaveValidatorSet saves validator set of the epoch
+	SaveValidatorSet(*types.ValidatorSet) error
+
+	// SaveFailedReceipts saves receipts of the block transactions failed validation
+	SaveFailedReceipts(*prototype.Block, []*types.Transaction) error
+
 	GenesisReader
 }
 
@@ -141,6 +204,9 @@ type StakePool interface {
 	// Init load initial pool data
 	Init() error
 
+	// Reload drops pool data and loads it again
+	Reload() error
+
 	// FinalizeStaking complete all staking pool updates
 	FinalizeStaking([]*types.Transaction) error
 
@@ -149,8 +215,20 @@ type StakePool interface {
 
 	HasValidator(validator string) bool
 
-	// DetermineProposer determines the proposer according to seed
-	DetermineProposer(seed int64) string
+	// DetermineProposer determines the proposer among the epoch active validators according to seed
+	DetermineProposer(seed int64, epoch uint64) string
+
+	// RankProposers returns the given count of the epoch active validators ordered by the proposal priority according to seed
+	RankProposers(seed int64, epoch uint64, count int) []string
+
+	// Committee samples committee of the given size from the epoch active validators according to seed
+	Committee(seed int64, size int, epoch uint64) []string
+
+	// IsActiveValidator returns true if validator is in the active set of the given epoch
+	IsActiveValidator(validator string, epoch uint64) bool
+
+	// ProcessEpoch updates validator set at the start of the given epoch by the block with given num
+	ProcessEpoch(epoch, num uint64) *types.ValidatorSet
 
 	// ListValidators returns all nodes with occupied slots
 	ListValidators() []string
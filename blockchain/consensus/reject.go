@@ -0,0 +1,49 @@
+package consensus
+
+import "github.com/pkg/errors"
+
+// RejectReason is the machine readable reason of the transaction rejection by the pool
+type RejectReason string
+
+const (
+	RejectInvalid          RejectReason = "invalid"
+	RejectKnown            RejectReason = "known"
+	RejectNonce            RejectReason = "nonce"
+	RejectFeeFloor         RejectReason = "underpriced"
+	RejectUnderpriced      RejectReason = "replacement_underpriced"
+	RejectReplacementInput RejectReason = "replacement_inputs"
+	RejectReplacementType  RejectReason = "replacement_type"
+	RejectSenderLimit      RejectReason = "sender_limit"
+	RejectPoolFull         RejectReason = "pool_full"
+	RejectSenderBusy       RejectReason = "sender_busy"
+	RejectExpired          RejectReason = "expired"
+	RejectNotValidYet      RejectReason = "not_valid_yet"
+)
+
+// TxRejectError describes why transaction was not accepted by the pool
+type TxRejectError struct {
+	Reason RejectReason
+	Err    error
+}
+
+func (e *TxRejectError) Error() string {
+	return e.Err.Error()
+}
+
+// RejectTx wraps error with the rejection reason
+func RejectTx(reason RejectReason, err error) error {
+	return &TxRejectError{
+		Reason: reason,
+		Err:    err,
+	}
+}
+
+// RejectReasonOf returns rejection reason of the given error. Errors without reason are treated as invalid transaction.
+func RejectReasonOf(err error) RejectReason {
+	var rejectErr *TxRejectError
+	if errors.As(err, &rejectErr) {
+		return rejectErr.Reason
+	}
+
+	return RejectInvalid
+}
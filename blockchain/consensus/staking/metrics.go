@@ -10,4 +10,16 @@ var (
 		Name: "stake_slots_filled",
 		Help: "Filled stake slots count",
 	})
+	activeValidators = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "active_validators",
+		Help: "Active validators count",
+	})
+	activationQueueSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "validators_activation_queue",
+		Help: "Count of validators waiting for activation",
+	})
+	exitQueueSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "validators_exit_queue",
+		Help: "Count of validators waiting for exit",
+	})
 )
@@ -3,6 +3,7 @@ package staking
 import (
 	"math/big"
 	"math/rand"
+	"sort"
 	"sync"
 	"unicode/utf8"
 
@@ -39,6 +40,12 @@ type StakingPool struct {
 	slotsReserved      int
 	blockchain         consensus.BlockchainReader
 
+	// set is the validator set of the last processed epoch
+	set *types.ValidatorSet
+
+	// next is the cached validator set transition for the next epoch
+	next *types.ValidatorSet
+
 	mu sync.Mutex
 }
 
@@ -64,9 +71,38 @@ func (p *StakingPool) Init() error {
 		}
 	}
 
+	set, err := p.blockchain.GetValidatorSet()
+	if err != nil {
+		return err
+	}
+
+	// the static validators list bootstraps the set until the first epoch is processed
+	if set == nil {
+		set = types.GenesisValidatorSet(params.ConsensusConfig().Proposers)
+	}
+
+	p.mu.Lock()
+	p.set = set
+	p.next = nil
+	p.mu.Unlock()
+
 	return nil
 }
 
+// Reload drops pool state and loads it from the stake deposits again. It is used after the chain reorganisation.
+func (p *StakingPool) Reload() error {
+	p.mu.Lock()
+	p.validators = map[string]*ValidatorStakeData{}
+	p.electors = map[string]map[string]struct{}{}
+	p.unstakedSnapshot = make(map[string]map[string]uint64)
+	p.cumulativeStake = 0
+	p.slotsFilled = 0
+	p.slotsReserved = 0
+	p.mu.Unlock()
+
+	return p.Init()
+}
+
 func (p *StakingPool) registerValidatorStake(validator string, amount uint64) error {
 	empty := p.getEmptySlots()
 	if empty == 0 {
@@ -357,6 +393,31 @@ func (p *StakingPool) processSystemUnstakeTx(tx *types.Transaction) error {
 	return nil
 }
 
+// processSlashTx burns part of the offender validator stake.
+// Validator looses all slots if the whole stake is burned.
+func (p *StakingPool) processSlashTx(tx *types.Transaction) error {
+	if len(tx.Inputs()) == 0 {
+		return errors.New("Slash tx has no inputs")
+	}
+
+	validator := tx.Inputs()[0].Address().Hex()
+
+	var amount uint64
+	for _, in := range tx.Inputs() {
+		amount += in.Amount()
+	}
+
+	for _, out := range tx.Outputs() {
+		if len(out.Node()) == common.AddressLength {
+			amount -= out.Amount()
+		}
+	}
+
+	log.Warnf("Slash validator %s stake: %d roi", validator, amount)
+
+	return p.cancelValidatorStake(validator, amount)
+}
+
 func (p *StakingPool) FinalizeStaking(batch []*types.Transaction) error {
 	p.mu.Lock()
 	p.slotsReserved = 0
@@ -372,6 +433,8 @@ func (p *StakingPool) FinalizeStaking(batch []*types.Transaction) error {
 			err = p.processUnstakeTx(tx)
 		case common.ValidatorsUnstakeTxType:
 			err = p.processSystemUnstakeTx(tx)
+		case common.SlashTxType:
+			err = p.processSlashTx(tx)
 		}
 
 		if err != nil {
@@ -379,6 +442,11 @@ func (p *StakingPool) FinalizeStaking(batch []*types.Transaction) error {
 		}
 	}
 
+	// staking state is changed so the next epoch transition should be computed again
+	p.mu.Lock()
+	p.next = nil
+	p.mu.Unlock()
+
 	return nil
 }
 
@@ -524,9 +592,9 @@ func (s *StakingPool) ListStakeValidators() []string {
 	return filtered
 }
 
-func (s *StakingPool) DetermineProposer(seed int64) string {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// validatorWeights returns selection weights of the given validators and their sum.
+// Need to acquire lock outside.
+func (s *StakingPool) validatorWeights(validators []string) (map[string]uint64, uint64) {
 	weights := make(map[string]uint64, 0)
 
 	slotUnit := params.RaidoConfig().StakeSlotUnit
@@ -534,7 +602,12 @@ func (s *StakingPool) DetermineProposer(seed int64) string {
 
 	var cumulative uint64
 
-	for validator, stakeData := range s.validators {
+	for _, validator := range validators {
+		stakeData, exists := s.validators[validator]
+		if !exists {
+			continue
+		}
+
 		electorsStake := stakeData.CumulativeStake - stakeData.SelfStake
 		w := stakeData.SelfStake/slotUnit*100 + electorsStake/slotUnit*uint64(100*coefficient)
 
@@ -542,33 +615,133 @@ func (s *StakingPool) DetermineProposer(seed int64) string {
 		cumulative += w
 	}
 
-	// If cumulative is zero, select the first validator in the validators list
-	cfg := params.ConsensusConfig()
-	proposers := cfg.Proposers
-	if cumulative == 0 {
-		proposer := proposers[0]
-		return proposer
+	return weights, cumulative
+}
+
+// epochWeights returns active validators of the given epoch with their selection weights snapshotted with the validator set
+// and the sum of weights.
+// Need to acquire lock outside.
+func (s *StakingPool) epochWeights(epoch uint64) ([]string, map[string]uint64, uint64) {
+	set := s.activeSet(epoch)
+
+	var cumulative uint64
+	for _, validator := range set.Active {
+		cumulative += set.Weights[validator]
 	}
 
-	rand.Seed(seed)
-	random := uint64(rand.Int63n(int64(cumulative))) + 1
-	var sum uint64
-	var leader string
+	return set.Active, set.Weights, cumulative
+}
 
-	for _, validator := range proposers {
-		weight, exists := weights[validator]
-		if !exists {
-			continue
+// DetermineProposer selects proposer among the active validators of the given epoch weighted by stake with the given seed
+func (s *StakingPool) DetermineProposer(seed int64, epoch uint64) string {
+	ranking := s.RankProposers(seed, epoch, 1)
+	if len(ranking) == 0 {
+		return ""
+	}
+
+	return ranking[0]
+}
+
+// RankProposers returns the given count of the epoch active validators ordered by the proposal priority in the slot.
+// The first one is the slot leader and the others are backup proposers. Validators are selected by the epoch stake snapshot
+// without replacement, validators without stake follow them in the address order.
+func (s *StakingPool) RankProposers(seed int64, epoch uint64, count int) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	active, weights, cumulative := s.epochWeights(epoch)
+	proposers := append([]string{}, active...)
+
+	if count > len(proposers) {
+		count = len(proposers)
+	}
+
+	rnd := rand.New(rand.NewSource(seed))
+	ranking := make([]string, 0, count)
+	for len(ranking) < count {
+		// If cumulative is zero, select validators in the active set order
+		if cumulative == 0 {
+			ranking = append(ranking, proposers[:count-len(ranking)]...)
+			break
 		}
-		if weight+sum >= random {
-			leader = validator
+
+		random := uint64(rnd.Int63n(int64(cumulative))) + 1
+		var sum uint64
+
+		for i, validator := range proposers {
+			weight := weights[validator]
+			if weight+sum < random {
+				sum += weight
+				continue
+			}
+
+			ranking = append(ranking, validator)
+			cumulative -= weight
+			proposers = append(proposers[:i], proposers[i+1:]...)
 			break
 		}
+	}
 
-		sum += weight
+	return ranking
+}
+
+// Committee samples committee of the given size from the active validators of the epoch weighted by the stake snapshotted
+// at the epoch start with the given seed. Validators are sampled without replacement. If size is not positive, all validators are returned.
+// Committee is always sorted by address, because votes are aggregated in the committee order.
+func (s *StakingPool) Committee(seed int64, size int, epoch uint64) []string {
+	s.mu.Lock()
+	active, weights, cumulative := s.epochWeights(epoch)
+	s.mu.Unlock()
+
+	rnd := rand.New(rand.NewSource(seed))
+
+	// If cumulative is zero, committee is sampled uniformly from the whole active set
+	if cumulative == 0 {
+		candidates := append([]string{}, active...)
+		rnd.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+
+		if size > 0 && len(candidates) > size {
+			candidates = candidates[:size]
+		}
+		sort.Strings(candidates)
+
+		return candidates
+	}
+
+	candidates := make([]string, 0, len(weights))
+	for validator, weight := range weights {
+		if weight > 0 {
+			candidates = append(candidates, validator)
+		}
+	}
+	sort.Strings(candidates)
+
+	if size <= 0 || len(candidates) <= size {
+		return candidates
+	}
+
+	committee := make([]string, 0, size)
+	for len(committee) < size {
+		random := uint64(rnd.Int63n(int64(cumulative))) + 1
+
+		var sum uint64
+		for i, validator := range candidates {
+			sum += weights[validator]
+			if sum < random {
+				continue
+			}
+
+			committee = append(committee, validator)
+			cumulative -= weights[validator]
+			candidates = append(candidates[:i], candidates[i+1:]...)
+			break
+		}
 	}
 
-	return leader
+	sort.Strings(committee)
+	return committee
 }
 
 func (s *StakingPool) GetFullyUnstaked() map[string]map[string]struct{} {
@@ -0,0 +1,152 @@
+package staking
+
+import (
+	"sort"
+
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+// IsActiveValidator returns true if validator is in the active set of the given epoch
+func (p *StakingPool) IsActiveValidator(validator string, epoch uint64) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.activeSet(epoch).IsActive(validator)
+}
+
+// ProcessEpoch moves validators between the active set and the queues at the start of the given epoch.
+// Returns the new validator set or nil if the epoch is already processed.
+func (p *StakingPool) ProcessEpoch(epoch, num uint64) *types.ValidatorSet {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.set == nil || epoch <= p.set.Epoch {
+		return nil
+	}
+
+	set := p.activeSet(epoch).Copy()
+	set.Epoch = epoch
+	set.ProcessedNum = num
+
+	p.set = set
+	p.next = nil
+
+	activeValidators.Set(float64(len(set.Active)))
+	activationQueueSize.Set(float64(len(set.ActivationQueue)))
+	exitQueueSize.Set(float64(len(set.ExitQueue)))
+
+	return set.Copy()
+}
+
+// activeSet returns validator set used in the given epoch.
+// Need to acquire lock outside.
+func (p *StakingPool) activeSet(epoch uint64) *types.ValidatorSet {
+	if p.set == nil {
+		return types.GenesisValidatorSet(params.ConsensusConfig().Proposers)
+	}
+
+	if epoch <= p.set.Epoch {
+		return p.set
+	}
+
+	if p.next == nil {
+		p.next = p.transition()
+	}
+
+	return p.next
+}
+
+// transition applies staking state to the current validator set. Validators with stake join the activation queue,
+// active validators without stake join the exit queue. Every epoch up to the churn limit validators leave each queue.
+// Validators without stake exit only while the set has staked validators, so the Genesis validators keep the network
+// running until somebody stakes. Selection weights of the new active set are taken from the current stake.
+// Need to acquire lock outside.
+func (p *StakingPool) transition() *types.ValidatorSet {
+	next := p.set.Copy()
+
+	churn := params.RaidoConfig().ValidatorChurnLimit
+	if churn <= 0 {
+		churn = 1
+	}
+
+	// update activation queue
+	queued := map[string]struct{}{}
+	activation := make([]string, 0, len(next.ActivationQueue))
+	for _, validator := range next.ActivationQueue {
+		if _, staked := p.validators[validator]; staked && !next.IsActive(validator) {
+			activation = append(activation, validator)
+			queued[validator] = struct{}{}
+		}
+	}
+
+	candidates := make([]string, 0)
+	for validator := range p.validators {
+		if _, exists := queued[validator]; !exists && !next.IsActive(validator) {
+			candidates = append(candidates, validator)
+		}
+	}
+	sort.Strings(candidates)
+	activation = append(activation, candidates...)
+
+	// update exit queue
+	queued = map[string]struct{}{}
+	exit := make([]string, 0, len(next.ExitQueue))
+	for _, validator := range next.ExitQueue {
+		if _, staked := p.validators[validator]; !staked && next.IsActive(validator) {
+			exit = append(exit, validator)
+			queued[validator] = struct{}{}
+		}
+	}
+
+	for _, validator := range next.Active {
+		if _, staked := p.validators[validator]; !staked {
+			if _, exists := queued[validator]; !exists {
+				exit = append(exit, validator)
+			}
+		}
+	}
+
+	// activate validators
+	count := churn
+	if len(activation) < count {
+		count = len(activation)
+	}
+
+	next.Active = append(next.Active, activation[:count]...)
+	next.ActivationQueue = activation[count:]
+
+	// exit validators keeping staked ones in the set
+	hasStaked := false
+	for _, validator := range next.Active {
+		if _, staked := p.validators[validator]; staked {
+			hasStaked = true
+			break
+		}
+	}
+
+	exited := map[string]struct{}{}
+	if hasStaked {
+		for _, validator := range exit {
+			if len(exited) == churn {
+				break
+			}
+
+			exited[validator] = struct{}{}
+		}
+	}
+
+	active := make([]string, 0, len(next.Active))
+	for _, validator := range next.Active {
+		if _, exists := exited[validator]; !exists {
+			active = append(active, validator)
+		}
+	}
+	sort.Strings(active)
+
+	next.Active = active
+	next.ExitQueue = exit[len(exited):]
+	next.Weights, _ = p.validatorWeights(active)
+
+	return next
+}
@@ -0,0 +1,125 @@
+package attestation
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	"github.com/raidoNetwork/RDO_v2/utils/file"
+	bolt "go.etcd.io/bbolt"
+)
+
+// JournalFileName is the name of the pool journal database stored in the data directory
+const JournalFileName = "txpool_journal.db"
+
+// journalInterval is the period of the journal rewriting with the current pool transactions
+const journalInterval = time.Minute
+
+var journalBucket = []byte("transactions")
+
+// journal keeps transactions accepted by the pool, so they are not lost after the node restart
+type journal struct {
+	db *bolt.DB
+}
+
+// openJournal opens pool journal database in the given directory
+func openJournal(dirPath string) (*journal, error) {
+	hasDir, err := file.HasDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		if err := file.MkdirAll(dirPath); err != nil {
+			return nil, err
+		}
+	}
+
+	boltDB, err := bolt.Open(
+		filepath.Join(dirPath, JournalFileName),
+		params.RaidoIoConfig().ReadWritePermissions,
+		&bolt.Options{
+			Timeout: 1 * time.Second,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain pool journal lock, data directory may be in use by another process")
+		}
+		return nil, err
+	}
+
+	if err := boltDB.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(journalBucket)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &journal{db: boltDB}, nil
+}
+
+// insert saves transaction to the journal
+func (j *journal) insert(tx *types.Transaction) error {
+	raw, err := tx.GetTx().MarshalSSZ()
+	if err != nil {
+		return err
+	}
+
+	return j.db.Update(func(btx *bolt.Tx) error {
+		return btx.Bucket(journalBucket).Put(tx.Hash(), raw)
+	})
+}
+
+// load returns all journal transactions
+func (j *journal) load() ([]*types.Transaction, error) {
+	txs := make([]*types.Transaction, 0)
+	err := j.db.View(func(btx *bolt.Tx) error {
+		return btx.Bucket(journalBucket).ForEach(func(k, v []byte) error {
+			txpb := new(prototype.Transaction)
+			if err := txpb.UnmarshalSSZ(v); err != nil {
+				log.Warnf("Skip broken journal transaction %x: %s", k, err)
+				return nil
+			}
+
+			txs = append(txs, types.NewTransaction(txpb))
+			return nil
+		})
+	})
+
+	return txs, err
+}
+
+// rotate replaces journal data with the given transactions
+func (j *journal) rotate(txs []*types.Transaction) error {
+	return j.db.Update(func(btx *bolt.Tx) error {
+		if err := btx.DeleteBucket(journalBucket); err != nil {
+			return err
+		}
+
+		bkt, err := btx.CreateBucket(journalBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range txs {
+			raw, err := tx.GetTx().MarshalSSZ()
+			if err != nil {
+				return err
+			}
+
+			if err := bkt.Put(tx.Hash(), raw); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// close closes the underlying BoltDB database
+func (j *journal) close() error {
+	return j.db.Close()
+}
@@ -13,39 +13,69 @@ import (
 	"github.com/raidoNetwork/RDO_v2/shared/common"
 	"github.com/raidoNetwork/RDO_v2/shared/types"
 	"github.com/raidoNetwork/RDO_v2/utils/async"
+	"github.com/raidoNetwork/RDO_v2/utils/serialize"
 	utypes "github.com/raidoNetwork/RDO_v2/utils/types"
 	"github.com/sirupsen/logrus"
 )
 
 const (
-	maxTxCount = 1000
+	// poolFullPercent is the pool size limit usage percent when the minimal fee price starts to rise
+	poolFullPercent = 90
+
+	// maxSenderTxCount is the limit of pending and queued transactions of one sender
+	maxSenderTxCount = 16
+
+	// maxQueuedTxCount is the limit of queued transactions of all senders
+	maxQueuedTxCount = 1024
 )
 
 var log = logrus.WithField("prefix", "attestation")
 
 type PoolSettings struct {
 	Validator  consensus.TxValidator
+	Blockchain consensus.BlockchainReader
 	MinimalFee uint64
 }
 
 func NewPool(cfg *PoolSettings) *Pool {
 	return &Pool{
-		txSenderMap: map[string]*types.Transaction{},
+		txSenderMap: map[string]Transactions{},
 		txHashMap:   map[string]*types.Transaction{},
 		stakeTxMap:  map[string]*types.Transaction{},
+		queued:      map[string]Transactions{},
 		pending:     make(Transactions, 0),
+		recent:      map[string]*types.PoolEvent{},
+		events:      make(chan *types.PoolEvent, poolEventBufferSize),
 		cfg:         cfg,
 	}
 }
 
 type Pool struct {
-	txSenderMap map[string]*types.Transaction
+	// txSenderMap holds sender pending transactions with consecutive nonces sorted by nonce
+	txSenderMap map[string]Transactions
 	txHashMap   map[string]*types.Transaction
 	stakeTxMap  map[string]*types.Transaction
 
+	// queued holds sender transactions with the future nonces sorted by nonce.
+	// Transaction is promoted to pending when the previous sender nonce is forged.
+	queued map[string]Transactions
+
 	pending Transactions
 	cfg     *PoolSettings
 
+	// size is the total size of the pool transactions in bytes
+	size int
+
+	// recent keeps status of the latest transactions removed from the pool in the removal order
+	recent      map[string]*types.PoolEvent
+	recentOrder []string
+
+	// events delivers transactions status changes to the subscribers
+	events chan *types.PoolEvent
+
+	// journal saves accepted transactions to restore them after restart, nil if disabled
+	journal *journal
+
 	mu        sync.Mutex
 	queueLock sync.Mutex
 
@@ -53,27 +83,66 @@ type Pool struct {
 }
 
 func (p *Pool) Insert(tx *types.Transaction) error {
+	err := p.insert(tx)
+	if err != nil {
+		return err
+	}
+
+	if p.journal != nil {
+		if err := p.journal.insert(tx); err != nil {
+			log.Errorf("Error saving tx %s to the journal: %s", tx.Hash().Hex(), err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Pool) insert(tx *types.Transaction) error {
 	p.mu.Lock()
 
 	hash := tx.Hash().Hex()
 	if _, exists := p.txHashMap[hash]; exists {
 		p.mu.Unlock()
-		return errors.New("Already exists")
+		return consensus.RejectTx(consensus.RejectKnown, errors.New("Already exists"))
 	}
 
 	sender := tx.From().Hex()
 	if sender == "" {
+		p.mu.Unlock()
 		return errors.New("Wrong sender")
 	}
 
-	if oldTx, exists := p.txSenderMap[sender]; exists {
+	if senderTxs, exists := p.txSenderMap[sender]; exists {
 		p.mu.Unlock()
-		return p.processDoubleSpend(oldTx, tx)
+		return p.processDoubleSpend(senderTxs, tx)
 	}
 
 	p.mu.Unlock()
 
-	err := p.validateTx(tx)
+	if err := p.checkFeeFloor(tx); err != nil {
+		return err
+	}
+
+	nonce, err := p.cfg.Blockchain.GetTransactionsCount(tx.From().Bytes())
+	if err != nil {
+		return err
+	}
+
+	if tx.Num() <= nonce {
+		return consensus.RejectTx(consensus.RejectNonce, consensus.ErrBadNonce)
+	}
+
+	// sender has a nonce gap, wait for the previous transactions
+	if tx.Num() > nonce+1 {
+		return p.enqueue(tx)
+	}
+
+	err = p.validateTx(tx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = p.makeRoom(tx)
 	if err != nil {
 		return err
 	}
@@ -81,34 +150,410 @@ func (p *Pool) Insert(tx *types.Transaction) error {
 	p.finalizeInsert(tx)
 	log.Debugf("Insert tx %s", tx.Hash().Hex())
 
-	if len(p.txHashMap)+1 == maxTxCount {
-		p.cleanWorst()
+	return nil
+}
+
+// loadJournal inserts journal transactions to the pool in the nonce order.
+// Transactions already included in the chain or invalid in the current state are dropped.
+func (p *Pool) loadJournal() error {
+	if p.journal == nil {
+		return nil
 	}
 
-	return nil
+	txs, err := p.journal.load()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		if !bytes.Equal(txs[i].From(), txs[j].From()) {
+			return bytes.Compare(txs[i].From(), txs[j].From()) < 0
+		}
+
+		return txs[i].Num() < txs[j].Num()
+	})
+
+	count := 0
+	for _, tx := range txs {
+		if err := p.insert(tx); err != nil {
+			log.Debugf("Drop journal tx %s: %s", tx.Hash().Hex(), err)
+			continue
+		}
+
+		count++
+	}
+
+	log.Infof("Loaded %d of %d journal transactions", count, len(txs))
+
+	return p.rotateJournal()
 }
 
-func (p *Pool) processDoubleSpend(oldTx, newTx *types.Transaction) error {
-	if oldTx.Type() == common.CollapseTxType {
-		return errors.New("Outputs cleaning. Try later")
+// rotateJournal rewrites journal with the current pool transactions
+func (p *Pool) rotateJournal() error {
+	if p.journal == nil {
+		return nil
 	}
 
-	if oldTx.Num() == newTx.Num() {
-		canSwap := txLess(oldTx, newTx)
-		if !canSwap {
-			return errors.New("Too cheap tx")
+	p.mu.Lock()
+	txs := make([]*types.Transaction, 0, len(p.txHashMap))
+	for _, tx := range p.txHashMap {
+		if utypes.IsStandardTx(tx) {
+			txs = append(txs, tx)
 		}
+	}
+	p.mu.Unlock()
 
-		err := p.validateTx(newTx)
+	return p.journal.rotate(txs)
+}
+
+// processDoubleSpend inserts transaction of the sender with pending transactions.
+// Transaction replaces pending one with the same nonce, follows the last pending nonce or goes to the queue.
+func (p *Pool) processDoubleSpend(senderTxs Transactions, newTx *types.Transaction) error {
+	if senderTxs[0].Type() == common.CollapseTxType {
+		return consensus.RejectTx(consensus.RejectSenderBusy, errors.New("Outputs cleaning. Try later"))
+	}
+
+	for i, oldTx := range senderTxs {
+		if oldTx.Num() != newTx.Num() {
+			continue
+		}
+
+		err := checkReplacement(oldTx, newTx)
+		if err != nil {
+			return err
+		}
+
+		err = p.validateReplacement(oldTx, newTx, senderTxs[:i])
 		if err != nil {
 			return err
 		}
 
 		return p.swap(oldTx, newTx)
 	}
+
+	if newTx.Num() < senderTxs[0].Num() {
+		return consensus.RejectTx(consensus.RejectNonce, consensus.ErrBadNonce)
+	}
+
+	if err := p.checkFeeFloor(newTx); err != nil {
+		return err
+	}
+
+	last := senderTxs[len(senderTxs)-1]
+	if newTx.Num() != last.Num()+1 || !types.SenderNoncesEnabled(p.cfg.Blockchain.GetBlockCount()) {
+		return p.enqueue(newTx)
+	}
+
+	// transaction spending outputs of the pending transactions waits in the queue until they are forged
+	if err := p.validateTx(newTx, senderTxs); err != nil {
+		log.Debugf("Queue tx %s following pending nonce: %s", newTx.Hash().Hex(), err)
+		return p.enqueue(newTx)
+	}
+
+	p.mu.Lock()
+	count := p.senderTxCount(newTx.From().Hex())
+	p.mu.Unlock()
+
+	if count >= maxSenderTxCount {
+		return consensus.RejectTx(consensus.RejectSenderLimit, errors.Errorf("Sender %s has too many transactions in the pool", newTx.From().Hex()))
+	}
+
+	err := p.makeRoom(newTx)
+	if err != nil {
+		return err
+	}
+
+	p.finalizeInsert(newTx)
+	log.Debugf("Insert tx %s with nonce %d", newTx.Hash().Hex(), newTx.Num())
+
+	return nil
+}
+
+// senderTxCount returns the number of sender pending and queued transactions.
+// Need to acquire lock outside.
+func (p *Pool) senderTxCount(sender string) int {
+	return len(p.txSenderMap[sender]) + len(p.queued[sender])
+}
+
+// enqueue puts transaction with the future nonce to the sender queue.
+// Queued transaction inputs are validated on promotion, because they can spend outputs of the previous sender transactions.
+func (p *Pool) enqueue(tx *types.Transaction) error {
+	err := p.cfg.Validator.ValidateTransactionStruct(tx)
+	if err != nil {
+		return err
+	}
+
+	// nothing is evicted for the rejected transaction
+	p.mu.Lock()
+	_, replace, err := p.queuePosition(tx)
+	p.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	if !replace {
+		err = p.makeQueueRoom()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = p.makeRoom(tx)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// queue could change while making room
+	i, replace, err := p.queuePosition(tx)
+	if err != nil {
+		return err
+	}
+
+	sender := tx.From().Hex()
+	queue := p.queued[sender]
+
+	// replace queued transaction with the same nonce
+	if replace {
+		oldTx := queue[i]
+		queue[i] = tx
+		p.removeTx(oldTx)
+		p.addTx(tx)
+		p.notify(oldTx, types.PoolDropped)
+		p.notify(tx, types.PoolQueued)
+
+		log.Debugf("Swap queued %s with %s", oldTx.Hash().Hex(), tx.Hash().Hex())
+		return nil
+	}
+
+	queue = append(queue, nil)
+	copy(queue[i+1:], queue[i:])
+	queue[i] = tx
+
+	p.queued[sender] = queue
+	p.addTx(tx)
+	p.notify(tx, types.PoolQueued)
+
+	log.Debugf("Queue tx %s with nonce %d", tx.Hash().Hex(), tx.Num())
+
+	return nil
+}
+
+// queuePosition returns index of the transaction in the sender queue and true if it replaces the queued transaction
+// with the same nonce. Returns error if transaction can't be queued.
+// Need to acquire lock outside.
+func (p *Pool) queuePosition(tx *types.Transaction) (int, bool, error) {
+	sender := tx.From().Hex()
+	queue := p.queued[sender]
+
+	i := sort.Search(len(queue), func(i int) bool {
+		return queue[i].Num() >= tx.Num()
+	})
+
+	if i < len(queue) && queue[i].Num() == tx.Num() {
+		if err := checkReplacement(queue[i], tx); err != nil {
+			return 0, false, err
+		}
+
+		return i, true, nil
+	}
+
+	if p.senderTxCount(sender) >= maxSenderTxCount {
+		return 0, false, consensus.RejectTx(consensus.RejectSenderLimit, errors.Errorf("Sender %s has too many transactions in the pool", sender))
+	}
+
+	return i, false, nil
+}
+
+// queuedStats returns the count and the total size of the queued transactions.
+// Need to acquire lock outside.
+func (p *Pool) queuedStats() (count int, size int) {
+	for _, queue := range p.queued {
+		count += len(queue)
+		for _, tx := range queue {
+			size += tx.Size()
+		}
+	}
+
+	return count, size
+}
+
+// makeQueueRoom evicts queued transactions until the queue fits the global limit
+func (p *Pool) makeQueueRoom() error {
+	for {
+		p.mu.Lock()
+		count, _ := p.queuedStats()
+		p.mu.Unlock()
+
+		if count < maxQueuedTxCount {
+			return nil
+		}
+
+		if !p.cleanWorstQueued() {
+			return consensus.RejectTx(consensus.RejectPoolFull, errors.New("Queue is full"))
+		}
+	}
+}
+
+// checkReplacement checks replace-by-fee rules for the transaction with the same sender nonce as the pool one.
+// Replacement should bump fee price at least by TX_PRICE_BUMP percent and can't spend inputs not spent by the pool transaction.
+// Transaction can be replaced only with the transaction of the same type or with the cancel transaction.
+func checkReplacement(oldTx, newTx *types.Transaction) error {
+	minPrice := oldTx.FeePrice() + oldTx.FeePrice()*params.RaidoConfig().TxPriceBump/100
+	if minPrice == oldTx.FeePrice() {
+		minPrice++
+	}
+
+	if newTx.FeePrice() < minPrice {
+		return consensus.RejectTx(consensus.RejectUnderpriced, errors.Errorf("Replacement fee price should be at least %d. Given: %d.", minPrice, newTx.FeePrice()))
+	}
+
+	if newTx.Type() != oldTx.Type() && !utypes.IsCancelTx(newTx) {
+		return consensus.RejectTx(consensus.RejectReplacementType, errors.Errorf("Transaction of type %d can't replace transaction of type %d.", newTx.Type(), oldTx.Type()))
+	}
+
+	inputs := map[string]struct{}{}
+	for _, in := range oldTx.Inputs() {
+		inputs[serialize.GenKeyFromInput(in)] = struct{}{}
+	}
+
+	for _, in := range newTx.Inputs() {
+		if _, exists := inputs[serialize.GenKeyFromInput(in)]; !exists {
+			return consensus.RejectTx(consensus.RejectReplacementInput, errors.Errorf("Replacement spends input %s not spent by the replaced transaction.", serialize.GenKeyFromInput(in)))
+		}
+	}
+
+	return nil
+}
+
+// resetSender revalidates sender transactions against the current chain state. Transactions with forged nonces are dropped,
+// transactions with consecutive nonces starting from the next sender nonce are pending and the rest wait in the queue.
+func (p *Pool) resetSender(sender string) error {
+	nonce, err := p.cfg.Blockchain.GetTransactionsCount(common.HexToAddress(sender).Bytes())
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	pending := p.txSenderMap[sender]
+	if len(pending) > 0 && pending[0].Type() == common.CollapseTxType {
+		p.mu.Unlock()
+		return nil
+	}
+
+	txs := make(Transactions, 0, len(pending)+len(p.queued[sender]))
+	txs = append(txs, pending...)
+	txs = append(txs, p.queued[sender]...)
+
+	delete(p.txSenderMap, sender)
+	delete(p.queued, sender)
+
+	if stakeTx, exists := p.stakeTxMap[sender]; exists && pending.GetIndex(stakeTx) != -1 {
+		delete(p.stakeTxMap, sender)
+	}
+
+	p.queueLock.Lock()
+	for _, tx := range pending {
+		p.pending = p.pending.SwapAndRemove(p.pending.GetIndex(tx))
+	}
+	p.queueLock.Unlock()
+	p.mu.Unlock()
+
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Num() < txs[j].Num()
+	})
+
+	wasPending := make(map[*types.Transaction]struct{}, len(pending))
+	for _, tx := range pending {
+		wasPending[tx] = struct{}{}
+	}
+
+	chained := types.SenderNoncesEnabled(p.cfg.Blockchain.GetBlockCount())
+	promoted := make(Transactions, 0, len(txs))
+	queue := make(Transactions, 0, len(txs))
+	dropped := make(Transactions, 0)
+	var last uint64
+	for _, tx := range txs {
+		// drop forged nonces and transactions with the same nonce
+		if tx.Num() <= nonce || tx.Num() == last {
+			dropped = append(dropped, tx)
+			continue
+		}
+
+		last = tx.Num()
+
+		if len(queue) > 0 || tx.Num() != nonce+uint64(len(promoted))+1 || (len(promoted) > 0 && !chained) {
+			queue = append(queue, tx)
+			continue
+		}
+
+		if err := p.validateTx(tx, promoted); err != nil {
+			// the first transaction is validated with the chain state only
+			if len(promoted) == 0 {
+				log.Debugf("Drop tx %s: %s", tx.Hash().Hex(), err)
+				dropped = append(dropped, tx)
+			} else {
+				queue = append(queue, tx)
+			}
+
+			continue
+		}
+
+		promoted = append(promoted, tx)
+	}
+
+	p.mu.Lock()
+	for _, tx := range dropped {
+		p.removeTx(tx)
+		p.notify(tx, types.PoolDropped)
+		tx.Drop()
+	}
+
+	for _, tx := range queue {
+		if _, exists := wasPending[tx]; exists {
+			p.notify(tx, types.PoolQueued)
+		}
+	}
+
+	if len(queue) > 0 {
+		p.queued[sender] = queue
+	}
+
+	if len(promoted) > 0 {
+		p.txSenderMap[sender] = promoted
+	}
+
+	for _, tx := range promoted {
+		if tx.Type() == common.StakeTxType {
+			p.stakeTxMap[sender] = tx
+		}
+
+		if _, exists := wasPending[tx]; !exists {
+			p.notify(tx, types.PoolPending)
+			log.Debugf("Promote tx %s with nonce %d", tx.Hash().Hex(), tx.Num())
+		}
+	}
+	p.mu.Unlock()
+
+	p.queueLock.Lock()
+	p.pending = append(p.pending, promoted...)
+	p.queueLock.Unlock()
+
 	return nil
 }
 
+// promote revalidates transactions of the given senders and moves the next nonce transactions from the queue to the pending list
+func (p *Pool) promote(senders map[string]struct{}) {
+	for sender := range senders {
+		if err := p.resetSender(sender); err != nil {
+			log.Errorf("Error promoting %s transactions: %s", sender, err)
+		}
+	}
+}
+
 func (p *Pool) swap(oldTx, newTx *types.Transaction) error {
 	p.swapLock.WaitLock()
 	if oldTx.IsForged() {
@@ -124,19 +569,30 @@ func (p *Pool) swap(oldTx, newTx *types.Transaction) error {
 	p.queueLock.Unlock()
 
 	p.mu.Lock()
+	sender := newTx.From().Hex()
 
 	// If a stake tx, update stakeTxMap
+	if stakeTx, exists := p.stakeTxMap[sender]; exists && stakeTx == oldTx {
+		delete(p.stakeTxMap, sender)
+	}
+
 	if newTx.Type() == common.StakeTxType {
-		p.stakeTxMap[newTx.From().Hex()] = newTx
+		p.stakeTxMap[sender] = newTx
 	}
 
-	if oldTx.Type() == common.StakeTxType {
-		delete(p.stakeTxMap, oldTx.From().Hex())
+	p.removeTx(oldTx)
+	p.addTx(newTx)
+
+	// copy sender transactions, because the list can be used outside the lock
+	senderTxs := make(Transactions, len(p.txSenderMap[sender]))
+	copy(senderTxs, p.txSenderMap[sender])
+	if i := senderTxs.GetIndex(oldTx); i != -1 {
+		senderTxs[i] = newTx
 	}
+	p.txSenderMap[sender] = senderTxs
 
-	delete(p.txHashMap, oldTx.Hash().Hex())
-	p.txHashMap[newTx.Hash().Hex()] = newTx
-	p.txSenderMap[newTx.From().Hex()] = newTx
+	p.notify(oldTx, types.PoolDropped)
+	p.notify(newTx, types.PoolPending)
 	p.mu.Unlock()
 
 	log.Debugf("Swap %s with %s", oldTx.Hash().Hex(), newTx.Hash().Hex())
@@ -144,39 +600,275 @@ func (p *Pool) swap(oldTx, newTx *types.Transaction) error {
 	return nil
 }
 
+// finalizeInsert adds transaction with the next sender nonce to the pending list.
+// Queued sender transactions following it are promoted.
 func (p *Pool) finalizeInsert(tx *types.Transaction) {
+	sender := tx.From().Hex()
+
 	p.mu.Lock()
 	if tx.Type() == uint32(common.StakeTxType) {
-		p.stakeTxMap[tx.From().Hex()] = tx
+		p.stakeTxMap[sender] = tx
 	}
-	p.txSenderMap[tx.From().Hex()] = tx
-	p.txHashMap[tx.Hash().Hex()] = tx
+
+	senderTxs := make(Transactions, 0, len(p.txSenderMap[sender])+1)
+	senderTxs = append(senderTxs, p.txSenderMap[sender]...)
+	p.txSenderMap[sender] = append(senderTxs, tx)
+
+	p.addTx(tx)
+	p.notify(tx, types.PoolPending)
+
+	queue := p.queued[sender]
+	promote := len(queue) > 0 && queue[0].Num() == tx.Num()+1
 	p.mu.Unlock()
 
 	p.queueLock.Lock()
 	p.pending = append(p.pending, tx)
 	p.queueLock.Unlock()
+
+	if promote {
+		if err := p.resetSender(sender); err != nil {
+			log.Errorf("Error promoting %s transactions: %s", sender, err)
+		}
+	}
+}
+
+// addTx adds transaction to the hash map and counts its size.
+// Need to acquire lock outside.
+func (p *Pool) addTx(tx *types.Transaction) {
+	p.txHashMap[tx.Hash().Hex()] = tx
+
+	// collapse transactions are created by the forger and don't use the pool space
+	if utypes.IsStandardTx(tx) {
+		p.size += tx.Size()
+	}
+}
+
+// removeTx removes transaction from the hash map and pool size.
+// Need to acquire lock outside.
+func (p *Pool) removeTx(tx *types.Transaction) {
+	hash := tx.Hash().Hex()
+	if _, exists := p.txHashMap[hash]; !exists {
+		return
+	}
+
+	delete(p.txHashMap, hash)
+
+	if utypes.IsStandardTx(tx) {
+		p.size -= tx.Size()
+	}
+}
+
+// makeRoom evicts transactions until the given transaction fits the pool size limit.
+// Queued transactions can't be forged now, so they are evicted first, then pending transactions with the lowest fee price.
+func (p *Pool) makeRoom(tx *types.Transaction) error {
+	limit := params.RaidoConfig().PoolSize
+	if tx.Size() > limit {
+		return consensus.RejectTx(consensus.RejectPoolFull, errors.New("Transaction is bigger than the pool"))
+	}
+
+	for {
+		p.mu.Lock()
+		size := p.size
+		p.mu.Unlock()
+
+		if size+tx.Size() <= limit {
+			return nil
+		}
+
+		if p.cleanWorstQueued() {
+			continue
+		}
+
+		if !p.cleanWorst(tx.FeePrice()) {
+			return consensus.RejectTx(consensus.RejectPoolFull, errors.New("Pool is full"))
+		}
+	}
 }
 
-func (p *Pool) cleanWorst() {
+// cleanWorst drops pending transaction with the lowest fee price if it is cheaper than the given fee price.
+// Returns false if there is no such transaction.
+func (p *Pool) cleanWorst(feePrice uint64) bool {
 	queue := p.GetQueue()
+	if len(queue) == 0 {
+		return false
+	}
+
 	lastIndex := len(queue) - 1
 	worst := queue[lastIndex]
+	if worst.FeePrice() >= feePrice {
+		return false
+	}
 
 	p.mu.Lock()
-	delete(p.txHashMap, worst.Hash().Hex())
-	delete(p.txSenderMap, worst.From().Hex())
+	p.cleanTransactionMap(worst, false)
+	p.notify(worst, types.PoolDropped)
 	p.mu.Unlock()
 
 	p.queueLock.Lock()
-	p.pending = p.pending[:lastIndex]
+	p.pending = p.pending.SwapAndRemove(p.pending.GetIndex(worst))
 	p.queueLock.Unlock()
 
 	worst.Drop()
 	log.Debugf("Delete worst %s", worst.Hash().Hex())
+
+	return true
 }
 
-func (p *Pool) validateTx(tx *types.Transaction) error {
+// checkFeeFloor rejects transaction with fee price lower than the current pool admission floor
+func (p *Pool) checkFeeFloor(tx *types.Transaction) error {
+	floor := p.MinFeePrice()
+	if tx.FeePrice() < floor {
+		return consensus.RejectTx(consensus.RejectFeeFloor, errors.Errorf("Fee price should be at least %d. Given: %d.", floor, tx.FeePrice()))
+	}
+
+	return nil
+}
+
+// MinFeePrice returns the lowest fee price accepted by the pool. When the pool is almost full
+// transaction should pay more than the cheapest pending transaction to be accepted.
+// Queued transactions are not validated against the state, so they don't raise the floor.
+func (p *Pool) MinFeePrice() uint64 {
+	floor := params.RaidoConfig().MinimalFee
+
+	p.mu.Lock()
+	_, queuedSize := p.queuedStats()
+	size := p.size - queuedSize
+	p.mu.Unlock()
+
+	if size < params.RaidoConfig().PoolSize*poolFullPercent/100 {
+		return floor
+	}
+
+	queue := p.GetQueue()
+	if len(queue) > 0 && queue[len(queue)-1].FeePrice()+1 > floor {
+		floor = queue[len(queue)-1].FeePrice() + 1
+	}
+
+	return floor
+}
+
+// cleanWorstQueued drops the latest transaction of the longest sender queue.
+// Returns false if there are no queued transactions.
+func (p *Pool) cleanWorstQueued() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	longest := ""
+	for sender, queue := range p.queued {
+		if longest == "" || len(queue) > len(p.queued[longest]) {
+			longest = sender
+		}
+	}
+
+	if longest == "" {
+		return false
+	}
+
+	queue := p.queued[longest]
+	lastIndex := len(queue) - 1
+	worst := queue[lastIndex]
+
+	if lastIndex == 0 {
+		delete(p.queued, longest)
+	} else {
+		p.queued[longest] = queue[:lastIndex]
+	}
+
+	p.removeTx(worst)
+	p.notify(worst, types.PoolDropped)
+	log.Debugf("Delete worst queued %s", worst.Hash().Hex())
+
+	return true
+}
+
+// checkTx validates transaction before sending it to the pool.
+// Transactions with the future nonce are checked without the chain state.
+func (p *Pool) checkTx(tx *types.Transaction) error {
+	num := p.cfg.Blockchain.GetBlockCount()
+	if tx.Expired(num) {
+		return consensus.RejectTx(consensus.RejectExpired, consensus.ErrTxExpired)
+	}
+
+	if !tx.ValidAt(num) {
+		return consensus.RejectTx(consensus.RejectNotValidYet, consensus.ErrTxNotValidYet)
+	}
+
+	nonce, err := p.cfg.Blockchain.GetTransactionsCount(tx.From().Bytes())
+	if err != nil {
+		return err
+	}
+
+	if tx.Num() <= nonce {
+		return consensus.RejectTx(consensus.RejectNonce, consensus.ErrBadNonce)
+	}
+
+	sender := tx.From().Hex()
+
+	p.mu.Lock()
+	_, known := p.txHashMap[tx.Hash().Hex()]
+	pending := p.txSenderMap[sender]
+	queue := p.queued[sender]
+	count := p.senderTxCount(sender)
+	p.mu.Unlock()
+
+	if known {
+		return consensus.RejectTx(consensus.RejectKnown, errors.New("Already exists"))
+	}
+
+	if len(pending) > 0 && pending[0].Type() == common.CollapseTxType {
+		return consensus.RejectTx(consensus.RejectSenderBusy, errors.New("Outputs cleaning. Try later"))
+	}
+
+	// find pool transaction with the same nonce
+	var poolTx *types.Transaction
+	var prev Transactions
+	for i, ptx := range pending {
+		if ptx.Num() == tx.Num() {
+			poolTx = ptx
+			prev = pending[:i]
+			break
+		}
+	}
+
+	for _, qtx := range queue {
+		if qtx.Num() == tx.Num() {
+			poolTx = qtx
+			break
+		}
+	}
+
+	if poolTx != nil {
+		err = checkReplacement(poolTx, tx)
+		if err != nil {
+			return err
+		}
+	} else {
+		if tx.Num() > nonce+1 && count >= maxSenderTxCount {
+			return consensus.RejectTx(consensus.RejectSenderLimit, errors.Errorf("Sender %s has too many transactions in the pool", sender))
+		}
+
+		err = p.checkFeeFloor(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// transaction replacing the pending one is validated after the previous pending sender transactions
+	if tx.Num() > nonce+1 && len(prev) == 0 {
+		return p.cfg.Validator.ValidateTransactionStruct(tx)
+	}
+
+	return p.validateReplacement(poolTx, tx, prev)
+}
+
+// validateTx validates transaction following the given pending sender transactions
+func (p *Pool) validateTx(tx *types.Transaction, prev Transactions) error {
+	return p.validateReplacement(nil, tx, prev)
+}
+
+// validateReplacement validates transaction replacing the given pool transaction and following the given pending sender transactions.
+// Replaced stake transaction is not counted in the pool stakers.
+func (p *Pool) validateReplacement(oldTx, tx *types.Transaction, prev Transactions) error {
 	err := p.cfg.Validator.ValidateTransactionStruct(tx)
 	if err != nil {
 		return err
@@ -184,13 +876,20 @@ func (p *Pool) validateTx(tx *types.Transaction) error {
 
 	// todo check locked inputs here
 	if tx.Type() == uint32(common.StakeTxType) {
-		if err := p.cfg.Validator.ValidateTransaction(tx); err != nil {
+		if err := p.cfg.Validator.ValidateNextTransaction(tx, prev); err != nil {
 			return err
 		}
 
-		return p.CheckMaxStakers(tx)
+		p.mu.Lock()
+		stakers := len(p.stakeTxMap)
+		if oldTx != nil && oldTx.Type() == common.StakeTxType {
+			stakers--
+		}
+		p.mu.Unlock()
+
+		return p.cfg.Validator.CheckMaxStakers(tx, stakers)
 	} else {
-		return p.cfg.Validator.ValidateTransaction(tx)
+		return p.cfg.Validator.ValidateNextTransaction(tx, prev)
 	}
 }
 
@@ -223,10 +922,10 @@ func (p *Pool) InsertCollapseTx(txs []*types.Transaction) error {
 		}
 
 		for from := range senders {
-			p.txSenderMap[from] = tx
+			p.txSenderMap[from] = Transactions{tx}
 		}
 
-		p.txHashMap[tx.Hash().Hex()] = tx
+		p.addTx(tx)
 	}
 
 	return nil
@@ -242,26 +941,27 @@ func (p *Pool) GetQueue() []*types.Transaction {
 	return p.pending
 }
 
+// GetFeePrice returns current admission floor of the pool
 func (p *Pool) GetFeePrice() uint64 {
-	queue := p.GetQueue()
-	size := len(queue)
-	if size == 0 {
-		return params.RaidoConfig().MinimalFee
-	} else if size == 1 {
-		return queue[0].FeePrice()
-	} else {
-		return (queue[0].FeePrice() + queue[size-1].FeePrice()) / 2
-	}
+	return p.MinFeePrice()
 }
 
 func (p *Pool) Finalize(txarr []*types.Transaction) {
 	p.mu.Lock()
-	// Gather ValidatorsUnstakeTxs
+	// Gather ValidatorsUnstakeTxs and SlashTxs
 	validatorsUnstakes := make([]*types.Transaction, 0)
 
+	// senders with the forged nonces
+	senders := map[string]struct{}{}
+
 	for _, tx := range txarr {
-		if tx.Type() == common.ValidatorsUnstakeTxType {
+		if utypes.IsStandardTx(tx) {
+			senders[tx.From().Hex()] = struct{}{}
+		}
+
+		if tx.Type() == common.ValidatorsUnstakeTxType || tx.Type() == common.SlashTxType {
 			validatorsUnstakes = append(validatorsUnstakes, tx)
+			senders[tx.Inputs()[0].Address().Hex()] = struct{}{}
 		}
 
 		if utypes.IsSystemTx(tx) && tx.Type() != common.CollapseTxType &&
@@ -278,7 +978,20 @@ func (p *Pool) Finalize(txarr []*types.Transaction) {
 		p.pending = p.pending.SwapAndRemove(index)
 		p.queueLock.Unlock()
 
-		p.cleanTransactionMap(rtx)
+		p.cleanTransactionMap(rtx, utypes.IsStandardTx(tx))
+
+		// sender transaction is replaced with the other transaction with the same nonce
+		if bytes.Equal(rtx.Hash(), tx.Hash()) {
+			p.notify(rtx, types.PoolForged)
+		} else {
+			p.notify(rtx, types.PoolDropped)
+		}
+
+		if rtx.Type() == common.CollapseTxType {
+			for _, sender := range rtx.AllSenders() {
+				senders[sender.Hex()] = struct{}{}
+			}
+		}
 	}
 
 	// Clean up SystemUnstakeTxs
@@ -287,20 +1000,70 @@ func (p *Pool) Finalize(txarr []*types.Transaction) {
 	}
 
 	p.mu.Unlock()
+
+	p.pruneExpired(p.cfg.Blockchain.GetBlockCount())
+	p.promote(senders)
+}
+
+// pruneExpired drops pending and queued transactions that can't be included in the block with given number or later
+func (p *Pool) pruneExpired(num uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.queueLock.Lock()
+	pending := make(Transactions, 0, len(p.pending))
+	expired := make(Transactions, 0)
+	for _, tx := range p.pending {
+		if tx.Expired(num) {
+			expired = append(expired, tx)
+		} else {
+			pending = append(pending, tx)
+		}
+	}
+	p.pending = pending
+	p.queueLock.Unlock()
+
+	for _, tx := range expired {
+		p.cleanTransactionMap(tx, false)
+		p.notify(tx, types.PoolDropped)
+		tx.Drop()
+		log.Debugf("Drop expired tx %s", tx.Hash().Hex())
+	}
+
+	for sender, queue := range p.queued {
+		actual := make(Transactions, 0, len(queue))
+		for _, tx := range queue {
+			if !tx.Expired(num) {
+				actual = append(actual, tx)
+				continue
+			}
+
+			p.removeTx(tx)
+			p.notify(tx, types.PoolDropped)
+			log.Debugf("Drop expired queued tx %s", tx.Hash().Hex())
+		}
+
+		if len(actual) == 0 {
+			delete(p.queued, sender)
+		} else {
+			p.queued[sender] = actual
+		}
+	}
 }
 
 func (p *Pool) findPoolTransaction(tx *types.Transaction) (*types.Transaction, int, error) {
 	_, exists := p.txHashMap[tx.Hash().Hex()]
-	senderTx, senderExists := p.txSenderMap[tx.From().Hex()]
+	sender := tx.From().Hex()
 
 	// If this is a systemUnstakeTx, we want to look through
 	// all pool transactions and remove stake and unstake txs
 	if tx.Type() == common.ValidatorsUnstakeTxType {
-		address := tx.Inputs()[0].Address().Hex()
-		senderTx, senderExists = p.txSenderMap[address]
+		sender = tx.Inputs()[0].Address().Hex()
 	}
 
-	log.Debugf("Looking for Tx %s HashMap %v SenderMap %v Sender %s", tx.Hash().Hex(), exists, senderExists, tx.From().Hex())
+	senderTxs, senderExists := p.txSenderMap[sender]
+
+	log.Debugf("Looking for Tx %s HashMap %v SenderMap %v Sender %s", tx.Hash().Hex(), exists, senderExists, sender)
 
 	if !exists && !senderExists {
 		return nil, -1, errors.New("Undefined sender and transaction")
@@ -308,14 +1071,25 @@ func (p *Pool) findPoolTransaction(tx *types.Transaction) (*types.Transaction, i
 
 	// now tx can be one of several cases:
 	//  1. tx exists in pool
-	//  2. tx is double of previous sender tx
+	//  2. tx is double of sender tx with the same nonce
 	// 	3. tx is the first swapped transaction so it does exist in the pool
 	// 	AND it is not marked as double
 	var poolTx *types.Transaction
 	if exists {
 		poolTx = tx
+	} else if tx.Type() == common.ValidatorsUnstakeTxType {
+		poolTx = senderTxs[0]
 	} else {
-		poolTx = senderTx
+		for _, stx := range senderTxs {
+			if stx.Num() == tx.Num() {
+				poolTx = stx
+				break
+			}
+		}
+
+		if poolTx == nil {
+			return nil, -1, errors.New("Undefined sender transaction with the same nonce")
+		}
 	}
 
 	index := p.pending.GetIndex(poolTx)
@@ -326,45 +1100,99 @@ func (p *Pool) findPoolTransaction(tx *types.Transaction) (*types.Transaction, i
 	return poolTx, index, nil
 }
 
-func (p *Pool) cleanTransactionMap(tx *types.Transaction) {
-	delete(p.txHashMap, tx.Hash().Hex())
+// cleanTransactionMap removes transaction from the pool maps.
+// Pending sender transactions following the removed one are moved to the queue
+// because of the nonce gap, unless the removed transaction nonce is forged.
+// Need to acquire lock outside.
+func (p *Pool) cleanTransactionMap(tx *types.Transaction, forged bool) {
+	p.removeTx(tx)
 
 	if tx.Type() != common.CollapseTxType {
-		delete(p.txSenderMap, tx.From().Hex())
+		p.removeSenderTx(tx, forged)
 	} else {
 		for _, sender := range tx.AllSenders() {
-			delete(p.txSenderMap, sender.Hex())
+			if senderTxs := p.txSenderMap[sender.Hex()]; senderTxs.GetIndex(tx) != -1 {
+				delete(p.txSenderMap, sender.Hex())
+			}
 		}
 	}
 
-	if tx.Type() == common.StakeTxType {
-		delete(p.stakeTxMap, tx.From().Hex())
+	sender := tx.From().Hex()
+	if stakeTx, exists := p.stakeTxMap[sender]; exists && stakeTx == tx {
+		delete(p.stakeTxMap, sender)
 	}
 }
 
-func (p *Pool) findProblematicStakeTx(address, node string) (*types.Transaction, int, error) {
-	tx, exists := p.txSenderMap[address]
-	if !exists {
-		return nil, -1, errors.Errorf("No problematic transaction found in tx pool")
+// removeSenderTx removes transaction from the sender pending list.
+// Following transactions are moved to the queue if the removed transaction nonce is not forged.
+// Need to acquire lock outside.
+func (p *Pool) removeSenderTx(tx *types.Transaction, forged bool) {
+	sender := tx.From().Hex()
+	senderTxs := p.txSenderMap[sender]
+
+	i := senderTxs.GetIndex(tx)
+	if i == -1 {
+		return
+	}
+
+	var demoted Transactions
+	if i == 0 && forged {
+		senderTxs = senderTxs[1:]
+	} else {
+		demoted = senderTxs[i+1:]
+		senderTxs = senderTxs[:i]
+	}
+
+	if len(senderTxs) == 0 {
+		delete(p.txSenderMap, sender)
+	} else {
+		p.txSenderMap[sender] = senderTxs
 	}
 
-	if tx.Type() != common.StakeTxType && tx.Type() != common.UnstakeTxType {
-		return nil, -1, errors.Errorf("No problematic transaction found in tx pool")
+	if len(demoted) == 0 {
+		return
 	}
 
-	// Checking if the transaction is a stake transaction on the specified node
-	for _, out := range tx.Outputs() {
-		if out.Node().Hex() == node {
-			index := p.pending.GetIndex(tx)
-			return tx, index, nil
+	p.queueLock.Lock()
+	for _, dtx := range demoted {
+		p.pending = p.pending.SwapAndRemove(p.pending.GetIndex(dtx))
+	}
+	p.queueLock.Unlock()
+
+	queue := make(Transactions, 0, len(demoted)+len(p.queued[sender]))
+	queue = append(queue, demoted...)
+	p.queued[sender] = append(queue, p.queued[sender]...)
+
+	for _, dtx := range demoted {
+		if stakeTx, exists := p.stakeTxMap[sender]; exists && stakeTx == dtx {
+			delete(p.stakeTxMap, sender)
 		}
+
+		p.notify(dtx, types.PoolQueued)
+		log.Debugf("Queue tx %s after the nonce gap", dtx.Hash().Hex())
 	}
+}
 
-	// Checking if the transaction is an unstake transaction from the specified node
-	for _, in := range tx.Inputs() {
-		if in.Node().Hex() == node {
-			index := p.pending.GetIndex(tx)
-			return tx, index, nil
+func (p *Pool) findProblematicStakeTx(address, node string) (*types.Transaction, int, error) {
+	for _, tx := range p.txSenderMap[address] {
+		if tx.Type() != common.StakeTxType && tx.Type() != common.UnstakeTxType {
+			continue
+		}
+
+		// Checking if the transaction is a stake transaction on the specified node
+		for _, out := range tx.Outputs() {
+			if out.Node().Hex() == node {
+				index := p.pending.GetIndex(tx)
+				return tx, index, nil
+			}
+		}
+
+		// Checking if the transaction is an unstake transaction from the specified node
+		for _, in := range tx.Inputs() {
+			if in.Node().Hex() == node {
+				index := p.pending.GetIndex(tx)
+				return tx, index, nil
+			}
 		}
 	}
 
@@ -377,15 +1205,19 @@ func (p *Pool) cleanUpSystemUnstake(tx *types.Transaction) {
 	in := tx.Inputs()[0]
 	address := in.Address().Hex()
 	node := in.Node().Hex()
-	rtx, index, err := p.findProblematicStakeTx(address, node)
-	if err != nil {
-		return
-	}
-	p.queueLock.Lock()
-	p.pending = p.pending.SwapAndRemove(index)
-	p.queueLock.Unlock()
+	for {
+		rtx, index, err := p.findProblematicStakeTx(address, node)
+		if err != nil {
+			return
+		}
+
+		p.queueLock.Lock()
+		p.pending = p.pending.SwapAndRemove(index)
+		p.queueLock.Unlock()
 
-	p.cleanTransactionMap(rtx)
+		p.cleanTransactionMap(rtx, false)
+		p.notify(rtx, types.PoolDropped)
+	}
 }
 
 func (p *Pool) DeleteTransaction(tx *types.Transaction) error {
@@ -403,7 +1235,8 @@ func (p *Pool) DeleteTransaction(tx *types.Transaction) error {
 	}
 
 	p.mu.Lock()
-	p.cleanTransactionMap(tx)
+	p.cleanTransactionMap(tx, false)
+	p.notify(tx, types.PoolDropped)
 	p.mu.Unlock()
 
 	log.Debugf("Delete transaction %s", tx.Hash().Hex())
@@ -447,8 +1280,35 @@ func (txs Transactions) Swap(i, j int) {
 	txs[i], txs[j] = txs[j], txs[i]
 }
 
+// Sort orders transactions by fee price. Transactions of one sender are placed
+// in the nonce order to the positions the sender takes in the fee price order.
 func (txs Transactions) Sort() {
 	sort.Slice(txs, txs.Less)
+
+	positions := map[string][]int{}
+	for i, tx := range txs {
+		sender := tx.From().Hex()
+		positions[sender] = append(positions[sender], i)
+	}
+
+	for _, indexes := range positions {
+		if len(indexes) < 2 {
+			continue
+		}
+
+		senderTxs := make(Transactions, len(indexes))
+		for j, i := range indexes {
+			senderTxs[j] = txs[i]
+		}
+
+		sort.SliceStable(senderTxs, func(a, b int) bool {
+			return senderTxs[a].Num() < senderTxs[b].Num()
+		})
+
+		for j, i := range indexes {
+			txs[i] = senderTxs[j]
+		}
+	}
 }
 
 func (txs Transactions) SwapByHash(old, new *types.Transaction) error {
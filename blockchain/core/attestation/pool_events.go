@@ -0,0 +1,97 @@
+package attestation
+
+import (
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	utypes "github.com/raidoNetwork/RDO_v2/utils/types"
+)
+
+const (
+	// recentTxCount is the number of the latest removed transactions which status is kept for the API
+	recentTxCount = 1024
+
+	// poolEventBufferSize is the number of pool events waiting for subscribers delivery
+	poolEventBufferSize = 1000
+)
+
+// notify records transaction status change and sends it to the pool events subscribers.
+// Events are skipped when subscribers are too slow, so pool is never blocked by them.
+// Need to acquire lock outside.
+func (p *Pool) notify(tx *types.Transaction, status types.PoolStatus) {
+	// collapse transactions are created by the forger
+	if !utypes.IsStandardTx(tx) {
+		return
+	}
+
+	hash := tx.Hash().Hex()
+	event := &types.PoolEvent{
+		Tx:     tx,
+		Status: status,
+	}
+
+	if status == types.PoolForged || status == types.PoolDropped {
+		if _, exists := p.recent[hash]; !exists {
+			p.recentOrder = append(p.recentOrder, hash)
+		}
+		p.recent[hash] = event
+
+		if len(p.recentOrder) > recentTxCount {
+			delete(p.recent, p.recentOrder[0])
+			p.recentOrder = p.recentOrder[1:]
+		}
+	} else {
+		delete(p.recent, hash)
+	}
+
+	select {
+	case p.events <- event:
+	default:
+		log.Debugf("Skip pool event %s %s: event buffer is full", hash, status)
+	}
+}
+
+// Events returns channel with pool transactions status changes
+func (p *Pool) Events() <-chan *types.PoolEvent {
+	return p.events
+}
+
+// PoolTransaction returns transaction with given hash and its pool status.
+// Transactions removed from the pool are kept for a while with forged or dropped status.
+func (p *Pool) PoolTransaction(hash common.Hash) (*types.Transaction, types.PoolStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := hash.Hex()
+	if tx, exists := p.txHashMap[key]; exists {
+		for _, qtx := range p.queued[tx.From().Hex()] {
+			if qtx == tx {
+				return tx, types.PoolQueued
+			}
+		}
+
+		if tx.IsForged() {
+			return tx, types.PoolForged
+		}
+
+		return tx, types.PoolPending
+	}
+
+	if event, exists := p.recent[key]; exists {
+		return event.Tx, event.Status
+	}
+
+	return nil, ""
+}
+
+// PendingByAddress returns pending and queued transactions of the given address ordered by nonce
+func (p *Pool) PendingByAddress(addr common.Address) []*types.Transaction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sender := addr.Hex()
+	queue := p.queued[sender]
+	res := make([]*types.Transaction, 0, len(p.txSenderMap[sender])+len(queue))
+	res = append(res, p.txSenderMap[sender]...)
+
+	return append(res, queue...)
+}
@@ -2,6 +2,7 @@ package attestation
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -13,6 +14,7 @@ import (
 	"github.com/raidoNetwork/RDO_v2/events"
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/shared"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
 	"github.com/raidoNetwork/RDO_v2/shared/params"
 	"github.com/raidoNetwork/RDO_v2/shared/types"
 	"google.golang.org/grpc/status"
@@ -20,11 +22,16 @@ import (
 
 var _ shared.Service = (*Service)(nil)
 
+// txEventBufferSize is the size of the incoming transactions channel buffer
+const txEventBufferSize = 1000
+
 type Config struct {
 	TxFeed        *events.Feed
 	StateFeed     *events.Feed
+	ReorgFeed     *events.Feed
 	EnableMetrics bool
 	Blockchain    *rdochain.Service
+	DataDir       string // pool journal is disabled if empty
 }
 
 func NewService(parentCtx context.Context, cfg *Config) (*Service, error) {
@@ -43,6 +50,7 @@ func NewService(parentCtx context.Context, cfg *Config) (*Service, error) {
 		ValidatorRegistryLimit: chainConfig.ValidatorRegistryLimit,
 		EnableMetrics:          cfg.EnableMetrics,
 		BlockSize:              chainConfig.BlockSize,
+		LegacyTxDeadline:       chainConfig.LegacyTxDeadline,
 	}
 
 	// new block and tx validator
@@ -51,10 +59,20 @@ func NewService(parentCtx context.Context, cfg *Config) (*Service, error) {
 	// new tx pool
 	txPool := NewPool(&PoolSettings{
 		Validator:  validator,
+		Blockchain: cfg.Blockchain,
 		MinimalFee: chainConfig.MinimalFee,
 	})
 
-	txEvent := make(chan *types.Transaction, maxTxCount)
+	if cfg.DataDir != "" {
+		journal, err := openJournal(cfg.DataDir)
+		if err != nil {
+			return nil, errors.Wrap(err, "Pool journal error")
+		}
+
+		txPool.journal = journal
+	}
+
+	txEvent := make(chan *types.Transaction, txEventBufferSize)
 	stateEvent := make(chan state.State, 1)
 	ctx, cancel := context.WithCancel(parentCtx)
 
@@ -80,6 +98,9 @@ type Service struct {
 	stateEvent chan state.State
 	txEvent    chan *types.Transaction
 
+	// poolFeed delivers pool transactions status changes to the API subscribers
+	poolFeed events.Feed
+
 	cfg *Config
 
 	ctx    context.Context
@@ -93,8 +114,51 @@ func (s *Service) Start() {
 		panic(errors.Wrap(err, "Stake pool error"))
 	}
 
+	// restore transactions saved before restart
+	err = s.txPool.loadJournal()
+	if err != nil {
+		log.Errorf("Error loading pool journal: %s", err)
+	}
+
 	// start tx pool work
 	go s.txListener()
+	go s.reorgListener()
+	go s.journalLoop()
+	go s.poolEventLoop()
+}
+
+// poolEventLoop sends pool transactions status changes to the subscribers
+func (s *Service) poolEventLoop() {
+	for {
+		select {
+		case event := <-s.txPool.Events():
+			s.poolFeed.Send(event)
+		case <-s.ctx.Done():
+			log.Debugf("Stop Pool events loop")
+			return
+		}
+	}
+}
+
+// journalLoop periodically rewrites pool journal to remove forged and dropped transactions
+func (s *Service) journalLoop() {
+	if s.txPool.journal == nil {
+		return
+	}
+
+	ticker := time.NewTicker(journalInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.txPool.rotateJournal(); err != nil {
+				log.Errorf("Error rotating pool journal: %s", err)
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
 }
 
 func (s *Service) txListener() {
@@ -115,6 +179,47 @@ func (s *Service) txListener() {
 	}
 }
 
+// reorgListener returns transactions of the reverted blocks to the pool
+func (s *Service) reorgListener() {
+	reorgEvent := make(chan *types.Reorg, 1)
+	sub := s.cfg.ReorgFeed.Subscribe(reorgEvent)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case reorg := <-reorgEvent:
+			applied := map[string]struct{}{}
+			for _, block := range reorg.Applied {
+				for _, tx := range block.Transactions {
+					applied[common.Encode(tx.Hash)] = struct{}{}
+				}
+			}
+
+			count := 0
+			for _, block := range reorg.Reverted {
+				for _, tx := range block.Transactions {
+					if _, exists := applied[common.Encode(tx.Hash)]; exists || common.IsSystemTx(tx) {
+						continue
+					}
+
+					err := s.txPool.Insert(types.NewTransaction(tx))
+					if err != nil {
+						log.Debugf("Can't return reverted transaction %s to the pool: %s", common.Encode(tx.Hash), err)
+						continue
+					}
+
+					count++
+				}
+			}
+
+			log.Infof("Returned %d transactions of the reverted blocks to the pool", count)
+		case <-s.ctx.Done():
+			log.Debugf("Stop Reorg listener loop")
+			return
+		}
+	}
+}
+
 func (s *Service) Status() error {
 	return nil
 }
@@ -124,21 +229,30 @@ func (s *Service) Stop() error {
 
 	s.cancel()
 
+	if s.txPool.journal != nil {
+		if err := s.txPool.rotateJournal(); err != nil {
+			log.Errorf("Error rotating pool journal: %s", err)
+		}
+
+		return s.txPool.journal.close()
+	}
+
 	return nil
 }
 
-// SendRawTx implements PoolAPI for gRPC gateway
+// SendRawTx implements PoolAPI for gRPC gateway.
+// Transaction rejected by the pool returns consensus.TxRejectError with the rejection reason.
 func (s *Service) SendRawTx(tx *prototype.Transaction) error {
 	_, err := tx.MarshalSSZ()
 	if err != nil {
 		return status.Error(17, "Transaction has bad format")
 	}
 
-	// Validate the transaction
+	// Validate the transaction, rejection reason is returned as is
 	typed := types.NewTransaction(tx)
-	err = s.txPool.validateTx(typed)
+	err = s.txPool.checkTx(typed)
 	if err != nil {
-		return status.Error(17, err.Error())
+		return err
 	}
 
 	s.cfg.TxFeed.Send(types.NewTransaction(tx))
@@ -146,9 +260,61 @@ func (s *Service) SendRawTx(tx *prototype.Transaction) error {
 	return nil
 }
 
-// GetFee returns minimal fee price needed to insert transaction in the block.
-func (s *Service) GetFee() uint64 {
-	return s.txPool.GetFeePrice()
+// SendRawTxBatch inserts given transactions to the pool one by one and returns insertion error of every transaction.
+// Accepted transactions are gossiped to the network.
+func (s *Service) SendRawTxBatch(txs []*prototype.Transaction) []error {
+	res := make([]error, len(txs))
+	for i, tx := range txs {
+		_, err := tx.MarshalSSZ()
+		if err != nil {
+			res[i] = status.Error(17, "Transaction has bad format")
+			continue
+		}
+
+		err = s.txPool.Insert(types.NewTransaction(tx))
+		if err != nil {
+			res[i] = err
+			continue
+		}
+
+		s.cfg.TxFeed.Send(types.NewTransaction(tx))
+	}
+
+	return res
+}
+
+// GetFee returns base fee of the next block and tip suggested by the median tip of the head block.
+// Suggested fee price is not lower than the pool admission floor, which rises when the pool is almost full.
+func (s *Service) GetFee() (*types.FeeSuggestion, error) {
+	head, err := s.cfg.Blockchain.GetHeadBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	tips := make([]uint64, 0, len(head.Transactions))
+	for _, tx := range head.Transactions {
+		if common.IsSystemTx(tx) || tx.Fee < head.BaseFee {
+			continue
+		}
+
+		tips = append(tips, tx.Fee-head.BaseFee)
+	}
+
+	fee := &types.FeeSuggestion{
+		BaseFee: types.NextBaseFee(head),
+	}
+
+	if len(tips) > 0 {
+		sort.Slice(tips, func(i, j int) bool { return tips[i] < tips[j] })
+		fee.Tip = tips[len(tips)/2]
+	}
+
+	fee.FeePrice = fee.BaseFee + fee.Tip
+	if floor := s.txPool.GetFeePrice(); fee.FeePrice < floor {
+		fee.FeePrice = floor
+	}
+
+	return fee, nil
 }
 
 // GetPendingTransactions returns list of pending transactions.
@@ -161,6 +327,31 @@ func (s *Service) GetPendingTransactions() ([]*prototype.Transaction, error) {
 	return res, nil
 }
 
+// GetPendingByAddress returns pending and queued transactions of the given address.
+func (s *Service) GetPendingByAddress(address string) ([]*prototype.Transaction, error) {
+	txs := s.txPool.PendingByAddress(common.HexToAddress(address))
+	res := make([]*prototype.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		res = append(res, tx.GetTx())
+	}
+	return res, nil
+}
+
+// GetPendingTransaction returns pool transaction with given hash and its pool status.
+func (s *Service) GetPendingTransaction(hash string) (*prototype.Transaction, types.PoolStatus, error) {
+	tx, poolStatus := s.txPool.PoolTransaction(common.HexToHash(hash))
+	if tx == nil {
+		return nil, "", errors.Errorf("Not found transaction %s in the pool", hash)
+	}
+
+	return tx.GetTx(), poolStatus, nil
+}
+
+// SubscribePendingTransactions subscribes given channel to the pool transactions status changes.
+func (s *Service) SubscribePendingTransactions(ch chan<- *types.PoolEvent) events.Subscription {
+	return s.poolFeed.Subscribe(ch)
+}
+
 func (s *Service) GetServiceStatus() (string, error) {
 	return "Unimplemented", nil
 }
@@ -205,3 +396,18 @@ func (s *Service) ListValidators() []string {
 func (s *Service) ListStakeValidators() []string {
 	return s.stakePool.ListStakeValidators()
 }
+
+// GetCommittee returns validators committee of the given slot on top of the current head.
+// Committee of the next epochs can change if new blocks are added before the epoch starts.
+func (s *Service) GetCommittee(slot uint64) ([]string, error) {
+	head, err := s.cfg.Blockchain.GetHeadBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if types.EpochOf(slot) < types.EpochOf(head.Slot) {
+		return nil, errors.Errorf("Committee of the past epoch %d is not available", types.EpochOf(slot))
+	}
+
+	return s.validator.Committee(head, slot)
+}
@@ -0,0 +1,157 @@
+package core
+
+import (
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+var ErrFinalizedBlockRevert = errors.New("Fork reverts finalized blocks")
+
+// processFinality counts checkpoint votes included in the previous epoch blocks when the given block starts a new epoch.
+// Checkpoint is justified when validators with 2/3 of the epoch stake voted for the link from the last justified checkpoint
+// to it. Source checkpoint of the link is finalized when it belongs to the previous epoch.
+func (s *Service) processFinality(block *prototype.Block) error {
+	parent, err := s.bc.GetBlockByHash(block.Parent)
+	if err != nil {
+		return err
+	}
+
+	if parent == nil {
+		return errors.Errorf("Not found parent of block #%d", block.Num)
+	}
+
+	epoch := types.EpochOf(parent.Slot)
+	if types.EpochOf(block.Slot) == epoch || parent.Num == 0 {
+		return nil
+	}
+
+	target, err := s.att.Validator().EpochCheckpoint(parent)
+	if err != nil {
+		return err
+	}
+
+	source, finalized, err := s.bc.GetFinalityStatus()
+	if err != nil {
+		return err
+	}
+
+	blocks, err := s.epochBlocks(parent, epoch)
+	if err != nil {
+		return err
+	}
+
+	voted, total := s.checkpointVotes(blocks, source, target)
+
+	checkpoint := &types.Checkpoint{
+		Epoch:        epoch,
+		Num:          target.Num,
+		Hash:         target.Hash,
+		VotedStake:   voted,
+		TotalStake:   total,
+		ProcessedNum: block.Num,
+	}
+
+	if total == 0 || voted*3 < total*2 {
+		log.Infof("Epoch %d checkpoint #%d is not justified. Voted stake: %d. Total stake: %d.", epoch, checkpoint.Num, voted, total)
+		return nil
+	}
+
+	err = s.bc.SaveJustifiedCheckpoint(checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "Error saving justified checkpoint")
+	}
+
+	justifiedEpoch.Set(float64(epoch))
+	log.Warnf("Epoch %d checkpoint #%d %s justified from epoch %d", epoch, checkpoint.Num, common.Encode(checkpoint.Hash), source.Epoch)
+
+	// finalize source with the link between two consecutive epochs, Genesis is finalized by default
+	if source.Epoch+1 != epoch || source.Num == 0 {
+		return nil
+	}
+
+	if source.Epoch <= finalized.Epoch && finalized.Num > 0 {
+		return nil
+	}
+
+	source.ProcessedNum = block.Num
+	err = s.bc.SaveFinalizedCheckpoint(source)
+	if err != nil {
+		return errors.Wrap(err, "Error saving finalized checkpoint")
+	}
+
+	finalizedEpoch.Set(float64(source.Epoch))
+	log.Warnf("Epoch %d checkpoint #%d %s finalized", source.Epoch, source.Num, common.Encode(source.Hash))
+
+	return nil
+}
+
+// epochBlocks returns canonical blocks of the epoch ending with the given block ordered by num
+func (s *Service) epochBlocks(last *prototype.Block, epoch uint64) ([]*prototype.Block, error) {
+	blocks := make([]*prototype.Block, 0)
+
+	block := last
+	for block.Num > 0 && types.EpochOf(block.Slot) == epoch {
+		blocks = append([]*prototype.Block{block}, blocks...)
+
+		var err error
+		block, err = s.bc.GetBlockByHash(block.Parent)
+		if err != nil {
+			return nil, err
+		}
+
+		if block == nil {
+			return nil, errors.Errorf("Not found parent of epoch %d block", epoch)
+		}
+	}
+
+	return blocks, nil
+}
+
+// checkpointVotes returns stake of the validators voted in the given blocks for the link from source to target
+// and total stake of the target epoch validators
+func (s *Service) checkpointVotes(blocks []*prototype.Block, source, target *types.Checkpoint) (uint64, uint64) {
+	stakes := s.att.StakePool().ValidatorStakeMap()
+
+	var voted, total uint64
+	for validator, stake := range stakes {
+		if s.att.StakePool().IsActiveValidator(validator, target.Epoch) {
+			total += stake
+		}
+	}
+
+	voters := map[string]struct{}{}
+	for _, block := range blocks {
+		for _, vote := range block.CheckpointVotes {
+			if !types.VoteLinks(vote, source, target) {
+				continue
+			}
+
+			voter := common.BytesToAddress(vote.Voter.Address).Hex()
+			if _, exists := voters[voter]; exists {
+				continue
+			}
+
+			voters[voter] = struct{}{}
+			voted += stakes[voter]
+		}
+	}
+
+	return voted, total
+}
+
+// checkFinalizedRevert returns error if reorg to the given ancestor reverts finalized blocks
+func (s *Service) checkFinalizedRevert(ancestor *prototype.Block) error {
+	_, finalized, err := s.bc.GetFinalityStatus()
+	if err != nil {
+		return err
+	}
+
+	// block that finalized the checkpoint and blocks used as its votes can't be reverted too
+	if finalized.ProcessedNum > 0 && ancestor.Num < finalized.ProcessedNum {
+		return ErrFinalizedBlockRevert
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package core
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/blockchain/consensus"
+	"github.com/raidoNetwork/RDO_v2/blockchain/consensus/attestation"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	vtypes "github.com/raidoNetwork/RDO_v2/validator/types"
+)
+
+// maxForkDepth is the maximum number of canonical blocks that can be reverted by the fork choice
+const maxForkDepth = 64
+
+var ErrSideBranchBlock = errors.New("Block is saved to the side branch")
+
+// branchWeight is the fork choice weight of the branch
+type branchWeight struct {
+	stake     uint64 // sum of approvers stake
+	approvers int    // count of approvers
+}
+
+// heavier returns true if weight is greater than the given one
+func (w branchWeight) heavier(other branchWeight) bool {
+	if w.stake != other.stake {
+		return w.stake > other.stake
+	}
+
+	return w.approvers > other.approvers
+}
+
+// processForkBlock saves block that doesn't extend the head to the side branch
+// and switches canonical chain to the block branch if it is heavier.
+func (s *Service) processForkBlock(block, head *prototype.Block) error {
+	if s.tree.Has(block.Hash) {
+		return consensus.ErrKnownBlock
+	}
+
+	if known, err := s.bc.GetBlockByHash(block.Hash); err != nil {
+		return err
+	} else if known != nil {
+		return consensus.ErrKnownBlock
+	}
+
+	parent := s.tree.Get(block.Parent)
+	if parent == nil {
+		var err error
+		parent, err = s.bc.GetBlockByHash(block.Parent)
+		if err != nil {
+			return err
+		}
+
+		if parent == nil {
+			return attestation.ErrPreviousBlockNotExists
+		}
+	}
+
+	if block.Num+maxForkDepth <= head.Num {
+		return errors.Errorf("Fork block #%d is too far from the head #%d", block.Num, head.Num)
+	}
+
+	err := s.att.Validator().ValidateForkBlock(block, parent)
+	if err != nil {
+		return errors.Wrap(err, "Fork block validation error")
+	}
+
+	s.tree.Add(block)
+
+	branch := s.tree.Branch(block)
+	ancestor, err := s.bc.GetBlockByHash(branch[0].Parent)
+	if err != nil {
+		return err
+	}
+
+	if ancestor == nil {
+		log.Debugf("Side branch of block #%d %s is not connected to the chain", block.Num, common.Encode(block.Hash))
+		return ErrSideBranchBlock
+	}
+
+	if err := s.checkFinalizedRevert(ancestor); err != nil {
+		s.tree.RemoveBranch(branch[0].Hash)
+		return err
+	}
+
+	canonical, err := s.canonicalBranch(ancestor, head)
+	if err != nil {
+		return err
+	}
+
+	branchW, err := s.branchWeight(ancestor, branch)
+	if err != nil {
+		return err
+	}
+
+	canonicalW, err := s.branchWeight(ancestor, canonical)
+	if err != nil {
+		return err
+	}
+	if !branchW.heavier(canonicalW) {
+		log.Infof(
+			"Save block #%d %s to the side branch. Branch weight: %d. Canonical weight: %d.",
+			block.Num,
+			common.Encode(block.Hash),
+			branchW.stake,
+			canonicalW.stake,
+		)
+		return ErrSideBranchBlock
+	}
+
+	return s.reorg(ancestor, canonical, branch)
+}
+
+// canonicalBranch returns canonical blocks after the ancestor ordered by num
+func (s *Service) canonicalBranch(ancestor, head *prototype.Block) ([]*prototype.Block, error) {
+	blocks := make([]*prototype.Block, 0, head.Num-ancestor.Num)
+	for num := ancestor.Num + 1; num <= head.Num; num++ {
+		block, err := s.bc.GetBlockByNum(num)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	if len(blocks) > 0 && !bytes.Equal(blocks[0].Parent, ancestor.Hash) {
+		return nil, errors.Errorf("Block #%d is not in the canonical chain", ancestor.Num)
+	}
+
+	return blocks, nil
+}
+
+// branchWeight counts stake of the committee members approved given blocks.
+// Blocks are ordered by num and the first block is a child of the given parent.
+func (s *Service) branchWeight(parent *prototype.Block, blocks []*prototype.Block) (branchWeight, error) {
+	stakes := s.att.StakePool().ValidatorStakeMap()
+
+	var weight branchWeight
+	for _, block := range blocks {
+		_, approvers, err := s.blockApprovers(parent, block)
+		if err != nil {
+			return weight, err
+		}
+		parent = block
+
+		for _, approver := range approvers {
+			weight.stake += stakes[approver]
+			weight.approvers++
+		}
+	}
+
+	return weight, nil
+}
+
+// blockApprovers returns committee of the block with given parent as a set and unique committee members approved the block
+func (s *Service) blockApprovers(parent, block *prototype.Block) (map[string]struct{}, []string, error) {
+	committee, err := s.att.Validator().Committee(parent, block.Slot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members := make(map[string]struct{}, len(committee))
+	for _, validator := range committee {
+		members[validator] = struct{}{}
+	}
+
+	signs, err := vtypes.BlockSigns(block, committee, vtypes.Approve)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := types.NewHeader(block)
+	approved := map[string]struct{}{}
+	approvers := make([]string, 0, len(signs))
+	for _, sign := range signs {
+		approver := common.BytesToAddress(sign.Address).Hex()
+		if _, exists := approved[approver]; exists {
+			continue
+		}
+
+		if _, exists := members[approver]; !exists {
+			continue
+		}
+
+		if err := vtypes.VerifyBlockSign(header, vtypes.Approve, sign); err != nil {
+			continue
+		}
+
+		approved[approver] = struct{}{}
+		approvers = append(approvers, approver)
+	}
+
+	return members, approvers, nil
+}
+
+// reorg switches canonical chain from the reverted blocks to the applied ones.
+// If one of the applied blocks is invalid, previous canonical chain is restored.
+func (s *Service) reorg(ancestor *prototype.Block, reverted, applied []*prototype.Block) error {
+	oldHead := ancestor
+	if len(reverted) > 0 {
+		oldHead = reverted[len(reverted)-1]
+	}
+	newHead := applied[len(applied)-1]
+
+	log.Warnf(
+		"Reorganize chain from #%d %s to #%d %s. Common ancestor #%d.",
+		oldHead.Num,
+		common.Encode(oldHead.Hash),
+		newHead.Num,
+		common.Encode(newHead.Hash),
+		ancestor.Num,
+	)
+
+	err := s.revertBlocks(reverted)
+	if err != nil {
+		return errors.Wrap(err, "Error reverting canonical blocks")
+	}
+
+	for i, block := range applied {
+		err = s.applyBlock(block)
+		if err == nil {
+			s.tree.Remove(block.Hash)
+			continue
+		}
+
+		log.Errorf("Invalid block #%d %s in the side branch: %s", block.Num, common.Encode(block.Hash), err)
+		s.tree.RemoveBranch(block.Hash)
+
+		// restore canonical chain
+		rerr := s.revertBlocks(applied[:i])
+		if rerr != nil {
+			return errors.Wrap(rerr, "Error reverting side branch blocks")
+		}
+
+		for _, rb := range reverted {
+			rerr = s.applyBlock(rb)
+			if rerr != nil {
+				return errors.Wrap(rerr, "Error restoring canonical blocks")
+			}
+		}
+
+		return errors.Wrap(err, "Reorg error")
+	}
+
+	// previous canonical blocks can become canonical again
+	for _, block := range reverted {
+		s.tree.Add(block)
+	}
+
+	reorgCount.Inc()
+	reorgDepth.Observe(float64(len(reverted)))
+
+	if len(reverted) > 0 {
+		s.reorgFeed.Send(&types.Reorg{
+			Ancestor: ancestor,
+			Reverted: reverted,
+			Applied:  applied,
+		})
+	}
+
+	return nil
+}
+
+// revertBlocks removes given blocks from the chain starting from the head and reloads stake pool state
+func (s *Service) revertBlocks(blocks []*prototype.Block) error {
+	for i := len(blocks) - 1; i >= 0; i-- {
+		err := s.bc.RevertBlock(blocks[i])
+		if err != nil {
+			return err
+		}
+
+		log.Warnf("Block #%d %s reverted", blocks[i].Num, common.Encode(blocks[i].Hash))
+	}
+
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	return s.att.StakePool().Reload()
+}
@@ -21,6 +21,23 @@ var (
 		Help: "Finalize block time",
 		Buckets: common.MillisecondsBuckets,
 	})
+	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "chain_reorg_count",
+		Help: "Count of the canonical chain reorganizations",
+	})
+	reorgDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "chain_reorg_depth",
+		Help: "Count of the blocks reverted by the chain reorganization",
+		Buckets: prometheus.LinearBuckets(1, 4, 16),
+	})
+	justifiedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "justified_epoch",
+		Help: "Epoch of the last justified checkpoint",
+	})
+	finalizedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "finalized_epoch",
+		Help: "Epoch of the last finalized checkpoint",
+	})
 )
 
 func updateCoreMetrics() {
@@ -0,0 +1,134 @@
+package rdochain
+
+import (
+	"sync"
+
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+)
+
+// BlockTree keeps blocks of the side branches which are not stored in the database,
+// so they can become canonical with fork choice.
+type BlockTree struct {
+	blocks   map[string]*prototype.Block    // block hash -> block
+	children map[string]map[string]struct{} // parent hash -> children hashes
+
+	mu sync.Mutex
+}
+
+func NewBlockTree() *BlockTree {
+	return &BlockTree{
+		blocks:   map[string]*prototype.Block{},
+		children: map[string]map[string]struct{}{},
+	}
+}
+
+// Add saves side branch block
+func (bt *BlockTree) Add(block *prototype.Block) {
+	hash := common.Encode(block.Hash)
+	parent := common.Encode(block.Parent)
+
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	bt.blocks[hash] = block
+
+	if _, exists := bt.children[parent]; !exists {
+		bt.children[parent] = map[string]struct{}{}
+	}
+	bt.children[parent][hash] = struct{}{}
+}
+
+// Get returns side branch block with given hash
+func (bt *BlockTree) Get(hash []byte) *prototype.Block {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	return bt.blocks[common.Encode(hash)]
+}
+
+// Has returns true if block with given hash is saved
+func (bt *BlockTree) Has(hash []byte) bool {
+	return bt.Get(hash) != nil
+}
+
+// Remove removes block from the tree. Descendants of the block are kept.
+func (bt *BlockTree) Remove(hash []byte) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	bt.remove(common.Encode(hash))
+}
+
+// RemoveBranch removes block with all its descendants
+func (bt *BlockTree) RemoveBranch(hash []byte) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	queue := []string{common.Encode(hash)}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+
+		for child := range bt.children[key] {
+			queue = append(queue, child)
+		}
+
+		bt.remove(key)
+		delete(bt.children, key)
+	}
+}
+
+// Branch returns side branch from the block with parent outside the tree to the given block ordered by num.
+func (bt *BlockTree) Branch(block *prototype.Block) []*prototype.Block {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	branch := []*prototype.Block{block}
+	for {
+		parent, exists := bt.blocks[common.Encode(branch[0].Parent)]
+		if !exists {
+			break
+		}
+
+		branch = append([]*prototype.Block{parent}, branch...)
+	}
+
+	return branch
+}
+
+// Prune removes blocks with number lower than given
+func (bt *BlockTree) Prune(minNum uint64) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	for hash, block := range bt.blocks {
+		if block.Num < minNum {
+			bt.remove(hash)
+			delete(bt.children, hash)
+		}
+	}
+}
+
+// Len returns count of the side branch blocks
+func (bt *BlockTree) Len() int {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	return len(bt.blocks)
+}
+
+func (bt *BlockTree) remove(hash string) {
+	block, exists := bt.blocks[hash]
+	if !exists {
+		return
+	}
+
+	parent := common.Encode(block.Parent)
+	delete(bt.children[parent], hash)
+	if len(bt.children[parent]) == 0 {
+		delete(bt.children, parent)
+	}
+
+	delete(bt.blocks, hash)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
 	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
 )
 
 const (
@@ -77,6 +78,9 @@ func (bc *BlockChain) Init() error {
 		return err
 	}
 
+	// bind all signatures to the network
+	types.InitSigningDomain(bc.cfg.ChainID, bc.genesisBlock.Hash)
+
 	// update counter according to the database
 	bc.mu.Lock()
 	bc.headBlockNum = head                  // head block number
@@ -149,19 +153,7 @@ func (bc *BlockChain) SaveBlock(block *prototype.Block) error {
 	blockHeadSavingTime.Observe(float64(time.Since(start).Milliseconds()))
 	start = time.Now()
 
-	var fee, reward uint64 // fee amount for block
-	for _, tx := range block.Transactions {
-		if tx.Type == common.RewardTxType || tx.Type == common.FeeTxType {
-			for _, out := range tx.Outputs {
-				if tx.Type == common.RewardTxType {
-					reward += out.Amount
-				} else {
-					fee += out.Amount
-				}
-			}
-		}
-	}
-
+	reward, fee := blockAmountStats(block)
 	err = bc.db.UpdateAmountStats(reward, fee)
 	if err != nil {
 		return err
@@ -182,6 +174,67 @@ func (bc *BlockChain) SaveBlock(block *prototype.Block) error {
 	return nil
 }
 
+// RevertBlock removes head block from the database and moves head to its parent
+func (bc *BlockChain) RevertBlock(block *prototype.Block) error {
+	head, err := bc.GetHeadBlock()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(head.Hash, block.Hash) {
+		return errors.Errorf("Block #%d %s is not the head block", block.Num, common.Encode(block.Hash))
+	}
+
+	parent, err := bc.GetBlockByHash(block.Parent)
+	if err != nil {
+		return err
+	}
+
+	if parent == nil {
+		return errors.Errorf("Parent of the block #%d is not found", block.Num)
+	}
+
+	err = bc.db.RevertBlock(block)
+	if err != nil {
+		return errors.Wrap(err, "Error reverting block in the KV")
+	}
+
+	reward, fee := blockAmountStats(block)
+	err = bc.db.RevertAmountStats(reward, fee)
+	if err != nil {
+		return err
+	}
+
+	bc.mu.Lock()
+	bc.headBlockNum--
+	bc.futureBlockNum--
+	bc.prevHash = parent.Hash
+	bc.headBlock = parent
+	bc.mu.Unlock()
+
+	headBlockNum.Dec()
+
+	return nil
+}
+
+// blockAmountStats returns minted reward and burned fee amount of the block
+func blockAmountStats(block *prototype.Block) (reward uint64, fee uint64) {
+	for _, tx := range block.Transactions {
+		if tx.Type == common.RewardTxType || tx.Type == common.FeeTxType || tx.Type == common.SlashTxType {
+			for _, out := range tx.Outputs {
+				if tx.Type == common.RewardTxType {
+					reward += out.Amount
+				} else if common.IsBurnOutput(tx, out) {
+					// burned stake leaves the supply as well as fee
+					fee += out.Amount
+				}
+			}
+		}
+	}
+
+	return reward, fee
+}
+
 // GetBlockByNum returns block from database by block number
 func (bc *BlockChain) GetBlockByNum(num uint64) (*prototype.Block, error) {
 	if num == 0 {
@@ -256,11 +309,86 @@ func (bc *BlockChain) GetTransaction(hash string) (*prototype.Transaction, error
 	return tx, nil
 }
 
+// GetTransactionBlockNum returns number of the block that includes transaction with given hash
+func (bc *BlockChain) GetTransactionBlockNum(hash []byte) (uint64, error) {
+	return bc.db.GetTransactionBlockNum(hash)
+}
+
+// GetTransactionReceipt returns receipt of the transaction with given hash
+func (bc *BlockChain) GetTransactionReceipt(hash string) (*prototype.Receipt, error) {
+	return bc.db.GetReceipt(common.HexToHash(hash).Bytes())
+}
+
+// SaveFailedReceipts saves receipts of the block transactions failed validation
+func (bc *BlockChain) SaveFailedReceipts(block *prototype.Block, txs []*types.Transaction) error {
+	return bc.db.SaveFailedReceipts(block, txs)
+}
+
+// GetAddressTransactions returns transactions of the address starting after the given cursor
+// and cursor of the next page.
+func (bc *BlockChain) GetAddressTransactions(addr []byte, cursor []byte, limit uint32, desc bool) ([]*types.AddressTx, []byte, error) {
+	return bc.db.GetAddressTransactions(addr, cursor, limit, desc)
+}
+
 // GetTransactionsCount get address nonce.
 func (bc *BlockChain) GetTransactionsCount(addr []byte) (uint64, error) {
 	return bc.db.GetTransactionsCount(addr)
 }
 
+// IsSlashed returns true if validator has been already slashed for the offence in the given slot
+func (bc *BlockChain) IsSlashed(offender []byte, slot uint64) (bool, error) {
+	return bc.db.IsSlashed(offender, slot)
+}
+
+// SaveJustifiedCheckpoint saves justified checkpoint of the epoch
+func (bc *BlockChain) SaveJustifiedCheckpoint(cp *types.Checkpoint) error {
+	return bc.db.SaveJustifiedCheckpoint(cp)
+}
+
+// GetJustifiedCheckpoint returns justified checkpoint of the given epoch or nil if epoch is not justified
+func (bc *BlockChain) GetJustifiedCheckpoint(epoch uint64) (*types.Checkpoint, error) {
+	return bc.db.GetJustifiedCheckpoint(epoch)
+}
+
+// SaveFinalizedCheckpoint saves the last finalized checkpoint
+func (bc *BlockChain) SaveFinalizedCheckpoint(cp *types.Checkpoint) error {
+	return bc.db.SaveFinalizedCheckpoint(cp)
+}
+
+// GetFinalityStatus returns the last justified and finalized checkpoints.
+// Genesis is returned when there are no checkpoints yet.
+func (bc *BlockChain) GetFinalityStatus() (*types.Checkpoint, *types.Checkpoint, error) {
+	finalized, err := bc.db.GetFinalizedCheckpoint()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if finalized == nil {
+		finalized = types.GenesisCheckpoint(bc.GetGenesis())
+	}
+
+	justified, err := bc.db.GetLastJustifiedCheckpoint()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if justified == nil || justified.Epoch < finalized.Epoch {
+		justified = finalized
+	}
+
+	return justified, finalized, nil
+}
+
+// SaveValidatorSet saves validator set of the epoch
+func (bc *BlockChain) SaveValidatorSet(set *types.ValidatorSet) error {
+	return bc.db.SaveValidatorSet(set)
+}
+
+// GetValidatorSet returns validator set of the latest processed epoch or nil if no epoch was processed
+func (bc *BlockChain) GetValidatorSet() (*types.ValidatorSet, error) {
+	return bc.db.GetValidatorSet()
+}
+
 // GetAmountStats returns total reward and fee amount
 func (bc *BlockChain) GetAmountStats() (uint64, uint64, uint64) {
 	bc.mu.Lock()
@@ -106,6 +106,7 @@ func (bc *BlockChain) createGenesis() *prototype.Block {
 			Fee:     0,
 			Data:    make([]byte, 0),
 			Timestamp: genesisData.Timestamp,
+			Legacy:    true, // keep Genesis hash the same for the existing networks
 		}
 		tx, err := types.NewPbTransaction(opts, nil)
 		if err != nil {
@@ -2,7 +2,6 @@ package rdochain
 
 import (
 	"math"
-	"strings"
 	"sync"
 	"time"
 
@@ -21,12 +20,13 @@ var (
 
 const blocksPerTx = 10
 
-func NewOutputManager(bc *BlockChain, outDB db.OutputStorage) *OutputManager {
+func NewOutputManager(bc *BlockChain, outDB db.OutputStorage, spentRetention uint64) *OutputManager {
 	om := OutputManager{
-		db:          outDB,
-		bc:          bc,
-		minBlockNum: 0,
-		maxBlockNum: 0,
+		db:             outDB,
+		bc:             bc,
+		minBlockNum:    0,
+		maxBlockNum:    0,
+		spentRetention: spentRetention,
 	}
 
 	return &om
@@ -37,6 +37,9 @@ type OutputManager struct {
 	db db.OutputStorage
 	bc *BlockChain
 
+	// number of the latest blocks which spent outputs are kept, 0 keeps all of them
+	spentRetention uint64
+
 	// sync status data
 	minBlockNum uint64
 	maxBlockNum uint64
@@ -66,14 +69,9 @@ func (om *OutputManager) ProcessBlock(block *prototype.Block) error {
 	var from common.Address
 	var startInner time.Time
 	var arows int64
-
-	var queryPart string // query data of one transaction
-	var outputsCount int // outputs count of transaction
 	var txHash common.Hash
 
-	blockOutputs := 0 // count of all block outputs
-
-	var queryBuilder strings.Builder
+	blockOutputs := make([]*types.UTxO, 0) // all block outputs
 
 	// update SQL
 	for _, tx := range block.Transactions {
@@ -86,7 +84,7 @@ func (om *OutputManager) ProcessBlock(block *prototype.Block) error {
 			from = common.BytesToAddress(tx.Inputs[0].Address)
 
 			// update tx inputs in database
-			err = om.processBlockInputs(blockTx, tx)
+			err = om.processBlockInputs(blockTx, tx, block.Num)
 			if err != nil {
 				log.Errorf("Error processing block inputs on tx %s: %s", txHash, err)
 				return err
@@ -94,29 +92,25 @@ func (om *OutputManager) ProcessBlock(block *prototype.Block) error {
 		}
 
 		// create tx outputs in the database
-		queryPart, outputsCount = om.prepareOutputsQuery(tx, from, block.Num)
-
-		// add divide coma
-		if queryBuilder.Len() > 0 && queryPart != "" {
-			queryBuilder.WriteString(", ")
-		}
-
-		queryBuilder.WriteString(queryPart)
-		blockOutputs += outputsCount
+		blockOutputs = append(blockOutputs, om.prepareOutputs(tx, from, block.Num)...)
 		updateTxDataTime.Observe(float64(time.Since(startInner).Milliseconds()))
 	}
 
-	query := queryBuilder.String()
-
 	// add all outputs batch
-	if query != "" {
-		arows, err = om.db.AddOutputBatch(blockTx, query)
-		if err != nil || arows != int64(blockOutputs) {
+	if len(blockOutputs) > 0 {
+		arows, err = om.db.AddOutputBatch(blockTx, blockOutputs)
+		if err != nil || arows != int64(len(blockOutputs)) {
 			log.Error("Error inserting outputs batch.")
-			return om.processUpdateError(arows, int64(blockOutputs), blockTx, err)
+			return om.processUpdateError(arows, int64(len(blockOutputs)), blockTx, err)
 		}
 	}
 
+	err = om.pruneSpentOutputs(blockTx, block.Num)
+	if err != nil {
+		log.Error("OutputManager.processBlock: Error pruning spent outputs.")
+		return om.rollbackAndGetError(blockTx, err)
+	}
+
 	err = om.db.CommitTx(blockTx)
 	if err != nil {
 		log.Error("OutputManager.processBlock: Error committing block transaction.")
@@ -128,6 +122,77 @@ func (om *OutputManager) ProcessBlock(block *prototype.Block) error {
 	return nil
 }
 
+// RevertBlock removes outputs created by the given block from SQL and restores outputs spent by it.
+// Pruned spent outputs are restored from the transactions stored in the KV, so it should be called before the KV revert.
+// Restored pruned output gets the new ID, so its position in the outputs history is changed by the reorg.
+func (om *OutputManager) RevertBlock(block *prototype.Block) error {
+	start := time.Now()
+
+	blockTx, err := om.db.CreateTx(true)
+	if err != nil {
+		log.Errorf("OutputManager.RevertBlock: Error creating DB tx. %s.", err)
+		return err
+	}
+
+	err = om.db.DeleteOutputs(blockTx, block.Num)
+	if err != nil {
+		return om.rollbackAndGetError(blockTx, err)
+	}
+
+	for _, tx := range block.Transactions {
+		if types.ExecutionStatus(tx.Status) == types.TxFailed {
+			continue
+		}
+
+		for _, in := range tx.Inputs {
+			uo, err := om.spentOutput(in)
+			if err != nil {
+				return om.rollbackAndGetError(blockTx, err)
+			}
+
+			err = om.db.AddOutputIfNotExists(blockTx, uo)
+			if err != nil {
+				return om.rollbackAndGetError(blockTx, err)
+			}
+		}
+	}
+
+	err = om.db.CommitTx(blockTx)
+	if err != nil {
+		log.Error("OutputManager.RevertBlock: Error committing block transaction.")
+		return om.rollbackAndGetError(blockTx, err)
+	}
+
+	log.Debugf("Revert block #%d outputs in %d ms", block.Num, time.Since(start).Milliseconds())
+
+	return nil
+}
+
+// spentOutput restores output spent by the given input from the transaction stored in the KV
+func (om *OutputManager) spentOutput(in *prototype.TxInput) (*types.UTxO, error) {
+	tx, err := om.bc.GetTransaction(common.Encode(in.Hash))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error reading spent transaction %s", common.Encode(in.Hash))
+	}
+
+	if int(in.Index) >= len(tx.Outputs) {
+		return nil, errors.Errorf("Spent output %s_%d not found", common.Encode(in.Hash), in.Index)
+	}
+
+	blockNum, err := om.bc.GetTransactionBlockNum(tx.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var from []byte
+	if common.HasInputs(tx) {
+		from = tx.Inputs[0].Address
+	}
+
+	out := tx.Outputs[in.Index]
+	return types.NewUTxO(tx.Hash, from, out.Address, out.Node, in.Index, out.Amount, blockNum, tx.Type, tx.Timestamp), nil
+}
+
 // SyncData synchronize SQL data with KV.
 func (om *OutputManager) SyncData() error {
 	lastSQLBlockNum, err := om.db.FindLastBlockNum()
@@ -206,12 +271,12 @@ func (om *OutputManager) syncBlock(block *prototype.Block, blockTx int) error {
 			continue
 		}
 
-		for _, in := range tx.Inputs {
+		for i, in := range tx.Inputs {
 			hash = common.BytesToHash(in.Hash)
 
 			// no need to check affected rows
-			// because it is needed to remove them all
-			_, err := om.db.SpendOutput(blockTx, hash.Hex(), in.Index)
+			// because it is needed to spend them all
+			_, err := om.db.SpendOutput(blockTx, hash.Hex(), in.Index, block.Num, common.Encode(tx.Hash), uint32(i))
 			if err != nil {
 				return om.rollbackAndGetError(blockTx, err)
 			}
@@ -225,8 +290,8 @@ func (om *OutputManager) syncBlock(block *prototype.Block, blockTx int) error {
 
 		index = 0
 		for _, out := range tx.Outputs {
-			// skip all fee outputs
-			if tx.Type == common.FeeTxType && common.BytesToAddress(out.Address).Hex() == common.BlackHoleAddress {
+			// skip all fee and burn outputs
+			if common.IsBurnOutput(tx, out) {
 				continue
 			}
 
@@ -296,6 +361,13 @@ func (om *OutputManager) syncDataInRange(min, max uint64, clear bool) error {
 		if counter%blocksPerTx == 0 {
 			// commit tx
 			if blockNum != min {
+				if !clear {
+					err = om.pruneSpentOutputs(tx, blockNum-1)
+					if err != nil {
+						return om.rollbackAndGetError(tx, err)
+					}
+				}
+
 				err = om.db.CommitTx(tx)
 				if err != nil {
 					return err
@@ -344,6 +416,13 @@ func (om *OutputManager) syncDataInRange(min, max uint64, clear bool) error {
 		counter++
 	}
 
+	if !clear {
+		err = om.pruneSpentOutputs(tx, max)
+		if err != nil {
+			return om.rollbackAndGetError(tx, err)
+		}
+	}
+
 	err = om.db.CommitTx(tx)
 	if err != nil {
 		return err
@@ -352,6 +431,22 @@ func (om *OutputManager) syncDataInRange(min, max uint64, clear bool) error {
 	return nil
 }
 
+// pruneSpentOutputs removes outputs spent earlier than spentRetention blocks before the given block
+func (om *OutputManager) pruneSpentOutputs(blockTx int, blockNum uint64) error {
+	if om.spentRetention == 0 || blockNum <= om.spentRetention {
+		return nil
+	}
+
+	pruned, err := om.db.PruneSpentOutputs(blockTx, blockNum-om.spentRetention)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Prune %d spent outputs on block #%d", pruned, blockNum)
+
+	return nil
+}
+
 // rollbackAndGetError rollback tx and return error
 func (om *OutputManager) rollbackAndGetError(txId int, err error) error {
 	res := err.Error()
@@ -389,54 +484,45 @@ func (om *OutputManager) processUpdateError(arows, targetRows int64, blockTx int
 }
 
 // processBlockInputs updates all transaction inputs in the database with given DB tx
-func (om *OutputManager) processBlockInputs(blockTx int, tx *prototype.Transaction) error {
+func (om *OutputManager) processBlockInputs(blockTx int, tx *prototype.Transaction, blockNum uint64) error {
 	var start time.Time
 	txHash := common.Encode(tx.Hash)
 
 	// update inputs
-	for _, in := range tx.Inputs {
+	for i, in := range tx.Inputs {
 		start = time.Now()
 		hash := common.BytesToHash(in.Hash).Hex()
 
-		arows, err := om.db.SpendOutput(blockTx, hash, in.Index)
+		arows, err := om.db.SpendOutput(blockTx, hash, in.Index, blockNum, txHash, uint32(i))
 		if err != nil || arows != 1 {
-			log.WithError(err).Errorf("Error deleting input: %s_%d.", hash, in.Index)
+			log.WithError(err).Errorf("Error spending input: %s_%d.", hash, in.Index)
 			return om.processUpdateError(arows, 1, blockTx, err)
 		}
 
-		log.Debugf("Delete input %s on %s", serialize.GenKeyFromPbInput(in), txHash)
+		log.Debugf("Spend input %s on %s", serialize.GenKeyFromPbInput(in), txHash)
 		inputsSavingTime.Observe(float64(time.Since(start).Milliseconds()))
 	}
 
 	return nil
 }
 
-// prepareOutputsQuery creates all transaction outputs query for db
-func (om *OutputManager) prepareOutputsQuery(tx *prototype.Transaction, from common.Address, blockNum uint64) (string, int) {
-	var uo *types.UTxO
-	counter := 0
+// prepareOutputs creates all transaction outputs for db
+func (om *OutputManager) prepareOutputs(tx *prototype.Transaction, from common.Address, blockNum uint64) []*types.UTxO {
+	outputs := make([]*types.UTxO, 0, len(tx.Outputs))
 
-	var queryBuilder strings.Builder
 	var index uint32
 	for _, out := range tx.Outputs {
-		// skip all fee outputs
-		if tx.Type == common.FeeTxType {
+		// skip all fee and burn outputs
+		if common.IsBurnOutput(tx, out) {
 			index++
 			continue
 		}
 
-		uo = types.NewUTxO(tx.Hash, from.Bytes(), out.Address, out.Node, index, out.Amount, blockNum, tx.Type, tx.Timestamp)
-
-		if counter > 0 {
-			queryBuilder.WriteString(", ")
-		}
-
-		queryBuilder.WriteString(uo.ToInsertQuery())
-		counter++
+		outputs = append(outputs, types.NewUTxO(tx.Hash, from.Bytes(), out.Address, out.Node, index, out.Amount, blockNum, tx.Type, tx.Timestamp))
 		index++
 	}
 
-	return queryBuilder.String(), counter
+	return outputs
 }
 
 // GetSyncStatus return current block num, max block num and percent of synchronisation.
@@ -453,6 +539,16 @@ func (om *OutputManager) GetSyncStatus() (uint64, uint64, float64) {
 	return om.minBlockNum, om.maxBlockNum, percent
 }
 
+// FindOutput returns output with given hash and index including spent one if it is not pruned
+func (om *OutputManager) FindOutput(hash string, index uint32) (*types.UTxO, error) {
+	return om.db.FindOutput(hash, index)
+}
+
+// FindOutputsHistory returns address outputs including spent ones created after the cursor output
+func (om *OutputManager) FindOutputsHistory(addr string, cursor uint64, limit uint32) ([]*types.UTxO, error) {
+	return om.db.FindOutputsHistory(addr, cursor, limit)
+}
+
 // FindStakeDeposits return list of stake deposits actual to the moment of block with given num.
 func (om *OutputManager) FindStakeDeposits() ([]*types.UTxO, error) {
 	return om.db.FindStakeDeposits()
@@ -26,14 +26,14 @@ var (
 	ErrNotForgedBlock = errors.New("Given block number is not forged yet.")
 )
 
-func NewService(kv db.BlockStorage, sql db.OutputStorage, stateFeed *events.Feed, repairDB bool) (*Service, error) {
+func NewService(kv db.BlockStorage, sql db.OutputStorage, stateFeed *events.Feed, repairDB bool, spentRetention uint64) (*Service, error) {
 	cfg := params.RaidoConfig()
 
 	// create blockchain instance
 	bc := NewBlockChain(kv, cfg)
 
 	// output manager
-	outm := NewOutputManager(bc, sql)
+	outm := NewOutputManager(bc, sql, spentRetention)
 
 	srv := &Service{
 		bc:        bc,
@@ -228,6 +228,54 @@ func (s *Service) GetTransaction(hash string) (*prototype.Transaction, error) {
 	return s.bc.GetTransaction(hash)
 }
 
+// GetTransactionReceipt returns receipt of the transaction with given hash.
+func (s *Service) GetTransactionReceipt(hash string) (*prototype.Receipt, error) {
+	return s.bc.GetTransactionReceipt(hash)
+}
+
+// GetAddressTransactions returns transactions sent or received by the address starting after the given cursor.
+// Empty cursor means the first page, empty next cursor means there are no more transactions.
+func (s *Service) GetAddressTransactions(addr string, cursor string, limit uint32, desc bool) ([]*types.AddressTx, string, error) {
+	var position []byte
+	if cursor != "" {
+		var err error
+		position, err = common.Decode(cursor)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "Wrong cursor")
+		}
+	}
+
+	txs, next, err := s.bc.GetAddressTransactions(common.HexToAddress(addr).Bytes(), position, limit, desc)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if next == nil {
+		return txs, "", nil
+	}
+
+	return txs, common.Encode(next), nil
+}
+
+// GetOutput returns output with given hash and index including spent one.
+func (s *Service) GetOutput(hash string, index uint32) (*types.UTxO, error) {
+	uo, err := s.outm.FindOutput(hash, index)
+	if err != nil {
+		return nil, err
+	}
+
+	if uo == nil {
+		return nil, errors.Errorf("Output %s_%d is not found", hash, index)
+	}
+
+	return uo, nil
+}
+
+// GetOutputsHistory returns address outputs including spent ones with ID greater than the cursor.
+func (s *Service) GetOutputsHistory(addr string, cursor uint64, limit uint32) ([]*types.UTxO, error) {
+	return s.outm.FindOutputsHistory(addr, cursor, limit)
+}
+
 // GetStakeDeposits returns all address stake deposits.
 func (s *Service) GetStakeDeposits(addr string, node string) ([]*types.UTxO, error) {
 	return s.outm.FindStakeDepositsOfAddress(addr, node)
@@ -241,6 +289,51 @@ func (s *Service) GetTransactionsCount(addr []byte) (uint64, error) {
 	return s.bc.GetTransactionsCount(addr)
 }
 
+// IsSlashed returns true if validator has been already slashed for the offence in the given slot
+func (s *Service) IsSlashed(offender []byte, slot uint64) (bool, error) {
+	return s.bc.IsSlashed(offender, slot)
+}
+
+// SaveJustifiedCheckpoint saves justified checkpoint of the epoch
+func (s *Service) SaveJustifiedCheckpoint(cp *types.Checkpoint) error {
+	return s.bc.SaveJustifiedCheckpoint(cp)
+}
+
+// GetJustifiedCheckpoint returns justified checkpoint of the given epoch or nil if epoch is not justified
+func (s *Service) GetJustifiedCheckpoint(epoch uint64) (*types.Checkpoint, error) {
+	return s.bc.GetJustifiedCheckpoint(epoch)
+}
+
+// SaveFinalizedCheckpoint saves the last finalized checkpoint
+func (s *Service) SaveFinalizedCheckpoint(cp *types.Checkpoint) error {
+	return s.bc.SaveFinalizedCheckpoint(cp)
+}
+
+// SaveFailedReceipts saves receipts of the block transactions failed validation
+func (s *Service) SaveFailedReceipts(block *prototype.Block, txs []*types.Transaction) error {
+	return s.bc.SaveFailedReceipts(block, txs)
+}
+
+// GetSigningDomain returns network chain id and Genesis hash bound into the signatures
+func (s *Service) GetSigningDomain() *types.SigningDomain {
+	return types.GetSigningDomain()
+}
+
+// GetFinalityStatus returns the last justified and finalized checkpoints
+func (s *Service) GetFinalityStatus() (*types.Checkpoint, *types.Checkpoint, error) {
+	return s.bc.GetFinalityStatus()
+}
+
+// SaveValidatorSet saves validator set of the epoch
+func (s *Service) SaveValidatorSet(set *types.ValidatorSet) error {
+	return s.bc.SaveValidatorSet(set)
+}
+
+// GetValidatorSet returns validator set of the latest processed epoch
+func (s *Service) GetValidatorSet() (*types.ValidatorSet, error) {
+	return s.bc.GetValidatorSet()
+}
+
 // GetLatestBlock returns the head block of blockchain
 func (s *Service) GetLatestBlock() (*prototype.Block, error) {
 	return s.bc.GetHeadBlock()
@@ -298,6 +391,31 @@ func (s *Service) FinalizeBlock(block *prototype.Block) error {
 	return nil
 }
 
+// RevertBlock removes head block from the local databases
+func (s *Service) RevertBlock(block *prototype.Block) error {
+	s.outm.FinalizeLock()
+	defer s.outm.FinalizeUnlock()
+
+	// SQL is reverted first, because spent outputs are restored from the KV
+	err := s.outm.RevertBlock(block)
+	if err != nil {
+		return errors.Wrap(err, "SQL error")
+	}
+
+	err = s.bc.RevertBlock(block)
+	if err != nil {
+		log.Debug("Resync database on fail")
+		syncErr := s.outm.SyncData()
+		if syncErr != nil {
+			log.Errorf("Error syncing databases %s", syncErr)
+		}
+
+		return errors.Wrap(err, "KV error")
+	}
+
+	return nil
+}
+
 func (s *Service) GetHeadBlock() (*prototype.Block, error) {
 	return s.bc.GetHeadBlock()
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/blockchain/consensus"
 	"github.com/raidoNetwork/RDO_v2/blockchain/consensus/attestation"
+	"github.com/raidoNetwork/RDO_v2/blockchain/core/rdochain"
 	"github.com/raidoNetwork/RDO_v2/blockchain/core/slot"
 	"github.com/raidoNetwork/RDO_v2/blockchain/state"
 	rsync "github.com/raidoNetwork/RDO_v2/blockchain/sync"
@@ -32,6 +33,7 @@ type Config struct {
 	AttestationPool consensus.AttestationPool
 	StateFeed       *events.Feed
 	BlockFeed       *events.Feed
+	ReorgFeed       *events.Feed
 	Context         context.Context
 }
 
@@ -57,6 +59,10 @@ func NewService(cliCtx *cli.Context, cfg *Config) (*Service, error) {
 		// feeds
 		blockFeed: cfg.BlockFeed,
 		stateFeed: cfg.StateFeed,
+		reorgFeed: cfg.ReorgFeed,
+
+		// side branches
+		tree: rdochain.NewBlockTree(),
 
 		// synced indicated whether the initial sync is complete
 		synced: false,
@@ -92,6 +98,10 @@ type Service struct {
 
 	blockFeed *events.Feed
 	stateFeed *events.Feed
+	reorgFeed *events.Feed
+
+	// side branches blocks
+	tree *rdochain.BlockTree
 }
 
 // Start service work
@@ -151,6 +161,11 @@ func (s *Service) mainLoop() {
 				}
 			}
 
+			if errors.Is(err, ErrSideBranchBlock) {
+				log.Debugf("Block #%d saved to the side branch time %d ms", block.Num, time.Since(start).Milliseconds())
+				continue
+			}
+
 			if err != nil {
 				log.Errorf("[CoreService] Error finalizing block: %s", err.Error())
 
@@ -222,6 +237,37 @@ func (s *Service) FinalizeBlock(block *prototype.Block) error {
 		return s.att.Validator().ValidateGenesis(block)
 	}
 
+	// block doesn't extend the head
+	if !bytes.Equal(block.Parent, s.bc.ParentHash()) {
+		head, err := s.bc.GetHeadBlock()
+		if err != nil {
+			return err
+		}
+
+		err = s.processForkBlock(block, head)
+		if err != nil {
+			return err
+		}
+
+		finalizeBlockTime.Observe(float64(time.Since(start).Milliseconds()))
+		return nil
+	}
+
+	err := s.applyBlock(block)
+	if err != nil {
+		return err
+	}
+
+	if block.Num > maxForkDepth {
+		s.tree.Prune(block.Num - maxForkDepth)
+	}
+
+	finalizeBlockTime.Observe(float64(time.Since(start).Milliseconds()))
+	return nil
+}
+
+// applyBlock validates block that extends the head and saves it to the chain
+func (s *Service) applyBlock(block *prototype.Block) error {
 	// validate block
 	failedTx, err := s.att.Validator().ValidateBlock(block, true)
 	if err != nil {
@@ -238,11 +284,30 @@ func (s *Service) FinalizeBlock(block *prototype.Block) error {
 		return errors.Wrap(err, "FinalizeBlockError")
 	}
 
+	// add validation errors to the receipts of the failed transactions
+	if len(failedTx) > 0 {
+		err = s.bc.SaveFailedReceipts(block, failedTx)
+		if err != nil {
+			log.Errorf("Error saving failed transactions receipts: %s", err)
+		}
+	}
+
 	typedBatch := utypes.PbTxBatchToTyped(block.Transactions)
 
 	// clear pool
 	s.att.TxPool().Finalize(typedBatch)
 
+	// votes are counted with the validator set and stakes of the finished epoch
+	err = s.processFinality(block)
+	if err != nil {
+		return errors.Wrap(err, "Finality error")
+	}
+
+	err = s.processValidatorSet(block)
+	if err != nil {
+		return errors.Wrap(err, "Validator set error")
+	}
+
 	// update stake pool data
 	err = s.att.StakePool().FinalizeStaking(typedBatch)
 	if err != nil {
@@ -254,6 +319,5 @@ func (s *Service) FinalizeBlock(block *prototype.Block) error {
 		return errors.Wrap(err, "Balances inconsistency")
 	}
 
-	finalizeBlockTime.Observe(float64(time.Since(start).Milliseconds()))
 	return nil
 }
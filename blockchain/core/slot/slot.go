@@ -160,6 +160,14 @@ func (st *SlotTicker) Slot() uint64 {
 	return st.slot
 }
 
+// CurrentSlot returns slot number according to the time passed since genesis
+func (st *SlotTicker) CurrentSlot() uint64 {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return st.currentSlot(st.genesisTime)
+}
+
 func (st *SlotTicker) Epoch() uint64 {
 	st.mu.Lock()
 	defer st.mu.Unlock()
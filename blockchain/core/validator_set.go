@@ -0,0 +1,31 @@
+package core
+
+import (
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+// processValidatorSet updates validator set when the given block starts a new epoch.
+// Set is updated with the staking state of the previous epoch, so it should be called before block staking is finalized.
+func (s *Service) processValidatorSet(block *prototype.Block) error {
+	set := s.att.StakePool().ProcessEpoch(types.EpochOf(block.Slot), block.Num)
+	if set == nil {
+		return nil
+	}
+
+	err := s.bc.SaveValidatorSet(set)
+	if err != nil {
+		return errors.Wrap(err, "Error saving validator set")
+	}
+
+	log.Warnf(
+		"Epoch %d validator set: %d active, %d in the activation queue, %d in the exit queue",
+		set.Epoch,
+		len(set.Active),
+		len(set.ActivationQueue),
+		len(set.ExitQueue),
+	)
+
+	return nil
+}
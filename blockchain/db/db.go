@@ -2,8 +2,25 @@ package db
 
 import (
 	"context"
+
+	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/blockchain/db/kv"
 	"github.com/raidoNetwork/RDO_v2/blockchain/db/utxo"
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/utxo/embedded"
+)
+
+const (
+	// MySQLBackend stores outputs in the MySQL server
+	MySQLBackend = utxo.MySQLDialect
+
+	// PostgresBackend stores outputs in the PostgreSQL server
+	PostgresBackend = utxo.PostgresDialect
+
+	// SQLiteBackend stores outputs in the SQLite database file in the data directory
+	SQLiteBackend = utxo.SQLiteDialect
+
+	// BoltBackend stores outputs in the embedded BoltDB database in the data directory
+	BoltBackend = "bolt"
 )
 
 // NewDB initializes a new DB.
@@ -13,5 +30,12 @@ func NewDB(ctx context.Context, dirPath string) (Database, error) {
 
 // NewUTxODB initializes a new UTxO DB.
 func NewUTxODB(ctx context.Context, config *SQLConfig) (OutputDatabase, error) {
-	return utxo.NewStore(ctx, config)
+	switch config.Backend {
+	case "", MySQLBackend, PostgresBackend, SQLiteBackend:
+		return utxo.NewStore(ctx, config)
+	case BoltBackend:
+		return embedded.NewStore(ctx, config)
+	default:
+		return nil, errors.Errorf("Unknown outputs storage backend %s", config.Backend)
+	}
 }
@@ -12,12 +12,22 @@ type ChainStorage interface {
 	WriteBlock(*prototype.Block) error
 	CountBlocks() (int, error)
 
+	// RevertBlock removes head block from the storage and restores accounts state
+	RevertBlock(*prototype.Block) error
+
+	// SaveFailedReceipts saves receipts of the block transactions failed validation
+	SaveFailedReceipts(*prototype.Block, []*types.Transaction) error
+
 	UpdateAmountStats(uint64, uint64) error
+	RevertAmountStats(uint64, uint64) error
 	GetAmountStats() (uint64, uint64)
 
 	HeadAccessStorage
 	BlockReader
 	TransactionReader
+	SlashingReader
+	FinalityStorage
+	ValidatorSetStorage
 }
 
 // BlockReader interface to access blocks
@@ -49,6 +59,46 @@ type TransactionReader interface {
 
 	// GetTransactionsCount return nonce of given address
 	GetTransactionsCount([]byte) (uint64, error)
+
+	// GetTransactionBlockNum returns number of the block that includes tx with given hash
+	GetTransactionBlockNum([]byte) (uint64, error)
+
+	// GetReceipt returns receipt of the tx with given hash
+	GetReceipt([]byte) (*prototype.Receipt, error)
+
+	// GetAddressTransactions returns transactions of the address starting after the given cursor
+	// and cursor of the next page.
+	GetAddressTransactions(addr []byte, cursor []byte, limit uint32, desc bool) ([]*types.AddressTx, []byte, error)
+}
+
+type SlashingReader interface {
+	// IsSlashed returns true if validator has been already slashed for the offence in the given slot
+	IsSlashed([]byte, uint64) (bool, error)
+}
+
+type FinalityStorage interface {
+	// SaveJustifiedCheckpoint saves justified checkpoint of the epoch
+	SaveJustifiedCheckpoint(*types.Checkpoint) error
+
+	// GetJustifiedCheckpoint returns justified checkpoint of the given epoch or nil if epoch is not justified
+	GetJustifiedCheckpoint(uint64) (*types.Checkpoint, error)
+
+	// GetLastJustifiedCheckpoint returns justified checkpoint with the highest epoch
+	GetLastJustifiedCheckpoint() (*types.Checkpoint, error)
+
+	// SaveFinalizedCheckpoint saves the last finalized checkpoint
+	SaveFinalizedCheckpoint(*types.Checkpoint) error
+
+	// GetFinalizedCheckpoint returns the last finalized checkpoint
+	GetFinalizedCheckpoint() (*types.Checkpoint, error)
+}
+
+type ValidatorSetStorage interface {
+	// SaveValidatorSet saves validator set of the epoch
+	SaveValidatorSet(*types.ValidatorSet) error
+
+	// GetValidatorSet returns validator set of the latest processed epoch
+	GetValidatorSet() (*types.ValidatorSet, error)
 }
 
 // Database common database interface
@@ -64,8 +114,8 @@ type OutputStorage interface {
 	// AddOutputIfNotExists - add unspent output to the database in database transaction.
 	AddOutputIfNotExists(int, *types.UTxO) error
 
-	// AddOutputBatch add outputs batch data to the database
-	AddOutputBatch(int, string) (int64, error)
+	// AddOutputBatch add outputs batch to the database in database transaction and returns count of added outputs.
+	AddOutputBatch(int, []*types.UTxO) (int64, error)
 
 	// FindAllUTxO finds all unspent outputs according to user address.
 	FindAllUTxO(string) ([]*types.UTxO, error)
@@ -79,14 +129,25 @@ type OutputStorage interface {
 	// RollbackTx - rollbacks all changes made by tx.
 	RollbackTx(int) error
 
-	SpendOutput(txID int, hash string, index uint32) (int64, error)
+	// SpendOutput marks output as spent by the input with given index of the transaction in the given block.
+	SpendOutput(txID int, hash string, index uint32, blockNum uint64, spentTxHash string, spentIndex uint32) (int64, error)
 
 	// FindLastBlockNum find maximum block id in the SQL
 	FindLastBlockNum() (uint64, error)
 
-	// DeleteOutputs remove all outputs with given block num.
+	// DeleteOutputs remove all outputs with given block num and restores outputs spent in this block.
 	DeleteOutputs(int, uint64) error
 
+	// PruneSpentOutputs removes outputs spent in the blocks with number less or equal to the given one.
+	PruneSpentOutputs(int, uint64) (int64, error)
+
+	// FindOutput returns output with given hash and index including spent one or nil if output is not found.
+	FindOutput(hash string, index uint32) (*types.UTxO, error)
+
+	// FindOutputsHistory returns address outputs including spent ones with ID greater than the cursor ordered by ID.
+	// Pruned output restored with AddOutputIfNotExists gets the new ID.
+	FindOutputsHistory(addr string, cursor uint64, limit uint32) ([]*types.UTxO, error)
+
 	GetTotalAmount() (uint64, error)
 
 	ClearDatabase() error
@@ -118,4 +179,6 @@ type OutputDatabase interface {
 type SQLConfig struct {
 	ConfigPath string
 	DataDir    string
+	Backend    string // Backend is the outputs storage backend: mysql, postgres, sqlite or bolt
+	DryRun     bool   // DryRun logs pending schema migrations without applying them
 }
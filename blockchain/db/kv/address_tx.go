@@ -0,0 +1,301 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync/atomic"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	"github.com/raidoNetwork/RDO_v2/utils/serialize"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// addressTxCursorSize is the size of the transaction position: block num + tx index
+	addressTxCursorSize = 12
+
+	// addressTxSyncBatch is the number of stored blocks indexed in one database transaction
+	addressTxSyncBatch = 1000
+)
+
+var ErrAddressTxIndexing = errors.New("Address transactions are indexing, try again later")
+
+// saveAddressTransactions indexes block transactions by addresses of its inputs and outputs.
+// Index key is address + block num + tx index, value is the block hash.
+func (s *Store) saveAddressTransactions(tx *bolt.Tx, block *prototype.Block) error {
+	bkt := tx.Bucket(addressTxBucket)
+
+	for i, transaction := range block.Transactions {
+		for _, addr := range txAddresses(transaction) {
+			if err := bkt.Put(genAddressTxKey(addr, block.Num, uint32(i)), block.Hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteAddressTransactions removes index records of the block transactions
+func (s *Store) deleteAddressTransactions(tx *bolt.Tx, block *prototype.Block) error {
+	bkt := tx.Bucket(addressTxBucket)
+
+	for i, transaction := range block.Transactions {
+		for _, addr := range txAddresses(transaction) {
+			key := genAddressTxKey(addr, block.Num, uint32(i))
+
+			// record can be overwritten by another block with the same number
+			if !bytes.Equal(bkt.Get(key), block.Hash) {
+				continue
+			}
+
+			if err := bkt.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetAddressTransactions returns transactions sent or received by the address in the chain order
+// starting after the given cursor. Cursor of the next page is returned if there are more transactions.
+func (s *Store) GetAddressTransactions(addr []byte, cursor []byte, limit uint32, desc bool) ([]*types.AddressTx, []byte, error) {
+	if atomic.LoadInt32(&s.addressTxSyncing) == 1 {
+		return nil, nil, ErrAddressTxIndexing
+	}
+
+	if cursor != nil && len(cursor) != addressTxCursorSize {
+		return nil, nil, errors.Errorf("Wrong cursor size %d", len(cursor))
+	}
+
+	prefix := append(make([]byte, 0, common.AddressLength+addressTxCursorSize), addr...)
+	res := make([]*types.AddressTx, 0)
+	var next []byte
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(addressTxBucket).Cursor()
+		hashBkt := tx.Bucket(blocksHashBucket)
+
+		var key, value []byte
+		if desc {
+			start := cursor
+			if start == nil {
+				start = bytes.Repeat([]byte{0xff}, addressTxCursorSize)
+			}
+
+			if key, _ = c.Seek(append(prefix, start...)); key == nil {
+				key, value = c.Last()
+			} else {
+				key, value = c.Prev()
+			}
+		} else {
+			key, value = c.Seek(append(prefix, cursor...))
+			if cursor != nil && bytes.Equal(key, append(prefix, cursor...)) {
+				key, value = c.Next()
+			}
+		}
+
+		move := c.Next
+		if desc {
+			move = c.Prev
+		}
+
+		var block *prototype.Block
+		for ; key != nil && bytes.HasPrefix(key, prefix); key, value = move() {
+			num := binary.BigEndian.Uint64(key[len(prefix):])
+			index := binary.BigEndian.Uint32(key[len(prefix)+8:])
+
+			// skip transactions of the blocks not included to the chain
+			if !bytes.Equal(hashBkt.Get(genHashKey(num)), value) {
+				continue
+			}
+
+			// next page exists only if there is one more chain transaction
+			if len(res) == int(limit) {
+				last := res[len(res)-1]
+				next = genAddressTxKey(nil, last.BlockNum, last.Index)
+				break
+			}
+
+			if block == nil || block.Num != num {
+				var err error
+				block, _, err = s.getBlockByLink(tx, ssz.MarshalUint32(genBlockKey(num, value), index))
+				if err != nil {
+					return err
+				}
+			}
+
+			if int(index) >= len(block.Transactions) {
+				return errors.Errorf("Wrong transaction index %d with block transaction count: %d", index, len(block.Transactions))
+			}
+
+			res = append(res, &types.AddressTx{
+				BlockNum: num,
+				Index:    index,
+				Tx:       block.Transactions[index],
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, next, nil
+}
+
+// startAddressTransactionsSync starts indexing of the stored blocks in the background if it isn't finished.
+// Address transactions can't be listed until indexing is done.
+func (s *Store) startAddressTransactionsSync() error {
+	var from uint64
+	var syncing bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		val := tx.Bucket(metaBucket).Get(addressTxSyncKey)
+		if len(val) == 8 {
+			from = ssz.UnmarshallUint64(val)
+			syncing = true
+		}
+
+		return nil
+	})
+	if err != nil || !syncing {
+		return err
+	}
+
+	atomic.StoreInt32(&s.addressTxSyncing, 1)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		if err := s.syncAddressTransactions(from); err != nil {
+			log.Errorf("Error indexing address transactions: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+// syncAddressTransactions indexes blocks stored before the address transactions index was created.
+// Progress is saved after every batch, so indexing continues after the node restart.
+// Blocks written during indexing are indexed with WriteBlock.
+func (s *Store) syncAddressTransactions(from uint64) error {
+	head, err := s.GetHeadBlockNum()
+	if errors.Is(err, ErrNoHead) {
+		return s.finishAddressTransactionsSync()
+	}
+	if err != nil {
+		return err
+	}
+
+	if head >= from {
+		log.Infof("Indexing address transactions of blocks %d - %d.", from, head)
+	}
+
+	for from <= head {
+		select {
+		case <-s.quit:
+			log.Infof("Address transactions indexing is stopped on block %d.", from)
+			return nil
+		default:
+		}
+
+		to := from + addressTxSyncBatch
+		if to > head+1 {
+			to = head + 1
+		}
+
+		err = s.db.Update(func(tx *bolt.Tx) error {
+			for num := from; num < to; num++ {
+				hash := tx.Bucket(blocksHashBucket).Get(genHashKey(num))
+				if hash == nil {
+					continue
+				}
+
+				enc := tx.Bucket(blocksBucket).Get(genBlockKey(num, hash))
+				if enc == nil {
+					continue
+				}
+
+				block, err := serialize.UnmarshalBlock(enc)
+				if err != nil {
+					return err
+				}
+
+				if err := s.saveAddressTransactions(tx, block); err != nil {
+					return err
+				}
+			}
+
+			return tx.Bucket(metaBucket).Put(addressTxSyncKey, ssz.MarshalUint64(nil, to))
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debugf("Indexed address transactions up to block %d.", to-1)
+
+		from = to
+	}
+
+	return s.finishAddressTransactionsSync()
+}
+
+// finishAddressTransactionsSync removes sync progress, so all blocks are indexed with WriteBlock only
+func (s *Store) finishAddressTransactionsSync() error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(metaBucket).Delete(addressTxSyncKey)
+	})
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt32(&s.addressTxSyncing, 0)
+	log.Info("Address transactions indexing is finished.")
+
+	return nil
+}
+
+// txAddresses returns unique addresses of the transaction inputs and outputs
+func txAddresses(transaction *prototype.Transaction) [][]byte {
+	addresses := make([][]byte, 0, len(transaction.Inputs)+len(transaction.Outputs))
+	seen := map[string]struct{}{}
+
+	add := func(addr []byte) {
+		if len(addr) != common.AddressLength {
+			return
+		}
+
+		if _, exists := seen[string(addr)]; exists {
+			return
+		}
+
+		seen[string(addr)] = struct{}{}
+		addresses = append(addresses, addr)
+	}
+
+	for _, in := range transaction.Inputs {
+		add(in.Address)
+	}
+
+	for _, out := range transaction.Outputs {
+		add(out.Address)
+	}
+
+	return addresses
+}
+
+// genAddressTxKey returns address transactions index key: address + block num + tx index.
+// Big endian numbers keep keys ordered by the transaction position in the chain.
+func genAddressTxKey(addr []byte, num uint64, index uint32) []byte {
+	key := make([]byte, 0, len(addr)+addressTxCursorSize)
+	key = append(key, addr...)
+	key = binary.BigEndian.AppendUint64(key, num)
+	return binary.BigEndian.AppendUint32(key, index)
+}
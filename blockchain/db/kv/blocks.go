@@ -89,6 +89,16 @@ func (s *Store) WriteBlock(block *prototype.Block) error {
 		end = time.Since(start)
 		log.Debugf("WriteBlock: Save transaction in hash map in %s", common.StatFmt(end))
 
+		if err := s.saveSlashings(tx, block); err != nil {
+			log.Error("Error saving block slashings.")
+			return err
+		}
+
+		if err := s.saveAddressTransactions(tx, block); err != nil {
+			log.Error("Error saving address transactions index.")
+			return err
+		}
+
 		return nil
 	})
 }
@@ -0,0 +1,155 @@
+package kv
+
+import (
+	"bytes"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+const checkpointSize = 32 + 5*8
+
+// SaveJustifiedCheckpoint saves justified checkpoint of the epoch
+func (s *Store) SaveJustifiedCheckpoint(cp *types.Checkpoint) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(finalityBucket).Put(genJustifiedKey(cp.Epoch), marshalCheckpoint(cp))
+	})
+}
+
+// GetJustifiedCheckpoint returns justified checkpoint of the given epoch or nil if epoch is not justified
+func (s *Store) GetJustifiedCheckpoint(epoch uint64) (*types.Checkpoint, error) {
+	var cp *types.Checkpoint
+	err := s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(finalityBucket).Get(genJustifiedKey(epoch))
+		if raw == nil {
+			return nil
+		}
+
+		var err error
+		cp, err = unmarshalCheckpoint(raw)
+		return err
+	})
+
+	return cp, err
+}
+
+// GetLastJustifiedCheckpoint returns justified checkpoint with the highest epoch or nil if nothing is justified
+func (s *Store) GetLastJustifiedCheckpoint() (*types.Checkpoint, error) {
+	var last *types.Checkpoint
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(finalityBucket).Cursor()
+
+		for k, v := c.Seek(justifiedPrefix); k != nil && bytes.HasPrefix(k, justifiedPrefix); k, v = c.Next() {
+			cp, err := unmarshalCheckpoint(v)
+			if err != nil {
+				return err
+			}
+
+			if last == nil || cp.Epoch > last.Epoch {
+				last = cp
+			}
+		}
+
+		return nil
+	})
+
+	return last, err
+}
+
+// SaveFinalizedCheckpoint saves finalized checkpoint and removes justified checkpoints of the previous epochs
+func (s *Store) SaveFinalizedCheckpoint(cp *types.Checkpoint) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(finalityBucket)
+
+		err := deleteCheckpoints(bkt, func(justified *types.Checkpoint) bool {
+			return justified.Epoch < cp.Epoch
+		})
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(finalizedKey, marshalCheckpoint(cp))
+	})
+}
+
+// GetFinalizedCheckpoint returns the last finalized checkpoint or nil if nothing is finalized
+func (s *Store) GetFinalizedCheckpoint() (*types.Checkpoint, error) {
+	var cp *types.Checkpoint
+	err := s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(finalityBucket).Get(finalizedKey)
+		if raw == nil {
+			return nil
+		}
+
+		var err error
+		cp, err = unmarshalCheckpoint(raw)
+		return err
+	})
+
+	return cp, err
+}
+
+// revertCheckpoints removes justified checkpoints processed by the reverted block.
+// Finalized checkpoint is never reverted.
+func (s *Store) revertCheckpoints(tx *bolt.Tx, num uint64) error {
+	return deleteCheckpoints(tx.Bucket(finalityBucket), func(justified *types.Checkpoint) bool {
+		return justified.ProcessedNum >= num
+	})
+}
+
+func deleteCheckpoints(bkt *bolt.Bucket, filter func(*types.Checkpoint) bool) error {
+	keys := make([][]byte, 0)
+
+	c := bkt.Cursor()
+	for k, v := c.Seek(justifiedPrefix); k != nil && bytes.HasPrefix(k, justifiedPrefix); k, v = c.Next() {
+		cp, err := unmarshalCheckpoint(v)
+		if err != nil {
+			return err
+		}
+
+		if filter(cp) {
+			keys = append(keys, append([]byte{}, k...))
+		}
+	}
+
+	for _, key := range keys {
+		if err := bkt.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func marshalCheckpoint(cp *types.Checkpoint) []byte {
+	buf := make([]byte, 0, checkpointSize)
+	buf = ssz.MarshalUint64(buf, cp.Epoch)
+	buf = ssz.MarshalUint64(buf, cp.Num)
+	buf = append(buf, cp.Hash...)
+	buf = ssz.MarshalUint64(buf, cp.VotedStake)
+	buf = ssz.MarshalUint64(buf, cp.TotalStake)
+	return ssz.MarshalUint64(buf, cp.ProcessedNum)
+}
+
+func unmarshalCheckpoint(raw []byte) (*types.Checkpoint, error) {
+	if len(raw) != checkpointSize {
+		return nil, errors.New("Broken checkpoint data")
+	}
+
+	return &types.Checkpoint{
+		Epoch:        ssz.UnmarshallUint64(raw[:8]),
+		Num:          ssz.UnmarshallUint64(raw[8:16]),
+		Hash:         append([]byte{}, raw[16:48]...),
+		VotedStake:   ssz.UnmarshallUint64(raw[48:56]),
+		TotalStake:   ssz.UnmarshallUint64(raw[56:64]),
+		ProcessedNum: ssz.UnmarshallUint64(raw[64:72]),
+	}, nil
+}
+
+func genJustifiedKey(epoch uint64) []byte {
+	key := make([]byte, 0, len(justifiedPrefix)+8)
+	key = append(key, justifiedPrefix...)
+	return ssz.MarshalUint64(key, epoch)
+}
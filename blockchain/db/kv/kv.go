@@ -2,6 +2,7 @@ package kv
 
 import (
 	"context"
+	ssz "github.com/ferranbt/fastssz"
 	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/shared/params"
 	"github.com/raidoNetwork/RDO_v2/utils/file"
@@ -9,6 +10,7 @@ import (
 	bolt "go.etcd.io/bbolt"
 	"os"
 	"path"
+	"sync"
 	"time"
 )
 
@@ -28,6 +30,11 @@ type Store struct {
 	db           *bolt.DB
 	databasePath string
 	ctx          context.Context
+
+	// addressTxSyncing is 1 while stored blocks are indexed by addresses
+	addressTxSyncing int32
+	quit             chan struct{}
+	wg               sync.WaitGroup
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -64,22 +71,43 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 		db:           boltDB,
 		databasePath: dirPath,
 		ctx:          ctx,
+		quit:         make(chan struct{}),
 	}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
-		return createBuckets(
+		// address transactions index is created for the existing database,
+		// so stored blocks should be indexed too
+		hasAddressTx := tx.Bucket(addressTxBucket) != nil
+
+		err := createBuckets(
 			tx,
 			blocksBucket,
 			blocksHashBucket,
 			blocksNumBucket,
 			blocksSlotBucket,
 			transactionBucket,
+			receiptBucket,
 			addressBucket,
+			slashingBucket,
+			undoBucket,
+			finalityBucket,
+			validatorSetBucket,
+			addressTxBucket,
+			metaBucket,
 		)
+		if err != nil || hasAddressTx {
+			return err
+		}
+
+		return tx.Bucket(metaBucket).Put(addressTxSyncKey, ssz.MarshalUint64(nil, 0))
 	}); err != nil {
 		return nil, err
 	}
 
+	if err := kv.startAddressTransactionsSync(); err != nil {
+		return nil, errors.Wrap(err, "Error indexing address transactions")
+	}
+
 	return kv, err
 }
 
@@ -111,8 +139,11 @@ func (s *Store) ClearDB() error {
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close stops background indexing and closes the underlying BoltDB database.
 func (s *Store) Close() error {
+	close(s.quit)
+	s.wg.Wait()
+
 	return s.db.Close()
 }
 
@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
 	"github.com/raidoNetwork/RDO_v2/utils/serialize"
 	bolt "go.etcd.io/bbolt"
 )
@@ -23,6 +24,11 @@ func (s *Store) saveTransactions(tx *bolt.Tx, block *prototype.Block) error {
 		if err != nil {
 			return err
 		}
+
+		err = s.saveReceipt(tx, block, i)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -57,11 +63,30 @@ func (s *Store) GetTransactionByHash(hash []byte) (*prototype.Transaction, error
 	return txRes, nil
 }
 
+// GetTransactionBlockNum returns number of the block that includes transaction with given hash
+func (s *Store) GetTransactionBlockNum(hash []byte) (uint64, error) {
+	key := genTxHashKey(hash)
+	var num uint64
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		blockLink := tx.Bucket(transactionBucket).Get(key)
+		if len(blockLink) < len(blockPrefix)+8 {
+			return errors.New("Undefined transaction")
+		}
+
+		num = ssz.UnmarshallUint64(blockLink[len(blockPrefix) : len(blockPrefix)+8])
+		return nil
+	})
+
+	return num, err
+}
+
 // updateBlockAccountState updates transaction map for all block data.
+// Previous account states are saved to the undo journal of the block.
 func (s *Store) updateBlockAccountState(tx *bolt.Tx, block *prototype.Block) error {
-	//blockKey := genBlockKey(block.Num, block.Hash)
-
 	bkt := tx.Bucket(addressBucket)
+	undo := make([]byte, 0)
+	touched := map[string]struct{}{}
 
 	for _, transaction := range block.Transactions {
 		if common.IsSystemTx(transaction) {
@@ -72,6 +97,10 @@ func (s *Store) updateBlockAccountState(tx *bolt.Tx, block *prototype.Block) err
 		key := genAddrKey(addr)
 
 		buf := bkt.Get(key)
+		if _, exists := touched[string(addr)]; !exists {
+			touched[string(addr)] = struct{}{}
+			undo = appendUndoEntry(undo, addr, buf)
+		}
 		if buf == nil {
 			buf = make([]byte, 0, 8)
 			buf = ssz.MarshalUint64(buf, transaction.Num)
@@ -82,6 +111,11 @@ func (s *Store) updateBlockAccountState(tx *bolt.Tx, block *prototype.Block) err
 				return errors.Errorf("Wrong nonce given: %d. Expected: %d or %d", transaction.Num, nonce, nonce+1)
 			}
 
+			// nonce is advanced by every sender transaction since the activation block
+			if types.SenderNoncesEnabled(block.Num) {
+				nonce = transaction.Num
+			}
+
 			buf = ssz.MarshalUint64(nil, nonce)
 		}
 
@@ -91,11 +125,21 @@ func (s *Store) updateBlockAccountState(tx *bolt.Tx, block *prototype.Block) err
 		}
 	}
 
-	return nil
+	return tx.Bucket(undoBucket).Put(genBlockKey(block.Num, block.Hash), undo)
 }
 
 // getTransactionByLink find transaction with given link of block hash and tx index.
 func (s *Store) getTransactionByLink(tx *bolt.Tx, lnk []byte) (*prototype.Transaction, error) {
+	block, index, err := s.getBlockByLink(tx, lnk)
+	if err != nil {
+		return nil, err
+	}
+
+	return block.Transactions[index], nil
+}
+
+// getBlockByLink returns block and transaction index of the given transaction link.
+func (s *Store) getBlockByLink(tx *bolt.Tx, lnk []byte) (*prototype.Block, int, error) {
 	size := len(lnk)
 	blockKey := lnk[:size-4]
 	index32 := ssz.UnmarshallUint32(lnk[size-4:])
@@ -104,24 +148,24 @@ func (s *Store) getTransactionByLink(tx *bolt.Tx, lnk []byte) (*prototype.Transa
 	blockBuf := blockBkt.Get(blockKey)
 
 	if blockBuf == nil {
-		return nil, errors.Errorf("Undefined block with key %s", blockKey)
+		return nil, 0, errors.Errorf("Undefined block with key %s", blockKey)
 	}
 
 	block, err := serialize.UnmarshalBlock(blockBuf)
 	if err != nil {
-		return nil, errors.Errorf("Block unmarshal error: %s", err)
+		return nil, 0, errors.Errorf("Block unmarshal error: %s", err)
 	}
 
 	index := int(index32)
 	if index < 0 {
-		return nil, errors.Errorf("Wrong transaciton index %d", index)
+		return nil, 0, errors.Errorf("Wrong transaciton index %d", index)
 	}
 
-	if index > len(block.Transactions) {
-		return nil, errors.Errorf("Wrong transaction index %d with block transaction count: %d", index, len(block.Transactions))
+	if index >= len(block.Transactions) {
+		return nil, 0, errors.Errorf("Wrong transaction index %d with block transaction count: %d", index, len(block.Transactions))
 	}
 
-	return block.Transactions[index], nil
+	return block, index, nil
 }
 
 // GetTransactionsCount return nonce of given address
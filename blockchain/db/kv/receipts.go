@@ -0,0 +1,84 @@
+package kv
+
+import (
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// saveReceipt saves receipt of the block transaction with given index
+func (s *Store) saveReceipt(tx *bolt.Tx, block *prototype.Block, index int) error {
+	receipt, err := types.NewReceipt(block, index)
+	if err != nil {
+		return err
+	}
+
+	enc, err := receipt.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket(receiptBucket).Put(genReceiptKey(receipt.TxHash), enc)
+}
+
+// SaveFailedReceipts saves receipts of the block transactions failed validation with their validation errors
+func (s *Store) SaveFailedReceipts(block *prototype.Block, txs []*types.Transaction) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(receiptBucket)
+		for _, failed := range txs {
+			receipt, err := types.NewFailedReceipt(block, failed)
+			if err != nil {
+				return err
+			}
+
+			enc, err := receipt.MarshalSSZ()
+			if err != nil {
+				return err
+			}
+
+			if err := bkt.Put(genReceiptKey(receipt.TxHash), enc); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetReceipt returns receipt of the transaction with given hash.
+// Receipts of the blocks saved before receipts were introduced are built from the block.
+func (s *Store) GetReceipt(hash []byte) (*prototype.Receipt, error) {
+	var receipt *prototype.Receipt
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(receiptBucket).Get(genReceiptKey(hash))
+		if enc != nil {
+			receipt = new(prototype.Receipt)
+			return receipt.UnmarshalSSZ(enc)
+		}
+
+		lnk := tx.Bucket(transactionBucket).Get(genTxHashKey(hash))
+		if lnk == nil {
+			return errors.New("Undefined transaction")
+		}
+
+		block, index, err := s.getBlockByLink(tx, lnk)
+		if err != nil {
+			return err
+		}
+
+		receipt, err = types.NewReceipt(block, index)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+func genReceiptKey(hash []byte) []byte {
+	return append(receiptPrefix, hash...)
+}
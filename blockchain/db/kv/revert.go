@@ -0,0 +1,190 @@
+package kv
+
+import (
+	"bytes"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// RevertBlock removes head block with all its indexes from the database, restores accounts state
+// from the block undo journal and moves head to the parent block.
+func (s *Store) RevertBlock(block *prototype.Block) error {
+	key := genBlockKey(block.Num, block.Hash)
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		if bkt.Get(key) == nil {
+			return errors.Errorf("block #%d %s not found", block.Num, common.Encode(block.Hash))
+		}
+
+		if err := s.revertAccountState(tx, key); err != nil {
+			return errors.Wrap(err, "Error reverting accounts state")
+		}
+
+		if err := bkt.Delete(key); err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(blocksNumBucket).Delete(genNumKey(block.Hash)); err != nil {
+			return err
+		}
+
+		// indexes by num and slot point to the canonical block only
+		hashBkt := tx.Bucket(blocksHashBucket)
+		if bytes.Equal(hashBkt.Get(genHashKey(block.Num)), block.Hash) {
+			if err := hashBkt.Delete(genHashKey(block.Num)); err != nil {
+				return err
+			}
+		}
+
+		slotBkt := tx.Bucket(blocksSlotBucket)
+		slotVal := slotBkt.Get(genSlotKey(block.Slot))
+		if len(slotVal) == 8 && ssz.UnmarshallUint64(slotVal) == block.Num {
+			if err := slotBkt.Delete(genSlotKey(block.Slot)); err != nil {
+				return err
+			}
+		}
+
+		if err := s.deleteTransactions(tx, key, block); err != nil {
+			return err
+		}
+
+		if err := s.deleteSlashings(tx, block); err != nil {
+			return err
+		}
+
+		if err := s.deleteAddressTransactions(tx, block); err != nil {
+			return err
+		}
+
+		if err := s.revertCheckpoints(tx, block.Num); err != nil {
+			return err
+		}
+
+		if err := s.revertValidatorSets(tx, block.Num); err != nil {
+			return err
+		}
+
+		return bkt.Put(lastBlockKey, ssz.MarshalUint64(nil, block.Num-1))
+	})
+}
+
+// RevertAmountStats subtracts reward and fee of the reverted block from the net amount statistics.
+func (s *Store) RevertAmountStats(reward, fee uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(addressBucket)
+		raw := bkt.Get(statsKey)
+		if raw == nil {
+			return errors.New("Amount stats not found")
+		}
+
+		rewardTotal, feeTotal := unmarshalStats(raw)
+		if rewardTotal < reward || feeTotal < fee {
+			return errors.New("Amount stats underflow")
+		}
+
+		return bkt.Put(statsKey, marshalStats(rewardTotal-reward, feeTotal-fee))
+	})
+}
+
+// revertAccountState restores accounts state saved in the undo journal of the block with given key
+func (s *Store) revertAccountState(tx *bolt.Tx, blockKey []byte) error {
+	undoBkt := tx.Bucket(undoBucket)
+	undo := undoBkt.Get(blockKey)
+	if undo == nil {
+		return errors.New("Undo journal not found")
+	}
+
+	bkt := tx.Bucket(addressBucket)
+	for len(undo) > 0 {
+		if len(undo) < common.AddressLength+1 {
+			return errors.New("Broken undo journal")
+		}
+
+		key := genAddrKey(undo[:common.AddressLength])
+		exists := undo[common.AddressLength] == 1
+		undo = undo[common.AddressLength+1:]
+
+		if !exists {
+			if err := bkt.Delete(key); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if len(undo) < 8 {
+			return errors.New("Broken undo journal")
+		}
+
+		if err := bkt.Put(key, append([]byte{}, undo[:8]...)); err != nil {
+			return err
+		}
+
+		undo = undo[8:]
+	}
+
+	return undoBkt.Delete(blockKey)
+}
+
+// deleteTransactions removes links to the block transactions
+func (s *Store) deleteTransactions(tx *bolt.Tx, blockKey []byte, block *prototype.Block) error {
+	bkt := tx.Bucket(transactionBucket)
+
+	for _, transaction := range block.Transactions {
+		key := genTxHashKey(transaction.Hash)
+
+		// transaction can be linked to another block already
+		link := bkt.Get(key)
+		if link == nil || !bytes.HasPrefix(link, blockKey) {
+			continue
+		}
+
+		if err := bkt.Delete(key); err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(receiptBucket).Delete(genReceiptKey(transaction.Hash)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteSlashings removes slashing records of the block
+func (s *Store) deleteSlashings(tx *bolt.Tx, block *prototype.Block) error {
+	bkt := tx.Bucket(slashingBucket)
+
+	for _, transaction := range block.Transactions {
+		if transaction.Type != common.SlashTxType {
+			continue
+		}
+
+		evidence := new(prototype.SlashingEvidence)
+		if err := evidence.UnmarshalSSZ(transaction.Data); err != nil {
+			return errors.Wrap(err, "Error unmarshaling slashing evidence")
+		}
+
+		if err := bkt.Delete(genSlashingKey(evidence.First.Signature.Address, evidence.First.Slot)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appendUndoEntry adds previous state of the address to the undo journal
+func appendUndoEntry(undo []byte, addr []byte, state []byte) []byte {
+	undo = append(undo, addr...)
+	if state == nil {
+		return append(undo, 0)
+	}
+
+	undo = append(undo, 1)
+	return append(undo, state...)
+}
@@ -1,12 +1,19 @@
 package kv
 
 var (
-	blocksBucket      = []byte("blocks")
-	blocksHashBucket  = []byte("blocks-hash")
-	blocksNumBucket   = []byte("blocks-num")
-	blocksSlotBucket  = []byte("blocks-slot")
-	transactionBucket = []byte("transaction")
-	addressBucket     = []byte("address-state")
+	blocksBucket       = []byte("blocks")
+	blocksHashBucket   = []byte("blocks-hash")
+	blocksNumBucket    = []byte("blocks-num")
+	blocksSlotBucket   = []byte("blocks-slot")
+	transactionBucket  = []byte("transaction")
+	receiptBucket      = []byte("receipt")
+	addressBucket      = []byte("address-state")
+	slashingBucket     = []byte("slashing")
+	undoBucket         = []byte("block-undo")
+	finalityBucket     = []byte("finality")
+	validatorSetBucket = []byte("validator-set")
+	addressTxBucket    = []byte("address-tx")
+	metaBucket         = []byte("meta")
 
 	lastBlockKey      = []byte("last-block")
 	genesisBlockKey   = []byte("genesis-block")
@@ -15,7 +22,13 @@ var (
 	blockSlotPrefix   = []byte("block-slot")
 	blockPrefix       = []byte("block")
 	transactionPrefix = []byte("tx-hash")
+	receiptPrefix     = []byte("tx-receipt")
 	addressPrefix     = []byte("address")
+	slashingPrefix    = []byte("slashing")
+	justifiedPrefix   = []byte("justified")
 
-	statsKey = []byte("statistic")
+	statsKey     = []byte("statistic")
+	finalizedKey = []byte("finalized")
+
+	addressTxSyncKey = []byte("address-tx-sync")
 )
@@ -0,0 +1,56 @@
+package kv
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// saveSlashings create map offender + slot -> block num for all block slash transactions
+func (s *Store) saveSlashings(tx *bolt.Tx, block *prototype.Block) error {
+	bkt := tx.Bucket(slashingBucket)
+
+	for _, transaction := range block.Transactions {
+		if transaction.Type != common.SlashTxType {
+			continue
+		}
+
+		evidence := new(prototype.SlashingEvidence)
+		if err := evidence.UnmarshalSSZ(transaction.Data); err != nil {
+			return errors.Wrap(err, "Error unmarshaling slashing evidence")
+		}
+
+		key := genSlashingKey(evidence.First.Signature.Address, evidence.First.Slot)
+		buf := make([]byte, 0, 8)
+		buf = ssz.MarshalUint64(buf, block.Num)
+
+		if err := bkt.Put(key, buf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsSlashed returns true if validator has been already slashed for the offence in the given slot
+func (s *Store) IsSlashed(offender []byte, slot uint64) (bool, error) {
+	key := genSlashingKey(offender, slot)
+	exists := false
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slashingBucket)
+		exists = bkt.Get(key) != nil
+		return nil
+	})
+
+	return exists, err
+}
+
+func genSlashingKey(offender []byte, slot uint64) []byte {
+	key := make([]byte, 0, len(slashingPrefix)+len(offender)+8)
+	key = append(key, slashingPrefix...)
+	key = append(key, offender...)
+	return ssz.MarshalUint64(key, slot)
+}
@@ -0,0 +1,163 @@
+package kv
+
+import (
+	"encoding/binary"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// maxValidatorSets is the count of the last epoch validator sets kept to restore set after the chain reorganisation
+const maxValidatorSets = 64
+
+// SaveValidatorSet saves validator set of the epoch and removes the oldest sets
+func (s *Store) SaveValidatorSet(set *types.ValidatorSet) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorSetBucket)
+
+		err := bkt.Put(genValidatorSetKey(set.Epoch), marshalValidatorSet(set))
+		if err != nil {
+			return err
+		}
+
+		if set.Epoch < maxValidatorSets {
+			return nil
+		}
+
+		return deleteValidatorSets(bkt, func(k []byte, _ *types.ValidatorSet) bool {
+			return binary.BigEndian.Uint64(k) <= set.Epoch-maxValidatorSets
+		})
+	})
+}
+
+// GetValidatorSet returns validator set of the latest processed epoch or nil if there are no sets
+func (s *Store) GetValidatorSet() (*types.ValidatorSet, error) {
+	var set *types.ValidatorSet
+	err := s.db.View(func(tx *bolt.Tx) error {
+		_, raw := tx.Bucket(validatorSetBucket).Cursor().Last()
+		if raw == nil {
+			return nil
+		}
+
+		var err error
+		set, err = unmarshalValidatorSet(raw)
+		return err
+	})
+
+	return set, err
+}
+
+// revertValidatorSets removes validator sets processed by the reverted block
+func (s *Store) revertValidatorSets(tx *bolt.Tx, num uint64) error {
+	return deleteValidatorSets(tx.Bucket(validatorSetBucket), func(_ []byte, set *types.ValidatorSet) bool {
+		return set.ProcessedNum >= num
+	})
+}
+
+func deleteValidatorSets(bkt *bolt.Bucket, filter func([]byte, *types.ValidatorSet) bool) error {
+	keys := make([][]byte, 0)
+
+	c := bkt.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		set, err := unmarshalValidatorSet(v)
+		if err != nil {
+			return err
+		}
+
+		if filter(k, set) {
+			keys = append(keys, append([]byte{}, k...))
+		}
+	}
+
+	for _, key := range keys {
+		if err := bkt.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func marshalValidatorSet(set *types.ValidatorSet) []byte {
+	size := 5*8 + (len(set.Active)+len(set.ActivationQueue)+len(set.ExitQueue))*common.AddressLength + len(set.Active)*8
+	buf := make([]byte, 0, size)
+	buf = ssz.MarshalUint64(buf, set.Epoch)
+	buf = ssz.MarshalUint64(buf, set.ProcessedNum)
+
+	for _, list := range [][]string{set.Active, set.ActivationQueue, set.ExitQueue} {
+		buf = ssz.MarshalUint64(buf, uint64(len(list)))
+		for _, validator := range list {
+			buf = append(buf, common.HexToAddress(validator).Bytes()...)
+		}
+	}
+
+	// weights follow the active list order
+	for _, validator := range set.Active {
+		buf = ssz.MarshalUint64(buf, set.Weights[validator])
+	}
+
+	return buf
+}
+
+func unmarshalValidatorSet(raw []byte) (*types.ValidatorSet, error) {
+	if len(raw) < 16 {
+		return nil, errors.New("Broken validator set data")
+	}
+
+	set := &types.ValidatorSet{
+		Epoch:        ssz.UnmarshallUint64(raw[:8]),
+		ProcessedNum: ssz.UnmarshallUint64(raw[8:16]),
+	}
+	raw = raw[16:]
+
+	lists := make([][]string, 3)
+	for i := range lists {
+		if len(raw) < 8 {
+			return nil, errors.New("Broken validator set data")
+		}
+
+		count := ssz.UnmarshallUint64(raw[:8])
+		raw = raw[8:]
+
+		if uint64(len(raw)) < count*common.AddressLength {
+			return nil, errors.New("Broken validator set data")
+		}
+
+		lists[i] = make([]string, 0, count)
+		for j := uint64(0); j < count; j++ {
+			lists[i] = append(lists[i], common.BytesToAddress(raw[:common.AddressLength]).Hex())
+			raw = raw[common.AddressLength:]
+		}
+	}
+
+	set.Active = lists[0]
+	set.ActivationQueue = lists[1]
+	set.ExitQueue = lists[2]
+	set.Weights = make(map[string]uint64, len(set.Active))
+
+	// sets saved before the stake snapshot have no weights
+	if len(raw) == 0 {
+		return set, nil
+	}
+
+	if len(raw) != len(set.Active)*8 {
+		return nil, errors.New("Broken validator set weights")
+	}
+
+	for _, validator := range set.Active {
+		set.Weights[validator] = ssz.UnmarshallUint64(raw[:8])
+		raw = raw[8:]
+	}
+
+	return set, nil
+}
+
+// genValidatorSetKey uses big endian epoch to keep sets ordered in the bucket
+func genValidatorSetKey(epoch uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, epoch)
+	return key
+}
@@ -6,4 +6,8 @@ const (
 	HashToTxIndex     = "hash_to_index"
 	BlockIdIndex      = "block_id_index"
 	TxTypeToNodeIndex = "tx_type_to_node_index"
+	AddrToIdIndex     = "addr_to_id_index"
+	SpentBlockIndex   = "spent_block_index"
+
+	SchemaVersionTable = "schema_version"
 )
@@ -0,0 +1,88 @@
+package utxo
+
+import (
+	"database/sql"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/iface"
+)
+
+const (
+	MySQLDialect    = "mysql"    // MySQLDialect works with MySQL server
+	PostgresDialect = "postgres" // PostgresDialect works with PostgreSQL server
+	SQLiteDialect   = "sqlite"   // SQLiteDialect works with SQLite database file in the data directory
+)
+
+// Dialect hides SQL syntax and driver differences of the supported databases.
+// Queries are written with ? placeholders and standard SQL functions.
+type Dialect interface {
+	// Name returns dialect name
+	Name() string
+
+	// DriverName returns database/sql driver name
+	DriverName() string
+
+	// DataSource returns driver connection string
+	DataSource(*iface.SQLConfig) (string, error)
+
+	// Schema returns statements creating outputs table and its indexes
+	Schema() []string
+
+	// Rebind replaces ? placeholders with the dialect ones
+	Rebind(string) string
+
+	// OnConflictUpdate returns insert query suffix updating given columns when unique key is duplicated
+	OnConflictUpdate(key []string, columns ...string) string
+
+	// TxIsolation returns database transaction isolation level
+	TxIsolation(isolated bool) sql.IsolationLevel
+}
+
+// newDialect returns dialect with given name, MySQL is used by default
+func newDialect(name string) (Dialect, error) {
+	switch name {
+	case "", MySQLDialect:
+		return &mysqlDialect{}, nil
+	case PostgresDialect:
+		return &postgresDialect{}, nil
+	case SQLiteDialect:
+		return &sqliteDialect{}, nil
+	default:
+		return nil, errors.Errorf("Unknown SQL dialect %s", name)
+	}
+}
+
+// serverConfig is the database server connection config
+type serverConfig struct {
+	user string
+	pass string
+	host string
+	port string
+	name string
+}
+
+// loadServerConfig loads database server connection config from the env file
+func loadServerConfig(path string) (*serverConfig, error) {
+	if path == "" {
+		return nil, errors.New("Empty database cfg path.")
+	}
+
+	err := godotenv.Load(path)
+	if err != nil {
+		return nil, errors.Errorf("Error loading .env file %s. Error message: %s", path, err)
+	}
+
+	cfg := &serverConfig{
+		user: os.Getenv("DB_USER"),
+		pass: os.Getenv("DB_PASS"),
+		host: os.Getenv("DB_HOST"),
+		port: os.Getenv("DB_PORT"),
+		name: os.Getenv("DB_NAME"),
+	}
+
+	log.Debugf("SQL database server: %s:%s", cfg.host, cfg.port)
+
+	return cfg, nil
+}
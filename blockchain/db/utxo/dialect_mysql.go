@@ -0,0 +1,54 @@
+package utxo
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/iface"
+)
+
+type mysqlDialect struct{}
+
+func (d *mysqlDialect) Name() string {
+	return MySQLDialect
+}
+
+func (d *mysqlDialect) DriverName() string {
+	return "mysql"
+}
+
+func (d *mysqlDialect) DataSource(config *iface.SQLConfig) (string, error) {
+	cfg, err := loadServerConfig(config.ConfigPath)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s", cfg.user, cfg.pass, cfg.host, cfg.port, cfg.name), nil
+}
+
+func (d *mysqlDialect) Schema() []string {
+	return []string{mysqlSchema}
+}
+
+func (d *mysqlDialect) Rebind(query string) string {
+	return query
+}
+
+func (d *mysqlDialect) OnConflictUpdate(_ []string, columns ...string) string {
+	values := make([]string, len(columns))
+	for i, col := range columns {
+		values[i] = fmt.Sprintf("%s = VALUES(%s)", col, col)
+	}
+
+	return "ON DUPLICATE KEY UPDATE " + strings.Join(values, ", ")
+}
+
+func (d *mysqlDialect) TxIsolation(isolated bool) sql.IsolationLevel {
+	if isolated {
+		return sql.LevelSerializable
+	}
+
+	return sql.LevelDefault
+}
@@ -0,0 +1,92 @@
+package utxo
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	_ "github.com/lib/pq"
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/iface"
+)
+
+type postgresDialect struct{}
+
+func (d *postgresDialect) Name() string {
+	return PostgresDialect
+}
+
+func (d *postgresDialect) DriverName() string {
+	return "postgres"
+}
+
+// DataSource uses DB_SSLMODE env param in addition to the common ones, SSL is disabled by default.
+func (d *postgresDialect) DataSource(config *iface.SQLConfig) (string, error) {
+	cfg, err := loadServerConfig(config.ConfigPath)
+	if err != nil {
+		return "", err
+	}
+
+	sslMode := os.Getenv("DB_SSLMODE")
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.user, cfg.pass),
+		Host:     cfg.host + ":" + cfg.port,
+		Path:     cfg.name,
+		RawQuery: url.Values{"sslmode": []string{sslMode}}.Encode(),
+	}
+
+	return dsn.String(), nil
+}
+
+func (d *postgresDialect) Schema() []string {
+	return postgresSchema
+}
+
+// Rebind replaces ? placeholders with the numbered ones: $1, $2, ...
+func (d *postgresDialect) Rebind(query string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 10)
+
+	n := 0
+	for _, c := range query {
+		if c != '?' {
+			b.WriteRune(c)
+			continue
+		}
+
+		n++
+		b.WriteByte('$')
+		b.WriteString(strconv.Itoa(n))
+	}
+
+	return b.String()
+}
+
+func (d *postgresDialect) OnConflictUpdate(key []string, columns ...string) string {
+	return onConflictUpdate(key, columns)
+}
+
+func (d *postgresDialect) TxIsolation(isolated bool) sql.IsolationLevel {
+	if isolated {
+		return sql.LevelSerializable
+	}
+
+	return sql.LevelDefault
+}
+
+// onConflictUpdate returns upsert clause used by PostgreSQL and SQLite
+func onConflictUpdate(key []string, columns []string) string {
+	values := make([]string, len(columns))
+	for i, col := range columns {
+		values[i] = fmt.Sprintf("%s = excluded.%s", col, col)
+	}
+
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(key, ", "), strings.Join(values, ", "))
+}
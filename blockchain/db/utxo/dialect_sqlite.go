@@ -0,0 +1,63 @@
+package utxo
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/iface"
+	"github.com/raidoNetwork/RDO_v2/utils/file"
+)
+
+// SQLiteFileName is the name of the SQLite outputs database stored in the node data directory
+const SQLiteFileName = "outputs.sqlite"
+
+type sqliteDialect struct{}
+
+func (d *sqliteDialect) Name() string {
+	return SQLiteDialect
+}
+
+func (d *sqliteDialect) DriverName() string {
+	return "sqlite3"
+}
+
+// DataSource returns path of the database file in the data directory, so MySQL config is not needed.
+// Write transactions lock database immediately to avoid deadlocks of the concurrent writers.
+func (d *sqliteDialect) DataSource(config *iface.SQLConfig) (string, error) {
+	if config.DataDir == "" {
+		return "", errors.New("Empty outputs database directory.")
+	}
+
+	hasDir, err := file.HasDir(config.DataDir)
+	if err != nil {
+		return "", err
+	}
+	if !hasDir {
+		if err := file.MkdirAll(config.DataDir); err != nil {
+			return "", err
+		}
+	}
+
+	path := filepath.Join(config.DataDir, SQLiteFileName)
+	return fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate", path), nil
+}
+
+func (d *sqliteDialect) Schema() []string {
+	return sqliteSchema
+}
+
+func (d *sqliteDialect) Rebind(query string) string {
+	return query
+}
+
+func (d *sqliteDialect) OnConflictUpdate(key []string, columns ...string) string {
+	return onConflictUpdate(key, columns)
+}
+
+// TxIsolation returns default level, because SQLite transactions are always serializable
+func (d *sqliteDialect) TxIsolation(bool) sql.IsolationLevel {
+	return sql.LevelDefault
+}
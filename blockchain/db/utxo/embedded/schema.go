@@ -0,0 +1,162 @@
+package embedded
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+var (
+	// outputsBucket keeps outputs by hash and index
+	outputsBucket = []byte("outputs")
+
+	// addressBucket indexes unspent outputs by receiver and node address
+	addressBucket = []byte("address-index")
+
+	// blockBucket indexes outputs by block number
+	blockBucket = []byte("block-index")
+
+	// stakeBucket indexes stake deposits by node address
+	stakeBucket = []byte("stake-index")
+
+	// spentBucket indexes spent outputs by spent block number
+	spentBucket = []byte("spent-index")
+
+	// historyBucket indexes all outputs by receiver address and output ID
+	historyBucket = []byte("history-index")
+
+	buckets = [][]byte{outputsBucket, addressBucket, blockBucket, stakeBucket, spentBucket, historyBucket}
+)
+
+const outputKeySize = common.HashLength + 4
+
+// genOutputKey returns output key: hash + index
+func genOutputKey(hash common.Hash, index uint32) []byte {
+	key := make([]byte, 0, outputKeySize)
+	key = append(key, hash.Bytes()...)
+	return binary.BigEndian.AppendUint32(key, index)
+}
+
+// appendAddress appends address with the length prefix, so empty node differs from the zero address node
+func appendAddress(buf []byte, addr common.Address) []byte {
+	buf = append(buf, byte(len(addr)))
+	return append(buf, addr...)
+}
+
+// genAddressKey returns address index key: receiver + node + output key
+func genAddressKey(uo *types.UTxO) []byte {
+	key := appendAddress(nil, uo.To)
+	key = appendAddress(key, uo.Node)
+	return append(key, genOutputKey(uo.Hash, uo.Index)...)
+}
+
+// genBlockKey returns block index key: block number + output key
+func genBlockKey(uo *types.UTxO) []byte {
+	key := binary.BigEndian.AppendUint64(nil, uo.BlockNum)
+	return append(key, genOutputKey(uo.Hash, uo.Index)...)
+}
+
+// genStakeKey returns stake index key: node + output key
+func genStakeKey(uo *types.UTxO) []byte {
+	key := appendAddress(nil, uo.Node)
+	return append(key, genOutputKey(uo.Hash, uo.Index)...)
+}
+
+// genSpentKey returns spent index key: spent block number + output key
+func genSpentKey(uo *types.UTxO) []byte {
+	key := binary.BigEndian.AppendUint64(nil, uo.SpentBlock)
+	return append(key, genOutputKey(uo.Hash, uo.Index)...)
+}
+
+// genHistoryKey returns history index key: receiver + output ID + output key
+func genHistoryKey(uo *types.UTxO) []byte {
+	key := appendAddress(nil, uo.To)
+	key = binary.BigEndian.AppendUint64(key, uo.ID)
+	return append(key, genOutputKey(uo.Hash, uo.Index)...)
+}
+
+// isStakeDeposit returns true if output is the actual stake deposit
+func isStakeDeposit(uo *types.UTxO) bool {
+	switch uo.TxType {
+	case common.StakeTxType, common.UnstakeTxType, common.SlashTxType:
+		return len(uo.Node) > 0
+	default:
+		return false
+	}
+}
+
+// encodeOutput serializes output:
+// id + block num + index + amount + timestamp + type + hash + from + to + node.
+// Spent output has tail: spent block + spent index + spent tx hash.
+func encodeOutput(uo *types.UTxO) []byte {
+	buf := make([]byte, 0, 40+common.HashLength+3+3*common.AddressLength)
+	buf = binary.BigEndian.AppendUint64(buf, uo.ID)
+	buf = binary.BigEndian.AppendUint64(buf, uo.BlockNum)
+	buf = binary.BigEndian.AppendUint32(buf, uo.Index)
+	buf = binary.BigEndian.AppendUint64(buf, uo.Amount)
+	buf = binary.BigEndian.AppendUint64(buf, uo.Timestamp)
+	buf = binary.BigEndian.AppendUint32(buf, uo.TxType)
+	buf = append(buf, uo.Hash.Bytes()...)
+	buf = appendAddress(buf, uo.From)
+	buf = appendAddress(buf, uo.To)
+	buf = appendAddress(buf, uo.Node)
+
+	if uo.IsSpent() {
+		buf = binary.BigEndian.AppendUint64(buf, uo.SpentBlock)
+		buf = binary.BigEndian.AppendUint32(buf, uo.SpentIndex)
+
+		spentHash := make([]byte, common.HashLength)
+		copy(spentHash, uo.SpentTxHash)
+		buf = append(buf, spentHash...)
+	}
+
+	return buf
+}
+
+// decodeOutput parses output serialized with encodeOutput
+func decodeOutput(enc []byte) (*types.UTxO, error) {
+	const fixedSize = 40 + common.HashLength
+	if len(enc) < fixedSize {
+		return nil, errors.New("Broken output data")
+	}
+
+	uo := &types.UTxO{
+		ID:        binary.BigEndian.Uint64(enc[0:8]),
+		BlockNum:  binary.BigEndian.Uint64(enc[8:16]),
+		Index:     binary.BigEndian.Uint32(enc[16:20]),
+		Amount:    binary.BigEndian.Uint64(enc[20:28]),
+		Timestamp: binary.BigEndian.Uint64(enc[28:36]),
+		TxType:    binary.BigEndian.Uint32(enc[36:40]),
+		Hash:      common.BytesToHash(enc[40:fixedSize]),
+	}
+
+	rest := enc[fixedSize:]
+	addresses := make([]common.Address, 3)
+	for i := range addresses {
+		if len(rest) == 0 || len(rest) < 1+int(rest[0]) {
+			return nil, errors.New("Broken output address data")
+		}
+
+		size := int(rest[0])
+		addresses[i] = common.BytesToAddress(rest[1 : 1+size])
+		rest = rest[1+size:]
+	}
+
+	uo.From, uo.To, uo.Node = addresses[0], addresses[1], addresses[2]
+
+	if len(rest) == 0 {
+		return uo, nil
+	}
+
+	if len(rest) != 12+common.HashLength {
+		return nil, errors.New("Broken output spent data")
+	}
+
+	uo.SpentBlock = binary.BigEndian.Uint64(rest[0:8])
+	uo.SpentIndex = binary.BigEndian.Uint32(rest[8:12])
+	uo.SpentTxHash = common.BytesToHash(rest[12:])
+
+	return uo, nil
+}
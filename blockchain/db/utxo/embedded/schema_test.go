@@ -0,0 +1,103 @@
+package embedded
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+func testOutput(id uint64, index uint32) *types.UTxO {
+	return &types.UTxO{
+		ID:        id,
+		BlockNum:  10,
+		Hash:      common.BytesToHash(bytes.Repeat([]byte{0x11}, common.HashLength)),
+		Index:     index,
+		From:      common.BytesToAddress(bytes.Repeat([]byte{0x22}, common.AddressLength)),
+		To:        common.BytesToAddress(bytes.Repeat([]byte{0x33}, common.AddressLength)),
+		Amount:    5000,
+		Timestamp: 1660000000,
+		TxType:    common.NormalTxType,
+	}
+}
+
+func TestEncodeDecodeOutput(t *testing.T) {
+	stake := testOutput(2, 1)
+	stake.TxType = common.StakeTxType
+	stake.Node = common.HexToAddress(common.BlackHoleAddress)
+
+	spent := testOutput(3, 2)
+	spent.SpentBlock = 12
+	spent.SpentIndex = 4
+	spent.SpentTxHash = common.BytesToHash(bytes.Repeat([]byte{0x44}, common.HashLength))
+
+	noSender := testOutput(4, 0)
+	noSender.From = nil
+	noSender.TxType = common.RewardTxType
+
+	tests := map[string]*types.UTxO{
+		"unspent":   testOutput(1, 0),
+		"stake":     stake,
+		"spent":     spent,
+		"no sender": noSender,
+	}
+
+	for name, uo := range tests {
+		decoded, err := decodeOutput(encodeOutput(uo))
+		if err != nil {
+			t.Fatalf("%s: decode error: %s", name, err)
+		}
+
+		if !reflect.DeepEqual(uo, decoded) {
+			t.Errorf("%s: decoded output mismatch.\nExpected: %s\nGiven: %s", name, uo.ToString(), decoded.ToString())
+		}
+
+		if decoded.IsSpent() != uo.IsSpent() {
+			t.Errorf("%s: spent flag mismatch", name)
+		}
+	}
+}
+
+func TestDecodeBrokenOutput(t *testing.T) {
+	enc := encodeOutput(testOutput(1, 0))
+
+	spent := testOutput(1, 0)
+	spent.SpentBlock = 12
+	spentEnc := encodeOutput(spent)
+
+	tests := map[string][]byte{
+		"empty":          nil,
+		"short fixed":    enc[:20],
+		"short address":  enc[:len(enc)-5],
+		"short spent":    spentEnc[:len(spentEnc)-1],
+		"unexpected end": append(append([]byte{}, enc...), 0x01),
+	}
+
+	for name, data := range tests {
+		if _, err := decodeOutput(data); err == nil {
+			t.Errorf("%s: expected decode error", name)
+		}
+	}
+}
+
+func TestAddressKeyDistinguishesEmptyNode(t *testing.T) {
+	uo := testOutput(1, 0)
+
+	staked := testOutput(1, 0)
+	staked.Node = make(common.Address, common.AddressLength)
+
+	if bytes.Equal(genAddressKey(uo), genAddressKey(staked)) {
+		t.Error("Output without node has the same address key as output with the zero node")
+	}
+
+	prefix := appendAddress(appendAddress(nil, uo.To), nil)
+	if !bytes.HasPrefix(genAddressKey(uo), prefix) {
+		t.Error("Unspent outputs prefix doesn't match output without node")
+	}
+
+	if bytes.HasPrefix(genAddressKey(staked), prefix) {
+		t.Error("Unspent outputs prefix matches stake output")
+	}
+}
@@ -0,0 +1,319 @@
+package embedded
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/iface"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	"github.com/raidoNetwork/RDO_v2/utils/file"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+// OutputsFileName is the name of the outputs database file stored in the node data directory
+const OutputsFileName = "outputs.db"
+
+var log = logrus.WithField("prefix", "OutputDB")
+
+// Store keeps outputs in the embedded BoltDB database,
+// so node can run without the external MySQL server.
+type Store struct {
+	db           *bolt.DB
+	databasePath string
+	ctx          context.Context
+	tx           map[int]*bolt.Tx
+	txID         int
+	canCreateTx  bool
+	lock         sync.RWMutex
+}
+
+// NewStore opens outputs database in the node data directory
+func NewStore(ctx context.Context, config *iface.SQLConfig) (*Store, error) {
+	dirPath := config.DataDir
+	if dirPath == "" {
+		return nil, errors.New("Empty outputs database directory.")
+	}
+
+	hasDir, err := file.HasDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		if err := file.MkdirAll(dirPath); err != nil {
+			return nil, err
+		}
+	}
+
+	boltDB, err := bolt.Open(
+		filepath.Join(dirPath, OutputsFileName),
+		params.RaidoIoConfig().ReadWritePermissions,
+		&bolt.Options{
+			Timeout: 1 * time.Second,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain outputs database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+
+	str := &Store{
+		db:           boltDB,
+		databasePath: dirPath,
+		ctx:          ctx,
+		txID:         1,
+		tx:           make(map[int]*bolt.Tx),
+		canCreateTx:  true,
+	}
+
+	if err := str.createSchema(); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Outputs database path: %s", boltDB.Path())
+
+	return str, nil
+}
+
+// Close - close database
+func (s *Store) Close() error {
+	s.finishWriting()
+
+	s.lock.Lock()
+	for id, tx := range s.tx {
+		if err := tx.Rollback(); err != nil {
+			log.Errorf("Error rollback database tx #%d: %s", id, err)
+		}
+		delete(s.tx, id)
+	}
+	s.lock.Unlock()
+
+	return s.db.Close()
+}
+
+// createSchema creates needed buckets if not exist.
+// History index is filled with the saved outputs when it is created for the existing database.
+func (s *Store) createSchema() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		hasHistory := tx.Bucket(historyBucket) != nil
+
+		for _, bucket := range buckets {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+
+		if hasHistory {
+			return nil
+		}
+
+		history := tx.Bucket(historyBucket)
+		return tx.Bucket(outputsBucket).ForEach(func(_, value []byte) error {
+			uo, err := decodeOutput(value)
+			if err != nil {
+				return err
+			}
+
+			return history.Put(genHistoryKey(uo), nil)
+		})
+	})
+}
+
+// DatabasePath at which this database writes files.
+func (s *Store) DatabasePath() string {
+	return s.databasePath
+}
+
+// FindAllUTxO find all addresses' unspent outputs
+func (s *Store) FindAllUTxO(addr string) ([]*types.UTxO, error) {
+	prefix := appendAddress(nil, common.HexToAddress(addr))
+	prefix = appendAddress(prefix, nil)
+
+	return s.getOutputsByIndex(addressBucket, prefix, nil)
+}
+
+// FindOutput returns output with given hash and index including spent one.
+func (s *Store) FindOutput(hash string, index uint32) (uo *types.UTxO, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(outputsBucket).Get(genOutputKey(common.HexToHash(hash), index))
+		if value == nil {
+			return nil
+		}
+
+		uo, err = decodeOutput(value)
+		return err
+	})
+
+	return
+}
+
+// FindOutputsHistory returns address outputs including spent ones with ID greater than the cursor.
+func (s *Store) FindOutputsHistory(addr string, cursor uint64, limit uint32) ([]*types.UTxO, error) {
+	uoArr := make([]*types.UTxO, 0, limit)
+	prefix := appendAddress(nil, common.HexToAddress(addr))
+	start := binary.BigEndian.AppendUint64(prefix[:len(prefix):len(prefix)], cursor+1)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(historyBucket).Cursor()
+		for key, _ := c.Seek(start); key != nil && bytes.HasPrefix(key, prefix) && len(uoArr) < int(limit); key, _ = c.Next() {
+			uo, err := getOutput(tx, key[len(key)-outputKeySize:])
+			if err != nil {
+				return err
+			}
+
+			uoArr = append(uoArr, uo)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uoArr, nil
+}
+
+// FindLastBlockNum search max block num in the database.
+func (s *Store) FindLastBlockNum() (num uint64, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		key, _ := tx.Bucket(blockBucket).Cursor().Last()
+		if len(key) < 8 {
+			return nil
+		}
+
+		uo, err := getOutput(tx, key[8:])
+		if err != nil {
+			return err
+		}
+
+		num = uo.BlockNum
+		return nil
+	})
+
+	return
+}
+
+// GetTotalAmount return sum amount of network
+func (s *Store) GetTotalAmount() (sum uint64, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(outputsBucket).ForEach(func(_, value []byte) error {
+			uo, err := decodeOutput(value)
+			if err != nil {
+				return err
+			}
+
+			if !uo.IsSpent() {
+				sum += uo.Amount
+			}
+
+			return nil
+		})
+	})
+
+	return
+}
+
+// FindStakeDeposits shows all actual stake deposits and return list of deposit outputs.
+func (s *Store) FindStakeDeposits() ([]*types.UTxO, error) {
+	return s.getOutputsByIndex(stakeBucket, nil, nil)
+}
+
+func (s *Store) FindValidatorStakeDeposits() ([]*types.UTxO, error) {
+	prefix := appendAddress(nil, common.HexToAddress(common.BlackHoleAddress))
+	return s.getOutputsByIndex(stakeBucket, prefix, nil)
+}
+
+// FindStakeDepositsOfAddress shows actual stake deposits of given address
+// and return list of deposit outputs.
+func (s *Store) FindStakeDepositsOfAddress(address string, node string) ([]*types.UTxO, error) {
+	nodeAddress := common.BlackHoleAddress
+	if node != "" {
+		nodeAddress = node
+	}
+
+	prefix := appendAddress(nil, common.HexToAddress(address))
+	if node != "all" {
+		prefix = appendAddress(prefix, common.HexToAddress(nodeAddress))
+	}
+
+	return s.getOutputsByIndex(addressBucket, prefix, isStakeDeposit)
+}
+
+// getOutputsByIndex return outputs list found with index key prefix ordered by output ID.
+// Index key always ends with the output key.
+func (s *Store) getOutputsByIndex(bucket, prefix []byte, filter func(*types.UTxO) bool) ([]*types.UTxO, error) {
+	uoArr := make([]*types.UTxO, 0)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucket).Cursor()
+		for key, _ := c.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = c.Next() {
+			if len(key) < outputKeySize {
+				return errors.Errorf("Broken index key %x", key)
+			}
+
+			uo, err := getOutput(tx, key[len(key)-outputKeySize:])
+			if err != nil {
+				return err
+			}
+
+			if filter != nil && !filter(uo) {
+				continue
+			}
+
+			uoArr = append(uoArr, uo)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(uoArr, func(i, j int) bool {
+		return uoArr[i].ID < uoArr[j].ID
+	})
+
+	return uoArr, nil
+}
+
+// getOutput returns output with given key
+func getOutput(tx *bolt.Tx, key []byte) (*types.UTxO, error) {
+	value := tx.Bucket(outputsBucket).Get(key)
+	if value == nil {
+		return nil, errors.Errorf("Output %x is not found", key)
+	}
+
+	return decodeOutput(value)
+}
+
+// ClearDatabase removes all outputs
+func (s *Store) ClearDatabase() error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range buckets {
+			if err := tx.DeleteBucket(bucket); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.createSchema()
+}
+
+// Ping is used to keep the connection of the network databases, embedded one is always available
+func (s *Store) Ping() error {
+	return nil
+}
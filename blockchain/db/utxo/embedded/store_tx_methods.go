@@ -0,0 +1,344 @@
+package embedded
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// AddOutputIfNotExists add new tx output to the database.
+// Existing output gets given type and becomes unspent.
+func (s *Store) AddOutputIfNotExists(txID int, uo *types.UTxO) error {
+	tx, err := s.getTx(txID)
+	if err != nil {
+		return err
+	}
+
+	key := genOutputKey(uo.Hash, uo.Index)
+	if value := tx.Bucket(outputsBucket).Get(key); value != nil {
+		saved, err := decodeOutput(value)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteIndexes(tx, saved); err != nil {
+			return err
+		}
+
+		saved.TxType = uo.TxType
+		saved.SpentBlock, saved.SpentTxHash, saved.SpentIndex = 0, nil, 0
+		return putOutput(tx, saved)
+	}
+
+	return insertOutput(tx, uo)
+}
+
+// AddOutputBatch add new tx outputs to the database
+func (s *Store) AddOutputBatch(txID int, outputs []*types.UTxO) (int64, error) {
+	tx, err := s.getTx(txID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, uo := range outputs {
+		key := genOutputKey(uo.Hash, uo.Index)
+		if tx.Bucket(outputsBucket).Get(key) != nil {
+			return 0, errors.Errorf("Duplicate output %s %d", uo.Hash.Hex(), uo.Index)
+		}
+
+		if err := insertOutput(tx, uo); err != nil {
+			return 0, err
+		}
+	}
+
+	return int64(len(outputs)), nil
+}
+
+// SpendOutput marks unspent output as spent, so it is kept for the outputs history
+func (s *Store) SpendOutput(txID int, hash string, index uint32, blockNum uint64, spentTxHash string, spentIndex uint32) (int64, error) {
+	tx, err := s.getTx(txID)
+	if err != nil {
+		return 0, errors.Wrap(err, "SpendOutput")
+	}
+
+	key := genOutputKey(common.HexToHash(hash), index)
+	value := tx.Bucket(outputsBucket).Get(key)
+	if value == nil {
+		return 0, nil
+	}
+
+	uo, err := decodeOutput(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if uo.IsSpent() {
+		return 0, nil
+	}
+
+	if err := deleteIndexes(tx, uo); err != nil {
+		return 0, err
+	}
+
+	uo.SpentBlock = blockNum
+	uo.SpentTxHash = common.HexToHash(spentTxHash)
+	uo.SpentIndex = spentIndex
+
+	if err := putOutput(tx, uo); err != nil {
+		return 0, err
+	}
+
+	return 1, nil
+}
+
+// DeleteOutputs delete all outputs with given block number and restores outputs spent in the block.
+func (s *Store) DeleteOutputs(txID int, blockNum uint64) error {
+	tx, err := s.getTx(txID)
+	if err != nil {
+		return errors.Wrap(err, "OutputDB.DeleteOutputs")
+	}
+
+	prefix := binary.BigEndian.AppendUint64(nil, blockNum)
+	outputs := make([]*types.UTxO, 0)
+
+	c := tx.Bucket(blockBucket).Cursor()
+	for key, _ := c.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = c.Next() {
+		uo, err := getOutput(tx, key[len(prefix):])
+		if err != nil {
+			return err
+		}
+
+		outputs = append(outputs, uo)
+	}
+
+	for _, uo := range outputs {
+		if err := deleteOutput(tx, uo); err != nil {
+			return err
+		}
+	}
+
+	outputs, err = findSpentOutputs(tx, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, uo := range outputs {
+		if err := deleteIndexes(tx, uo); err != nil {
+			return err
+		}
+
+		uo.SpentBlock, uo.SpentTxHash, uo.SpentIndex = 0, nil, 0
+		if err := putOutput(tx, uo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PruneSpentOutputs removes outputs spent in the blocks with number less or equal to the given one.
+func (s *Store) PruneSpentOutputs(txID int, blockNum uint64) (int64, error) {
+	tx, err := s.getTx(txID)
+	if err != nil {
+		return 0, errors.Wrap(err, "OutputDB.PruneSpentOutputs")
+	}
+
+	outputs := make([]*types.UTxO, 0)
+
+	c := tx.Bucket(spentBucket).Cursor()
+	for key, _ := c.First(); key != nil && binary.BigEndian.Uint64(key[:8]) <= blockNum; key, _ = c.Next() {
+		uo, err := getOutput(tx, key[8:])
+		if err != nil {
+			return 0, err
+		}
+
+		outputs = append(outputs, uo)
+	}
+
+	for _, uo := range outputs {
+		if err := deleteOutput(tx, uo); err != nil {
+			return 0, err
+		}
+	}
+
+	return int64(len(outputs)), nil
+}
+
+// findSpentOutputs returns outputs which spent index key starts with the given prefix
+func findSpentOutputs(tx *bolt.Tx, prefix []byte) ([]*types.UTxO, error) {
+	outputs := make([]*types.UTxO, 0)
+
+	c := tx.Bucket(spentBucket).Cursor()
+	for key, _ := c.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = c.Next() {
+		uo, err := getOutput(tx, key[8:])
+		if err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, uo)
+	}
+
+	return outputs, nil
+}
+
+// CreateTx create new database transaction and return it's ID.
+// BoltDB transactions are always serializable, so isolated flag is ignored.
+func (s *Store) CreateTx(isolated bool) (int, error) {
+	s.lock.RLock()
+	canCreate := s.canCreateTx
+	s.lock.RUnlock()
+
+	if !canCreate {
+		return 0, errors.New("Database is closing.")
+	}
+
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return 0, err
+	}
+
+	s.lock.Lock()
+	s.tx[s.txID] = tx
+	id := s.txID
+	s.txID++
+	s.lock.Unlock()
+
+	log.Debugf("OutputDB.CreateTx: new database tx id #%d.", id)
+
+	return id, nil
+}
+
+// RollbackTx rollback database transaction.
+func (s *Store) RollbackTx(txID int) error {
+	tx, err := s.getTx(txID)
+	if err != nil {
+		return errors.Wrap(err, "OutputDB.RollbackTx")
+	}
+
+	defer s.removeTx(txID)
+
+	return tx.Rollback()
+}
+
+// CommitTx commit database transaction.
+func (s *Store) CommitTx(txID int) error {
+	tx, err := s.getTx(txID)
+	if err != nil {
+		return errors.Wrap(err, "OutputDB.CommitTx")
+	}
+
+	defer s.removeTx(txID)
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Debugf("OutputDB.CommitTx: commit database tx id #%d.", txID)
+
+	return nil
+}
+
+// getTx returns database transaction with given ID
+func (s *Store) getTx(txID int) (*bolt.Tx, error) {
+	s.lock.RLock()
+	tx, exists := s.tx[txID]
+	s.lock.RUnlock()
+
+	if !exists {
+		return nil, errors.Errorf("Undefined transaction #%d", txID)
+	}
+
+	return tx, nil
+}
+
+// finishWriting prepare database for closing
+func (s *Store) finishWriting() {
+	s.lock.Lock()
+	s.canCreateTx = false
+	s.lock.Unlock()
+}
+
+// removeTx remove database transaction
+func (s *Store) removeTx(id int) {
+	s.lock.Lock()
+	delete(s.tx, id)
+	log.Debugf("OutputDB.removeTx: delete database tx id #%d", id)
+	s.lock.Unlock()
+}
+
+// insertOutput saves new output with the next ID
+func insertOutput(tx *bolt.Tx, uo *types.UTxO) error {
+	id, err := tx.Bucket(outputsBucket).NextSequence()
+	if err != nil {
+		return err
+	}
+
+	saved := *uo
+	saved.ID = id
+
+	return putOutput(tx, &saved)
+}
+
+// putOutput saves output and its indexes, spent output is excluded from the unspent outputs indexes
+func putOutput(tx *bolt.Tx, uo *types.UTxO) error {
+	key := genOutputKey(uo.Hash, uo.Index)
+	if err := tx.Bucket(outputsBucket).Put(key, encodeOutput(uo)); err != nil {
+		return err
+	}
+
+	if err := tx.Bucket(blockBucket).Put(genBlockKey(uo), nil); err != nil {
+		return err
+	}
+
+	if err := tx.Bucket(historyBucket).Put(genHistoryKey(uo), nil); err != nil {
+		return err
+	}
+
+	if uo.IsSpent() {
+		return tx.Bucket(spentBucket).Put(genSpentKey(uo), nil)
+	}
+
+	if err := tx.Bucket(addressBucket).Put(genAddressKey(uo), nil); err != nil {
+		return err
+	}
+
+	if isStakeDeposit(uo) {
+		return tx.Bucket(stakeBucket).Put(genStakeKey(uo), nil)
+	}
+
+	return nil
+}
+
+// deleteOutput removes output and its indexes
+func deleteOutput(tx *bolt.Tx, uo *types.UTxO) error {
+	if err := deleteIndexes(tx, uo); err != nil {
+		return err
+	}
+
+	return tx.Bucket(outputsBucket).Delete(genOutputKey(uo.Hash, uo.Index))
+}
+
+// deleteIndexes removes output indexes
+func deleteIndexes(tx *bolt.Tx, uo *types.UTxO) error {
+	if err := tx.Bucket(addressBucket).Delete(genAddressKey(uo)); err != nil {
+		return err
+	}
+
+	if err := tx.Bucket(blockBucket).Delete(genBlockKey(uo)); err != nil {
+		return err
+	}
+
+	if err := tx.Bucket(historyBucket).Delete(genHistoryKey(uo)); err != nil {
+		return err
+	}
+
+	if err := tx.Bucket(spentBucket).Delete(genSpentKey(uo)); err != nil {
+		return err
+	}
+
+	return tx.Bucket(stakeBucket).Delete(genStakeKey(uo))
+}
@@ -0,0 +1,345 @@
+package embedded
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/iface"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	s, err := NewStore(context.Background(), &iface.SQLConfig{DataDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return s
+}
+
+// hasKey returns true if bucket has the key, index values are empty, so Get can't be used
+func hasKey(tx *bolt.Tx, bucket, key []byte) bool {
+	k, _ := tx.Bucket(bucket).Cursor().Seek(key)
+	return bytes.Equal(k, key)
+}
+
+// checkIndexes checks that output is present only in the expected index buckets
+func checkIndexes(t *testing.T, tx *bolt.Tx, uo *types.UTxO, expected ...[]byte) {
+	t.Helper()
+
+	keys := map[string][]byte{
+		string(addressBucket): genAddressKey(uo),
+		string(blockBucket):   genBlockKey(uo),
+		string(stakeBucket):   genStakeKey(uo),
+		string(spentBucket):   genSpentKey(uo),
+		string(historyBucket): genHistoryKey(uo),
+	}
+
+	want := map[string]struct{}{}
+	for _, bucket := range expected {
+		want[string(bucket)] = struct{}{}
+	}
+
+	for bucket, key := range keys {
+		_, shouldExist := want[bucket]
+		exists := hasKey(tx, []byte(bucket), key)
+		if exists != shouldExist {
+			t.Errorf("Output %d index %s: exists %v, expected %v", uo.Index, bucket, exists, shouldExist)
+		}
+	}
+}
+
+func TestPutOutputIndexes(t *testing.T) {
+	s := newTestStore(t)
+
+	unspent := testOutput(1, 0)
+
+	stake := testOutput(2, 1)
+	stake.TxType = common.StakeTxType
+	stake.Node = common.HexToAddress(common.BlackHoleAddress)
+
+	spent := testOutput(3, 2)
+	spent.SpentBlock = 11
+	spent.SpentTxHash = common.BytesToHash([]byte{0x55})
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, uo := range []*types.UTxO{unspent, stake, spent} {
+			if err := putOutput(tx, uo); err != nil {
+				return err
+			}
+		}
+
+		checkIndexes(t, tx, unspent, addressBucket, blockBucket, historyBucket)
+		checkIndexes(t, tx, stake, addressBucket, blockBucket, historyBucket, stakeBucket)
+		checkIndexes(t, tx, spent, blockBucket, historyBucket, spentBucket)
+
+		for _, uo := range []*types.UTxO{unspent, stake, spent} {
+			if err := deleteIndexes(tx, uo); err != nil {
+				return err
+			}
+
+			checkIndexes(t, tx, uo)
+
+			if tx.Bucket(outputsBucket).Get(genOutputKey(uo.Hash, uo.Index)) == nil {
+				t.Errorf("Output %d is removed with its indexes", uo.Index)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSpendOutputMovesIndexes(t *testing.T) {
+	s := newTestStore(t)
+
+	uo := testOutput(0, 0)
+	uo.TxType = common.StakeTxType
+	uo.Node = common.HexToAddress(common.BlackHoleAddress)
+
+	txID, err := s.CreateTx(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.AddOutputIfNotExists(txID, uo); err != nil {
+		t.Fatal(err)
+	}
+
+	spentTxHash := common.BytesToHash([]byte{0x66})
+	count, err := s.SpendOutput(txID, uo.Hash.Hex(), uo.Index, 11, spentTxHash.Hex(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 1 {
+		t.Fatalf("Expected 1 spent output. Given: %d", count)
+	}
+
+	// output can be spent only once
+	count, err = s.SpendOutput(txID, uo.Hash.Hex(), uo.Index, 12, spentTxHash.Hex(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 0 {
+		t.Fatalf("Spent output is spent again")
+	}
+
+	if err := s.CommitTx(txID); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := s.FindOutput(uo.Hash.Hex(), uo.Index)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if saved.SpentBlock != 11 || saved.SpentIndex != 3 || !bytes.Equal(saved.SpentTxHash, spentTxHash) {
+		t.Fatalf("Wrong spent data: %d %d %s", saved.SpentBlock, saved.SpentIndex, saved.SpentTxHash.Hex())
+	}
+
+	err = s.db.View(func(tx *bolt.Tx) error {
+		checkIndexes(t, tx, saved, blockBucket, historyBucket, spentBucket)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deposits, err := s.FindStakeDeposits()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deposits) != 0 {
+		t.Fatalf("Spent stake deposit is found")
+	}
+}
+
+// checkConsistency checks that every index record points to the saved output and every output has all its indexes
+func checkConsistency(t *testing.T, s *Store) {
+	t.Helper()
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		counts := map[string]int{}
+
+		err := tx.Bucket(outputsBucket).ForEach(func(_, value []byte) error {
+			uo, err := decodeOutput(value)
+			if err != nil {
+				return err
+			}
+
+			expected := [][]byte{blockBucket, historyBucket}
+			if uo.IsSpent() {
+				expected = append(expected, spentBucket)
+			} else {
+				expected = append(expected, addressBucket)
+				if isStakeDeposit(uo) {
+					expected = append(expected, stakeBucket)
+				}
+			}
+
+			for _, bucket := range expected {
+				counts[string(bucket)]++
+			}
+
+			checkIndexes(t, tx, uo, expected...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, bucket := range [][]byte{addressBucket, blockBucket, stakeBucket, spentBucket, historyBucket} {
+			if n := tx.Bucket(bucket).Stats().KeyN; n != counts[string(bucket)] {
+				t.Errorf("Bucket %s has %d keys, expected %d", bucket, n, counts[string(bucket)])
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSpentIndexesAfterDeleteAndPrune(t *testing.T) {
+	s := newTestStore(t)
+
+	spentTxHash := common.BytesToHash([]byte{0x66})
+	outputs := []*types.UTxO{testOutput(0, 0), testOutput(0, 1), testOutput(0, 2)}
+
+	write := func(f func(txID int) error) {
+		t.Helper()
+
+		txID, err := s.CreateTx(false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := f(txID); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := s.CommitTx(txID); err != nil {
+			t.Fatal(err)
+		}
+
+		checkConsistency(t, s)
+	}
+
+	spend := func(txID int, uo *types.UTxO, blockNum uint64) error {
+		_, err := s.SpendOutput(txID, uo.Hash.Hex(), uo.Index, blockNum, spentTxHash.Hex(), uo.Index)
+		return err
+	}
+
+	spentBlock := func(uo *types.UTxO) (uint64, bool) {
+		t.Helper()
+
+		saved, err := s.FindOutput(uo.Hash.Hex(), uo.Index)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if saved == nil {
+			return 0, false
+		}
+
+		return saved.SpentBlock, true
+	}
+
+	// outputs of block 10 are spent in blocks 11 and 12
+	write(func(txID int) error {
+		if _, err := s.AddOutputBatch(txID, outputs); err != nil {
+			return err
+		}
+
+		for i, uo := range outputs {
+			if err := spend(txID, uo, 11+uint64(i/2)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	write(func(txID int) error {
+		return s.DeleteOutputs(txID, 11)
+	})
+
+	for i, expected := range []uint64{0, 0, 12} {
+		if num, _ := spentBlock(outputs[i]); num != expected {
+			t.Errorf("Output %d: spent block %d after delete, expected %d", i, num, expected)
+		}
+	}
+
+	// block 11 is applied again and pruned
+	write(func(txID int) error {
+		if err := spend(txID, outputs[0], 11); err != nil {
+			return err
+		}
+
+		pruned, err := s.PruneSpentOutputs(txID, 11)
+		if err != nil {
+			return err
+		}
+
+		if pruned != 1 {
+			t.Errorf("Expected 1 pruned output. Given: %d", pruned)
+		}
+
+		return nil
+	})
+
+	if _, exists := spentBlock(outputs[0]); exists {
+		t.Error("Pruned output is found")
+	}
+
+	for i, expected := range []uint64{0, 12} {
+		num, exists := spentBlock(outputs[i+1])
+		if !exists || num != expected {
+			t.Errorf("Output %d: exists %v, spent block %d after prune, expected %d", i+1, exists, num, expected)
+		}
+	}
+
+	history, err := s.FindOutputsHistory(outputs[0].To.Hex(), 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 outputs in the history. Given: %d", len(history))
+	}
+
+	// pruned output is restored by the block 11 revert with the new ID
+	write(func(txID int) error {
+		if err := s.DeleteOutputs(txID, 11); err != nil {
+			return err
+		}
+
+		return s.AddOutputIfNotExists(txID, outputs[0])
+	})
+
+	history, err = s.FindOutputsHistory(outputs[0].To.Hex(), 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history) != 3 || history[2].Index != outputs[0].Index {
+		t.Fatalf("Restored output is not the last one in the history")
+	}
+}
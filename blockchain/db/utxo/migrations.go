@@ -0,0 +1,166 @@
+package utxo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/utxo/dbshared"
+)
+
+// migration is the outputs database schema change.
+// Statements are built for the given dialect, because SQL syntax of the databases differs.
+type migration struct {
+	version     int
+	description string
+	statements  func(Dialect) []string
+}
+
+// migrations is the ordered list of the schema changes.
+// New migration should be appended with the next version, applied migrations must never be changed.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "create outputs table",
+		statements: func(d Dialect) []string {
+			return d.Schema()
+		},
+	},
+	{
+		version:     2,
+		description: "keep spent outputs history",
+		statements:  spentOutputsMigration,
+	},
+}
+
+// spentOutputsMigration adds spent output columns and indexes for the history queries and pruning
+func spentOutputsMigration(d Dialect) []string {
+	blockType, hashType, indexType := "BIGINT", "VARCHAR(66)", "INT"
+	switch d.Name() {
+	case MySQLDialect:
+		blockType = "BIGINT UNSIGNED"
+	case SQLiteDialect:
+		blockType, hashType, indexType = "INTEGER", "TEXT", "INTEGER"
+	}
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN spent_block %s DEFAULT NULL;", dbshared.UtxoTable, blockType),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN spent_tx_hash %s DEFAULT NULL;", dbshared.UtxoTable, hashType),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN spent_index %s DEFAULT NULL;", dbshared.UtxoTable, indexType),
+		fmt.Sprintf("CREATE INDEX %s ON %s (spent_block);", dbshared.SpentBlockIndex, dbshared.UtxoTable),
+		fmt.Sprintf("CREATE INDEX %s ON %s (address_to, id);", dbshared.AddrToIdIndex, dbshared.UtxoTable),
+	}
+}
+
+var schemaVersionQuery = fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	version INT NOT NULL,
+	description VARCHAR(255) NOT NULL,
+	applied_at BIGINT NOT NULL,
+	PRIMARY KEY (version)
+);`, dbshared.SchemaVersionTable)
+
+// LatestSchemaVersion returns the newest outputs database schema version known by the node
+func LatestSchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+// migrate applies all pending migrations in the version order.
+// With dry run pending migrations are only logged and database is not changed.
+// Database with the unknown newer schema version is refused.
+func (s *Store) migrate(dryRun bool) error {
+	if err := s.db.Ping(); err != nil {
+		return errors.Wrap(err, "Error connecting outputs database")
+	}
+
+	if !dryRun {
+		if _, err := s.db.Exec(schemaVersionQuery); err != nil {
+			return err
+		}
+	}
+
+	current, err := s.schemaVersion()
+	if err != nil {
+		if !dryRun {
+			return err
+		}
+
+		log.Infof("Table %s is not found and will be created.", dbshared.SchemaVersionTable)
+		current = 0
+	}
+
+	latest := LatestSchemaVersion()
+	if current > latest {
+		return errors.Errorf("Outputs database schema version %d is newer than supported version %d. Update the node.", current, latest)
+	}
+
+	if current == latest {
+		log.Debugf("Outputs database schema is up to date. Version: %d.", current)
+		return nil
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		statements := m.statements(s.dialect)
+		if dryRun {
+			log.Infof("Pending outputs database migration #%d: %s", m.version, m.description)
+			for _, query := range statements {
+				log.Info(query)
+			}
+
+			continue
+		}
+
+		if err := s.applyMigration(m.version, m.description, statements); err != nil {
+			return errors.Wrapf(err, "Error applying outputs database migration #%d", m.version)
+		}
+
+		log.Infof("Applied outputs database migration #%d: %s", m.version, m.description)
+	}
+
+	return nil
+}
+
+// schemaVersion returns current database schema version, 0 is returned for the empty version table.
+func (s *Store) schemaVersion() (int, error) {
+	query := fmt.Sprintf("SELECT COALESCE(MAX(version), 0) FROM %s", dbshared.SchemaVersionTable)
+
+	var version int
+	if err := s.db.QueryRow(query).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// applyMigration executes migration statements and saves its version in one database transaction.
+// Note: MySQL commits schema changes implicitly, so only version insert is rolled back there.
+func (s *Store) applyMigration(version int, description string, statements []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, query := range statements {
+		if _, err := tx.Exec(query); err != nil {
+			if errb := tx.Rollback(); errb != nil {
+				log.Errorf("Migration rollback error: %s", errb)
+			}
+
+			return err
+		}
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (version, description, applied_at) VALUES (?, ?, ?)", dbshared.SchemaVersionTable)
+	if _, err := tx.Exec(s.dialect.Rebind(query), version, description, time.Now().Unix()); err != nil {
+		if errb := tx.Rollback(); errb != nil {
+			log.Errorf("Migration rollback error: %s", errb)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -5,7 +5,7 @@ import (
 	"github.com/raidoNetwork/RDO_v2/blockchain/db/utxo/dbshared"
 )
 
-var utxoSchema = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (" +
+var mysqlSchema = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (" +
 	"`id` BIGINT UNSIGNED AUTO_INCREMENT," +
 	"`tx_type` INT DEFAULT NULL," +
 	"`block_id` BIGINT UNSIGNED DEFAULT NULL," +
@@ -22,3 +22,40 @@ var utxoSchema = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (" +
    KEY %s (block_id),
    KEY %s (tx_type, address_node)
 );`, dbshared.UtxoTable, dbshared.AddrToNode, dbshared.HashToTxIndex, dbshared.BlockIdIndex, dbshared.TxTypeToNodeIndex)
+
+var postgresSchema = append([]string{
+	fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id BIGSERIAL PRIMARY KEY,
+	tx_type INT DEFAULT NULL,
+	block_id BIGINT DEFAULT NULL,
+	hash VARCHAR(66) DEFAULT NULL,
+	tx_index INT DEFAULT NULL,
+	address_from VARCHAR(44) DEFAULT NULL,
+	address_to VARCHAR(44) DEFAULT NULL,
+	address_node VARCHAR(44) DEFAULT NULL,
+	amount NUMERIC(28, 0) DEFAULT NULL,
+	timestamp BIGINT DEFAULT NULL,
+	CONSTRAINT %s UNIQUE (hash, tx_index)
+);`, dbshared.UtxoTable, dbshared.HashToTxIndex)}, indexesSchema...)
+
+var sqliteSchema = append([]string{
+	fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	tx_type INTEGER DEFAULT NULL,
+	block_id INTEGER DEFAULT NULL,
+	hash TEXT DEFAULT NULL,
+	tx_index INTEGER DEFAULT NULL,
+	address_from TEXT DEFAULT NULL,
+	address_to TEXT DEFAULT NULL,
+	address_node TEXT DEFAULT NULL,
+	amount INTEGER DEFAULT NULL,
+	timestamp INTEGER DEFAULT NULL,
+	CONSTRAINT %s UNIQUE (hash, tx_index)
+);`, dbshared.UtxoTable, dbshared.HashToTxIndex)}, indexesSchema...)
+
+// indexesSchema creates secondary indexes for the databases without inline index definition
+var indexesSchema = []string{
+	fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (address_to, address_node);", dbshared.AddrToNode, dbshared.UtxoTable),
+	fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (block_id);", dbshared.BlockIdIndex, dbshared.UtxoTable),
+	fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (tx_type, address_node);", dbshared.TxTypeToNodeIndex, dbshared.UtxoTable),
+}
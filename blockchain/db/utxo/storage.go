@@ -4,47 +4,30 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	_ "github.com/go-sql-driver/mysql"
-	"github.com/joho/godotenv"
-	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/blockchain/db/iface"
 	"github.com/raidoNetwork/RDO_v2/blockchain/db/utxo/dbshared"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
 	"github.com/raidoNetwork/RDO_v2/shared/types"
 	"github.com/raidoNetwork/RDO_v2/utils/slice"
 	"github.com/sirupsen/logrus"
-	"os"
 	"sync"
 )
 
 var log = logrus.WithField("prefix", "OutputDB")
 
+// NewStore opens SQL outputs database with the dialect given in the config backend.
 func NewStore(ctx context.Context, config *iface.SQLConfig) (*Store, error) {
-	path := config.ConfigPath
-	if path == "" {
-		return nil, errors.New("Empty database cfg path.")
-	}
-
-	err := godotenv.Load(path)
+	dialect, err := newDialect(config.Backend)
 	if err != nil {
-		return nil, errors.Errorf("Error loading .env file %s. Error message: %s", path, err)
+		return nil, err
 	}
 
-	var uname, pass, host, port, dbname string
-	uname = os.Getenv("DB_USER")
-	pass = os.Getenv("DB_PASS")
-	host = os.Getenv("DB_HOST")
-	port = os.Getenv("DB_PORT")
-	dbname = os.Getenv("DB_NAME")
-
-	dataSource := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s", uname, pass, host, port, dbname)
-	log.Debugf("SQL database server: %s:%s", host, port)
-
-	db, err := sql.Open("mysql", dataSource)
+	dataSource, err := dialect.DataSource(config)
 	if err != nil {
 		return nil, err
 	}
 
+	db, err := sql.Open(dialect.DriverName(), dataSource)
 	if err != nil {
 		return nil, err
 	}
@@ -56,10 +39,10 @@ func NewStore(ctx context.Context, config *iface.SQLConfig) (*Store, error) {
 		txID:         1,
 		tx:           make(map[int]*sql.Tx),
 		canCreateTx:  true,
-		schema:       utxoSchema,
+		dialect:      dialect,
 	}
 
-	if err := str.createSchema(); err != nil {
+	if err := str.migrate(config.DryRun); err != nil {
 		return nil, err
 	}
 
@@ -75,7 +58,7 @@ type Store struct {
 	canCreateTx  bool
 	lock         sync.RWMutex
 
-	schema string
+	dialect Dialect
 }
 
 // Close - close database connections
@@ -85,12 +68,6 @@ func (s *Store) Close() error {
 	return s.db.Close()
 }
 
-// createSchema generates needed database structure if not exist.
-func (s *Store) createSchema() error {
-	_, err := s.db.Exec(s.schema)
-	return err
-}
-
 // DatabasePath at which this database writes files.
 func (s *Store) DatabasePath() string {
 	return s.databasePath
@@ -98,13 +75,33 @@ func (s *Store) DatabasePath() string {
 
 // FindAllUTxO find all addresses' unspent outputs
 func (s *Store) FindAllUTxO(addr string) (uoArr []*types.UTxO, err error) {
-	query := "WHERE address_to = ? AND address_node = ?"
+	query := "WHERE address_to = ? AND address_node = ? AND spent_block IS NULL"
 	return s.getOutputsList(query, addr, "")
 }
 
+// FindOutput returns output with given hash and index including spent one.
+func (s *Store) FindOutput(hash string, index uint32) (*types.UTxO, error) {
+	uoArr, err := s.getOutputsList("WHERE hash = ? AND tx_index = ?", hash, index)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(uoArr) == 0 {
+		return nil, nil
+	}
+
+	return uoArr[0], nil
+}
+
+// FindOutputsHistory returns address outputs including spent ones with ID greater than the cursor.
+func (s *Store) FindOutputsHistory(addr string, cursor uint64, limit uint32) ([]*types.UTxO, error) {
+	query := "WHERE address_to = ? AND id > ? ORDER BY id LIMIT ?"
+	return s.getOutputsList(query, addr, cursor, limit)
+}
+
 // FindLastBlockNum search max block num in the database.
 func (s *Store) FindLastBlockNum() (num uint64, err error) {
-	query := fmt.Sprintf("SELECT IFNULL(MAX(block_id), 0) as maxBlockId FROM %s", dbshared.UtxoTable)
+	query := fmt.Sprintf("SELECT COALESCE(MAX(block_id), 0) as maxBlockId FROM %s", dbshared.UtxoTable)
 	rows, err := s.db.Query(query)
 	if err != nil {
 		return
@@ -125,7 +122,7 @@ func (s *Store) FindLastBlockNum() (num uint64, err error) {
 
 // GetTotalAmount return sum amount of network
 func (s *Store) GetTotalAmount() (uint64, error) {
-	query := fmt.Sprintf("SELECT IFNULL(SUM(amount), 0) FROM %s", dbshared.UtxoTable)
+	query := fmt.Sprintf("SELECT COALESCE(SUM(amount), 0) FROM %s WHERE spent_block IS NULL", dbshared.UtxoTable)
 	rows, err := s.db.Query(query)
 	if err != nil {
 		return 0, nil
@@ -146,13 +143,13 @@ func (s *Store) GetTotalAmount() (uint64, error) {
 
 // FindStakeDeposits shows all actual stake deposits and return list of deposit outputs.
 func (s *Store) FindStakeDeposits() (uoArr []*types.UTxO, err error) {
-	query := `WHERE (tx_type = ? OR tx_type = ?) AND address_node != ""`
-	return s.getOutputsList(query, common.StakeTxType, common.UnstakeTxType)
+	query := `WHERE (tx_type = ? OR tx_type = ? OR tx_type = ?) AND address_node != '' AND spent_block IS NULL`
+	return s.getOutputsList(query, common.StakeTxType, common.UnstakeTxType, common.SlashTxType)
 }
 
 func (s *Store) FindValidatorStakeDeposits() (uoArr []*types.UTxO, err error) {
-	query := "WHERE (tx_type = ? OR tx_type = ?) AND address_node = ?"
-	return s.getOutputsList(query, common.StakeTxType, common.UnstakeTxType, common.BlackHoleAddress)
+	query := "WHERE (tx_type = ? OR tx_type = ? OR tx_type = ?) AND address_node = ? AND spent_block IS NULL"
+	return s.getOutputsList(query, common.StakeTxType, common.UnstakeTxType, common.SlashTxType, common.BlackHoleAddress)
 }
 
 // FindStakeDepositsOfAddress shows actual stake deposits of given address
@@ -166,15 +163,16 @@ func (s *Store) FindStakeDepositsOfAddress(address string, node string) ([]*type
 	arguments := []interface{}{
 		common.StakeTxType,
 		common.UnstakeTxType,
+		common.SlashTxType,
 		address,
 	}
 	if node == "all" {
-		nodePlaceholder = `!= ""`
+		nodePlaceholder = "!= ''"
 	} else {
-		arguments = slice.Insert(arguments, nodeAddress, 2)
+		arguments = slice.Insert(arguments, nodeAddress, 3)
 	}
 
-	query := "WHERE (tx_type = ? OR tx_type = ? ) AND address_node " + nodePlaceholder + " AND address_to = ?"
+	query := "WHERE (tx_type = ? OR tx_type = ? OR tx_type = ?) AND address_node " + nodePlaceholder + " AND address_to = ? AND spent_block IS NULL"
 	return s.getOutputsList(query, arguments...)
 }
 
@@ -189,9 +187,12 @@ func (s *Store) getOutputsList(condQuery string, params ...interface{}) (uoArr [
 					amount, 
 					timestamp, 
 					block_id, 
-					tx_type FROM %s %s`, dbshared.UtxoTable, condQuery)
+					tx_type,
+					COALESCE(spent_block, 0),
+					COALESCE(spent_tx_hash, ''),
+					COALESCE(spent_index, 0) FROM %s %s`, dbshared.UtxoTable, condQuery)
 
-	rows, err := s.db.Query(query, params...)
+	rows, err := s.db.Query(s.dialect.Rebind(query), params...)
 	if err != nil {
 		return nil, err
 	}
@@ -200,24 +201,33 @@ func (s *Store) getOutputsList(condQuery string, params ...interface{}) (uoArr [
 
 	uoArr = make([]*types.UTxO, 0)
 
-	var hash, from, to, node string
-	var id, blockNum, amount, timestamp uint64
-	var index, typev uint32
+	var hash, from, to, node, spentTxHash string
+	var id, blockNum, amount, timestamp, spentBlock uint64
+	var index, typev, spentIndex uint32
 	for rows.Next() {
-		err = rows.Scan(&id, &hash, &index, &from, &to, &node, &amount, &timestamp, &blockNum, &typev)
+		err = rows.Scan(&id, &hash, &index, &from, &to, &node, &amount, &timestamp, &blockNum, &typev, &spentBlock, &spentTxHash, &spentIndex)
 		if err != nil {
 			return
 		}
 
 		uo := types.NewUTxOFull(id, hash, from, to, node, index, amount, blockNum, timestamp, typev)
+		uo.SpentBlock = spentBlock
+		uo.SpentTxHash = common.HexToHash(spentTxHash)
+		uo.SpentIndex = spentIndex
 		uoArr = append(uoArr, uo)
 	}
 
 	return uoArr, nil
 }
 
+// ClearDatabase drops all tables and creates the latest schema again
 func (s *Store) ClearDatabase() error {
-	query := fmt.Sprintf("DROP TABLE %s", dbshared.UtxoTable)
-	_, err := s.db.Exec(query)
-	return err
+	for _, table := range []string{dbshared.UtxoTable, dbshared.SchemaVersionTable} {
+		query := fmt.Sprintf("DROP TABLE IF EXISTS %s", table)
+		if _, err := s.db.Exec(query); err != nil {
+			return err
+		}
+	}
+
+	return s.migrate(false)
 }
\ No newline at end of file
@@ -0,0 +1,115 @@
+package utxo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+func TestFindStakeDeposits(t *testing.T) {
+	s := newTestStore(t)
+
+	staker := common.BytesToAddress(bytes.Repeat([]byte{0x33}, common.AddressLength))
+	other := common.BytesToAddress(bytes.Repeat([]byte{0x44}, common.AddressLength))
+	node := common.BytesToAddress(bytes.Repeat([]byte{0x55}, common.AddressLength))
+	validator := common.HexToAddress(common.BlackHoleAddress)
+
+	deposit := func(index uint32, txType uint32, to, node common.Address) *types.UTxO {
+		uo := testOutput(0x11, index, 10)
+		uo.TxType = txType
+		uo.To = to
+		uo.Node = node
+		return uo
+	}
+
+	outputs := []*types.UTxO{
+		deposit(0, common.StakeTxType, staker, validator),
+		deposit(1, common.UnstakeTxType, staker, validator),
+		deposit(2, common.StakeTxType, staker, node),
+		deposit(3, common.SlashTxType, other, validator),
+		deposit(4, common.StakeTxType, other, node),
+		deposit(5, common.StakeTxType, staker, validator), // spent
+		deposit(6, common.NormalTxType, staker, nil),
+		deposit(7, common.RewardTxType, staker, nil),
+	}
+
+	writeTx(t, s, func(txID int) {
+		if _, err := s.AddOutputBatch(txID, outputs); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := s.SpendOutput(txID, outputs[5].Hash.Hex(), outputs[5].Index, 11, common.BytesToHash([]byte{0x66}).Hex(), 0); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		find     func() ([]*types.UTxO, error)
+		expected []uint32
+	}{
+		{
+			name:     "all deposits",
+			find:     s.FindStakeDeposits,
+			expected: []uint32{0, 1, 2, 3, 4},
+		},
+		{
+			name:     "validator deposits",
+			find:     s.FindValidatorStakeDeposits,
+			expected: []uint32{0, 1, 3},
+		},
+		{
+			name: "address validator deposits",
+			find: func() ([]*types.UTxO, error) {
+				return s.FindStakeDepositsOfAddress(staker.Hex(), "")
+			},
+			expected: []uint32{0, 1},
+		},
+		{
+			name: "address node deposits",
+			find: func() ([]*types.UTxO, error) {
+				return s.FindStakeDepositsOfAddress(staker.Hex(), node.Hex())
+			},
+			expected: []uint32{2},
+		},
+		{
+			name: "address all deposits",
+			find: func() ([]*types.UTxO, error) {
+				return s.FindStakeDepositsOfAddress(staker.Hex(), "all")
+			},
+			expected: []uint32{0, 1, 2},
+		},
+		{
+			name: "unknown address",
+			find: func() ([]*types.UTxO, error) {
+				return s.FindStakeDepositsOfAddress(node.Hex(), "all")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deposits, err := tt.find()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			indexes := make(map[uint32]struct{}, len(deposits))
+			for _, uo := range deposits {
+				indexes[uo.Index] = struct{}{}
+			}
+
+			if len(deposits) != len(tt.expected) {
+				t.Fatalf("Expected %d deposits. Given: %d", len(tt.expected), len(deposits))
+			}
+
+			for _, index := range tt.expected {
+				if _, exists := indexes[index]; !exists {
+					t.Errorf("Deposit %d is not found", index)
+				}
+			}
+		})
+	}
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/blockchain/db/utxo/dbshared"
 	"github.com/raidoNetwork/RDO_v2/shared/types"
 )
 
-// AddOutputIfNotExists add new tx output to the database
+// outputBatchSize is the max number of outputs inserted with one query
+const outputBatchSize = 1000
+
+// AddOutputIfNotExists add new tx output to the database.
+// Existing output gets given type and becomes unspent.
 func (s *Store) AddOutputIfNotExists(txID int, uo *types.UTxO) (err error) {
 	s.lock.RLock()
 	tx, exists := s.tx[txID]
@@ -30,11 +35,17 @@ func (s *Store) AddOutputIfNotExists(txID int, uo *types.UTxO) (err error) {
 				amount, 
 				timestamp, 
 				block_id, 
-				address_node
-			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE tx_type = ?`, dbshared.UtxoTable)
+				address_node,
+				spent_block,
+				spent_tx_hash,
+				spent_index
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NULL, NULL, NULL) %s`,
+		dbshared.UtxoTable,
+		s.dialect.OnConflictUpdate([]string{"hash", "tx_index"}, "tx_type", "spent_block", "spent_tx_hash", "spent_index"),
+	)
 
 	_, err = tx.Exec(
-		query,
+		s.dialect.Rebind(query),
 		uo.TxType,
 		uo.Hash.Hex(),
 		uo.Index,
@@ -44,14 +55,13 @@ func (s *Store) AddOutputIfNotExists(txID int, uo *types.UTxO) (err error) {
 		uo.Timestamp,
 		uo.BlockNum,
 		uo.Node.Hex(),
-		uo.TxType,
 	)
 
 	return
 }
 
 // AddOutputBatch add new tx outputs to the database
-func (s *Store) AddOutputBatch(txID int, values string) (rows int64, err error) {
+func (s *Store) AddOutputBatch(txID int, outputs []*types.UTxO) (rows int64, err error) {
 	s.lock.RLock()
 	tx, exists := s.tx[txID]
 	s.lock.RUnlock()
@@ -60,22 +70,44 @@ func (s *Store) AddOutputBatch(txID int, values string) (rows int64, err error)
 		return 0, errors.Errorf("Undefined transaction #%d", txID)
 	}
 
-	query := fmt.Sprintf(
-		"INSERT INTO %s (tx_type, hash, tx_index, address_from, address_to, address_node, amount, timestamp, block_id) VALUES %s",
-		dbshared.UtxoTable,
-		values,
-	)
-
-	res, err := tx.Exec(query)
-	if err != nil {
-		return
+	// split batch to fit the query placeholders limit
+	for start := 0; start < len(outputs); start += outputBatchSize {
+		end := start + outputBatchSize
+		if end > len(outputs) {
+			end = len(outputs)
+		}
+
+		values := make([]string, 0, end-start)
+		args := make([]interface{}, 0, (end-start)*9)
+		for _, uo := range outputs[start:end] {
+			values = append(values, "(?, ?, ?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, uo.TxType, uo.Hash.Hex(), uo.Index, uo.From.Hex(), uo.To.Hex(), uo.Node.Hex(), uo.Amount, uo.Timestamp, uo.BlockNum)
+		}
+
+		query := fmt.Sprintf(
+			"INSERT INTO %s (tx_type, hash, tx_index, address_from, address_to, address_node, amount, timestamp, block_id) VALUES %s",
+			dbshared.UtxoTable,
+			strings.Join(values, ", "),
+		)
+
+		res, err := tx.Exec(s.dialect.Rebind(query), args...)
+		if err != nil {
+			return rows, err
+		}
+
+		count, err := res.RowsAffected()
+		if err != nil {
+			return rows, err
+		}
+
+		rows += count
 	}
 
-	return res.RowsAffected()
+	return rows, nil
 }
 
-// SpendOutput delete output in the database
-func (s *Store) SpendOutput(txID int, hash string, index uint32) (int64, error) {
+// SpendOutput marks unspent output as spent, so it is kept for the outputs history
+func (s *Store) SpendOutput(txID int, hash string, index uint32, blockNum uint64, spentTxHash string, spentIndex uint32) (int64, error) {
 	s.lock.RLock()
 	tx, exists := s.tx[txID]
 	s.lock.RUnlock()
@@ -84,9 +116,12 @@ func (s *Store) SpendOutput(txID int, hash string, index uint32) (int64, error)
 		return 0, errors.Errorf("SpendOutput: Undefined transaction #%d", txID)
 	}
 
-	query := fmt.Sprintf("DELETE FROM %s WHERE hash = ? AND tx_index = ?", dbshared.UtxoTable)
+	query := fmt.Sprintf(
+		"UPDATE %s SET spent_block = ?, spent_tx_hash = ?, spent_index = ? WHERE hash = ? AND tx_index = ? AND spent_block IS NULL",
+		dbshared.UtxoTable,
+	)
 
-	res, err := tx.Exec(query, hash, index)
+	res, err := tx.Exec(s.dialect.Rebind(query), blockNum, spentTxHash, spentIndex, hash, index)
 	if err != nil {
 		return 0, err
 	}
@@ -94,7 +129,7 @@ func (s *Store) SpendOutput(txID int, hash string, index uint32) (int64, error)
 	return res.RowsAffected()
 }
 
-// DeleteOutputs delete all outputs with given block number.
+// DeleteOutputs delete all outputs with given block number and restores outputs spent in the block.
 func (s *Store) DeleteOutputs(txID int, blockNum uint64) error {
 	s.lock.RLock()
 	tx, exists := s.tx[txID]
@@ -106,7 +141,14 @@ func (s *Store) DeleteOutputs(txID int, blockNum uint64) error {
 
 	query := fmt.Sprintf("DELETE FROM %s WHERE block_id = ?", dbshared.UtxoTable)
 
-	_, err := tx.Exec(query, blockNum)
+	_, err := tx.Exec(s.dialect.Rebind(query), blockNum)
+	if err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET spent_block = NULL, spent_tx_hash = NULL, spent_index = NULL WHERE spent_block = ?", dbshared.UtxoTable)
+
+	_, err = tx.Exec(s.dialect.Rebind(query), blockNum)
 	if err != nil {
 		return err
 	}
@@ -114,6 +156,26 @@ func (s *Store) DeleteOutputs(txID int, blockNum uint64) error {
 	return nil
 }
 
+// PruneSpentOutputs removes outputs spent in the blocks with number less or equal to the given one.
+func (s *Store) PruneSpentOutputs(txID int, blockNum uint64) (int64, error) {
+	s.lock.RLock()
+	tx, exists := s.tx[txID]
+	s.lock.RUnlock()
+
+	if !exists {
+		return 0, errors.Errorf("OutputDB.PruneSpentOutputs: Undefined transaction #%d", txID)
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE spent_block IS NOT NULL AND spent_block <= ?", dbshared.UtxoTable)
+
+	res, err := tx.Exec(s.dialect.Rebind(query), blockNum)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
 // CreateTx create new database transaction and return it's ID.
 func (s *Store) CreateTx(isolated bool) (int, error) {
 	s.lock.RLock()
@@ -124,13 +186,8 @@ func (s *Store) CreateTx(isolated bool) (int, error) {
 		return 0, errors.New("Database is closing.")
 	}
 
-	txIsolation := sql.LevelDefault
-	if isolated {
-		txIsolation = sql.LevelSerializable
-	}
-
 	tx, err := s.db.BeginTx(context.Background(), &sql.TxOptions{
-		Isolation: txIsolation,
+		Isolation: s.dialect.TxIsolation(isolated),
 	})
 	if err != nil {
 		return 0, err
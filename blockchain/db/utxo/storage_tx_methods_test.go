@@ -0,0 +1,271 @@
+package utxo
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/raidoNetwork/RDO_v2/blockchain/db/iface"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	s, err := NewStore(context.Background(), &iface.SQLConfig{DataDir: t.TempDir(), Backend: SQLiteDialect})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return s
+}
+
+// writeTx runs f in the new database transaction and commits it
+func writeTx(t *testing.T, s *Store, f func(txID int)) {
+	t.Helper()
+
+	txID, err := s.CreateTx(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f(txID)
+
+	if err := s.CommitTx(txID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testOutput(txHash byte, index uint32, blockNum uint64) *types.UTxO {
+	return &types.UTxO{
+		BlockNum:  blockNum,
+		Hash:      common.BytesToHash(bytes.Repeat([]byte{txHash}, common.HashLength)),
+		Index:     index,
+		From:      common.BytesToAddress(bytes.Repeat([]byte{0x22}, common.AddressLength)),
+		To:        common.BytesToAddress(bytes.Repeat([]byte{0x33}, common.AddressLength)),
+		Amount:    5000,
+		Timestamp: 1660000000,
+		TxType:    common.NormalTxType,
+	}
+}
+
+func findOutput(t *testing.T, s *Store, uo *types.UTxO) *types.UTxO {
+	t.Helper()
+
+	saved, err := s.FindOutput(uo.Hash.Hex(), uo.Index)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return saved
+}
+
+func TestAddOutputIfNotExists(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing bool
+		spent    bool
+		txType   uint32
+	}{
+		{name: "new output", txType: common.NormalTxType},
+		{name: "existing output", existing: true, txType: common.NormalTxType},
+		{name: "spent output", existing: true, spent: true, txType: common.NormalTxType},
+		{name: "changed type", existing: true, spent: true, txType: common.RewardTxType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestStore(t)
+			uo := testOutput(0x11, 0, 10)
+
+			if tt.existing {
+				writeTx(t, s, func(txID int) {
+					if _, err := s.AddOutputBatch(txID, []*types.UTxO{uo}); err != nil {
+						t.Fatal(err)
+					}
+
+					if !tt.spent {
+						return
+					}
+
+					if _, err := s.SpendOutput(txID, uo.Hash.Hex(), uo.Index, 11, common.BytesToHash([]byte{0x55}).Hex(), 1); err != nil {
+						t.Fatal(err)
+					}
+				})
+			}
+
+			restored := testOutput(0x11, 0, 10)
+			restored.TxType = tt.txType
+			writeTx(t, s, func(txID int) {
+				if err := s.AddOutputIfNotExists(txID, restored); err != nil {
+					t.Fatal(err)
+				}
+			})
+
+			saved := findOutput(t, s, uo)
+			if saved == nil {
+				t.Fatal("Output is not found")
+			}
+
+			if saved.IsSpent() {
+				t.Errorf("Output is spent in block %d", saved.SpentBlock)
+			}
+
+			if saved.TxType != tt.txType {
+				t.Errorf("Wrong output type. Expected: %d. Given: %d", tt.txType, saved.TxType)
+			}
+
+			all, err := s.FindAllUTxO(uo.To.Hex())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(all) != 1 {
+				t.Errorf("Expected 1 unspent output. Given: %d", len(all))
+			}
+		})
+	}
+}
+
+func TestSpendOutput(t *testing.T) {
+	spentTxHash := common.BytesToHash([]byte{0x66})
+
+	tests := []struct {
+		name       string
+		hash       common.Hash
+		index      uint32
+		spendTwice bool
+		expected   int64
+	}{
+		{name: "unspent output", hash: testOutput(0x11, 0, 10).Hash, index: 0, expected: 1},
+		{name: "spent output", hash: testOutput(0x11, 0, 10).Hash, index: 0, spendTwice: true, expected: 0},
+		{name: "wrong index", hash: testOutput(0x11, 0, 10).Hash, index: 1, expected: 0},
+		{name: "unknown output", hash: testOutput(0x12, 0, 10).Hash, index: 0, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestStore(t)
+			uo := testOutput(0x11, 0, 10)
+
+			writeTx(t, s, func(txID int) {
+				if err := s.AddOutputIfNotExists(txID, uo); err != nil {
+					t.Fatal(err)
+				}
+
+				if !tt.spendTwice {
+					return
+				}
+
+				if _, err := s.SpendOutput(txID, tt.hash.Hex(), tt.index, 11, spentTxHash.Hex(), 3); err != nil {
+					t.Fatal(err)
+				}
+			})
+
+			var count int64
+			writeTx(t, s, func(txID int) {
+				var err error
+				count, err = s.SpendOutput(txID, tt.hash.Hex(), tt.index, 12, spentTxHash.Hex(), 3)
+				if err != nil {
+					t.Fatal(err)
+				}
+			})
+
+			if count != tt.expected {
+				t.Fatalf("Expected %d spent outputs. Given: %d", tt.expected, count)
+			}
+
+			saved := findOutput(t, s, uo)
+			if tt.expected == 0 {
+				if !tt.spendTwice && saved.IsSpent() {
+					t.Errorf("Output is spent with wrong key")
+				}
+
+				if tt.spendTwice && saved.SpentBlock != 11 {
+					t.Errorf("Spent block is overwritten with %d", saved.SpentBlock)
+				}
+
+				return
+			}
+
+			if saved.SpentBlock != 12 || saved.SpentIndex != 3 || !bytes.Equal(saved.SpentTxHash, spentTxHash) {
+				t.Errorf("Wrong spent data: %d %d %s", saved.SpentBlock, saved.SpentIndex, saved.SpentTxHash.Hex())
+			}
+
+			all, err := s.FindAllUTxO(uo.To.Hex())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(all) != 0 {
+				t.Errorf("Spent output is found as unspent")
+			}
+		})
+	}
+}
+
+func TestDeleteOutputs(t *testing.T) {
+	s := newTestStore(t)
+
+	prev := testOutput(0x11, 0, 10)
+	spentPrev := testOutput(0x11, 1, 10)
+	created := testOutput(0x12, 0, 11)
+	spentLater := testOutput(0x11, 2, 10)
+
+	writeTx(t, s, func(txID int) {
+		if _, err := s.AddOutputBatch(txID, []*types.UTxO{prev, spentPrev, spentLater}); err != nil {
+			t.Fatal(err)
+		}
+
+		// block 11 spends one output and creates another
+		if _, err := s.SpendOutput(txID, spentPrev.Hash.Hex(), spentPrev.Index, 11, created.Hash.Hex(), 0); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := s.AddOutputBatch(txID, []*types.UTxO{created}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := s.SpendOutput(txID, spentLater.Hash.Hex(), spentLater.Index, 12, common.BytesToHash([]byte{0x13}).Hex(), 0); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	writeTx(t, s, func(txID int) {
+		if err := s.DeleteOutputs(txID, 11); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		uo      *types.UTxO
+		exists  bool
+		spentIn uint64
+	}{
+		{name: "unspent output of previous block", uo: prev, exists: true},
+		{name: "output spent in deleted block", uo: spentPrev, exists: true},
+		{name: "output of deleted block", uo: created},
+		{name: "output spent in next block", uo: spentLater, exists: true, spentIn: 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := findOutput(t, s, tt.uo)
+			if (saved != nil) != tt.exists {
+				t.Fatalf("Output exists %v, expected %v", saved != nil, tt.exists)
+			}
+
+			if saved != nil && saved.SpentBlock != tt.spentIn {
+				t.Errorf("Wrong spent block. Expected: %d. Given: %d", tt.spentIn, saved.SpentBlock)
+			}
+		})
+	}
+}
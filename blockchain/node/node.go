@@ -52,9 +52,12 @@ type RDONode struct {
 	blockFeed events.Feed
 	txFeed    events.Feed
 	seedFeed  events.Feed
+	reorgFeed events.Feed
 	// validator events
 	proposeFeed     events.Feed
 	attestationFeed events.Feed
+	evidenceFeed    events.Feed
+	checkpointFeed  events.Feed
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -177,6 +180,8 @@ func (r *RDONode) InitValidatorService() error {
 		BlockFeed:       &r.blockFeed,
 		StateFeed:       &r.stateFeed,
 		SeedFeed:        &r.seedFeed,
+		EvidenceFeed:    &r.evidenceFeed,
+		CheckpointFeed:  &r.checkpointFeed,
 		Context:         r.ctx,
 	}
 
@@ -210,6 +215,7 @@ func (r *RDONode) registerCoreService() error {
 		AttestationPool: attestationService,
 		BlockFeed:       r.BlockFeed(),
 		StateFeed:       r.StateFeed(),
+		ReorgFeed:       &r.reorgFeed,
 		Context:         r.ctx,
 	}
 	srv, err := core.NewService(r.cliCtx, &cfg)
@@ -272,7 +278,12 @@ func (r *RDONode) registerGatewayService() error {
 
 func (r *RDONode) registerBlockchainService() error {
 	needRepairDB := r.cliCtx.Bool(cmd.RepairDB.Name)
-	srv, err := rdochain.NewService(r.kvStore, r.outDB, r.StateFeed(), needRepairDB)
+	spentRetention := r.cliCtx.Int(cmd.SpentOutputsRetention.Name)
+	if spentRetention < 0 {
+		return errors.Errorf("Wrong spent outputs retention: %d", spentRetention)
+	}
+
+	srv, err := rdochain.NewService(r.kvStore, r.outDB, r.StateFeed(), needRepairDB, uint64(spentRetention))
 	if err != nil {
 		return err
 	}
@@ -291,8 +302,10 @@ func (r *RDONode) registerAttestationService() error {
 	cfg := attestation.Config{
 		TxFeed:        r.TxFeed(),
 		StateFeed:     r.StateFeed(),
+		ReorgFeed:     &r.reorgFeed,
 		EnableMetrics: enableStats,
 		Blockchain:    blockchainService,
+		DataDir:       r.cliCtx.String(cmd.DataDirFlag.Name),
 	}
 	srv, err := attestation.NewService(r.ctx, &cfg)
 	if err != nil {
@@ -351,6 +364,8 @@ func (r *RDONode) registerSyncService() error {
 			ProposeFeed:     &r.proposeFeed,
 			AttestationFeed: &r.attestationFeed,
 			SeedFeed:        &r.seedFeed,
+			EvidenceFeed:    &r.evidenceFeed,
+			CheckpointFeed:  &r.checkpointFeed,
 		},
 	}
 	srv := rsync.NewService(r.ctx, &cfg)
@@ -440,13 +455,10 @@ func (r *RDONode) startDB(cliCtx *cli.Context) error {
 	}
 
 	// Prepare SQL database config
-	SQLCfg := db.SQLConfig{
-		ConfigPath: cliCtx.String(cmd.SQLConfigPath.Name),
-		DataDir:    dbPath,
-	}
+	SQLCfg := sqlConfig(cliCtx, dbPath)
 
 	// Init SQL database
-	sqlStore, err := db.NewUTxODB(r.ctx, &SQLCfg)
+	sqlStore, err := db.NewUTxODB(r.ctx, SQLCfg)
 	if err != nil {
 		return errors.Wrap(err, "could not create new SQL database")
 	}
@@ -486,6 +498,35 @@ func (r *RDONode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// sqlConfig returns outputs database config from the node flags
+func sqlConfig(cliCtx *cli.Context, dbPath string) *db.SQLConfig {
+	return &db.SQLConfig{
+		ConfigPath: cliCtx.String(cmd.SQLConfigPath.Name),
+		DataDir:    dbPath,
+		Backend:    cliCtx.String(cmd.UTxOBackend.Name),
+	}
+}
+
+// MigrateDryRun logs pending outputs database migrations without applying them.
+func MigrateDryRun(cliCtx *cli.Context) error {
+	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
+	dbPath := filepath.Join(baseDir, kv.RdoNodeDbDirName)
+
+	SQLCfg := sqlConfig(cliCtx, dbPath)
+	if SQLCfg.Backend == db.BoltBackend {
+		log.Info("Bolt outputs database has no schema migrations.")
+		return nil
+	}
+
+	SQLCfg.DryRun = true
+	sqlStore, err := db.NewUTxODB(context.Background(), SQLCfg)
+	if err != nil {
+		return errors.Wrap(err, "could not check outputs database migrations")
+	}
+
+	return sqlStore.Close()
+}
+
 func (r *RDONode) BlockFeed() *events.Feed {
 	return &r.blockFeed
 }
@@ -10,7 +10,6 @@ import (
 type blockQueue struct {
 	queue []*prototype.Block
 	hashMap map[string]struct{}
-	numMap map[uint64]int
 
 	mu sync.Mutex
 
@@ -30,15 +29,9 @@ func (bq *blockQueue) listen(data <-chan *prototype.Block) {
 				continue
 			}
 
-			if _, exists := bq.numMap[b.Num]; exists {
-				bq.mu.Unlock()
-				// todo add fork choice by num
-				continue
-			}
-
+			// blocks with the same num are kept for the fork choice
 			bq.queue = append(bq.queue, b)
 			bq.hashMap[hash] = struct{}{}
-			bq.numMap[b.Num] = len(bq.queue) - 1
 			bq.mu.Unlock()
 		case <-bq.ctx.Done():
 			return
@@ -54,7 +47,6 @@ func (bq *blockQueue) free() []*prototype.Block {
 		bq.mu.Lock()
 		bq.queue = make([]*prototype.Block, 0, 100)
 		bq.hashMap = map[string]struct{}{}
-		bq.numMap = map[uint64]int{}
 		bq.mu.Unlock()
 	} ()
 
@@ -10,7 +10,6 @@ func (s *Service) forkWatcher() {
 	bq := &blockQueue{
 		queue: make([]*prototype.Block, 0, 100),
 		hashMap: map[string]struct{}{},
-		numMap: map[uint64]int{},
 		ctx: ctx,
 		cancel: cancel,
 	}
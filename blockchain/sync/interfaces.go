@@ -23,6 +23,8 @@ type ValidatorGossipPublisher interface {
 	ValidatorAttNotifier() *events.Feed
 	ValidatorSeedNotifier() *events.Feed
 	ValidatorProposalNotifier() *events.Feed
+	ValidatorEvidenceNotifier() *events.Feed
+	ValidatorCheckpointNotifier() *events.Feed
 }
 
 type BlockchainInfo interface {
@@ -44,6 +44,8 @@ type ValidatorCfg struct {
 	ProposeFeed     *events.Feed
 	AttestationFeed *events.Feed
 	SeedFeed        *events.Feed
+	EvidenceFeed    *events.Feed
+	CheckpointFeed  *events.Feed
 	Enabled         bool
 }
 
@@ -260,7 +262,7 @@ func (s *Service) listenIncoming() {
 				s.forkBlockEvent <- block
 			} else {
 				bQueue := s.freeQueue()
-				for b := range bQueue {
+				for _, b := range bQueue {
 					s.cfg.BlockFeed.Send(b)
 				}
 
@@ -12,19 +12,27 @@ const (
 	seedGossipCount        = 50
 	proposalGossipCount    = 5
 	attestationGossipCount = 50
+	evidenceGossipCount    = 10
+	checkpointGossipCount  = 50
 )
 
 func (s *Service) listenValidatorTopics() {
 	seedNotification := make(chan p2p.Notty, seedGossipCount)
 	proposalNotification := make(chan p2p.Notty, proposalGossipCount)
 	attestationNotification := make(chan p2p.Notty, attestationGossipCount)
+	evidenceNotification := make(chan p2p.Notty, evidenceGossipCount)
+	checkpointNotification := make(chan p2p.Notty, checkpointGossipCount)
 	subSeed := s.cfg.P2P.ValidatorSeedNotifier().Subscribe(seedNotification)
 	subAtt := s.cfg.P2P.ValidatorAttNotifier().Subscribe(attestationNotification)
 	subProposal := s.cfg.P2P.ValidatorProposalNotifier().Subscribe(proposalNotification)
+	subEvidence := s.cfg.P2P.ValidatorEvidenceNotifier().Subscribe(evidenceNotification)
+	subCheckpoint := s.cfg.P2P.ValidatorCheckpointNotifier().Subscribe(checkpointNotification)
 	defer func() {
 		subSeed.Unsubscribe()
 		subAtt.Unsubscribe()
 		subProposal.Unsubscribe()
+		subEvidence.Unsubscribe()
+		subCheckpoint.Unsubscribe()
 	}()
 
 	for {
@@ -58,6 +66,22 @@ func (s *Service) listenValidatorTopics() {
 			}
 			s.cfg.Validator.SeedFeed.Send(seed)
 			receivedMessages.WithLabelValues(p2p.SeedTopic).Inc()
+		case notty := <-evidenceNotification:
+			evidence, err := serialize.UnmarshalEvidence(notty.Data)
+			if err != nil {
+				log.Errorf("Error unmarshaling slashing evidence: %s", err)
+				break
+			}
+			s.cfg.Validator.EvidenceFeed.Send(evidence)
+			receivedMessages.WithLabelValues(p2p.EvidenceTopic).Inc()
+		case notty := <-checkpointNotification:
+			vote, err := serialize.UnmarshalCheckpointVote(notty.Data)
+			if err != nil {
+				log.Errorf("Error unmarshaling checkpoint vote: %s", err)
+				break
+			}
+			s.cfg.Validator.CheckpointFeed.Send(vote)
+			receivedMessages.WithLabelValues(p2p.CheckpointTopic).Inc()
 		}
 	}
 }
@@ -66,15 +90,21 @@ func (s *Service) gossipValidatorMessages() {
 	proposeEvent := make(chan *prototype.Block, 5)
 	attEvent := make(chan *types.Attestation, 100)
 	seedEvent := make(chan *prototype.Seed, 100)
+	evidenceEvent := make(chan *prototype.SlashingEvidence, 10)
+	checkpointEvent := make(chan *prototype.CheckpointVote, 10)
 
 	proposeSub := s.cfg.Validator.ProposeFeed.Subscribe(proposeEvent)
 	attSub := s.cfg.Validator.AttestationFeed.Subscribe(attEvent)
 	seedSub := s.cfg.Validator.SeedFeed.Subscribe(seedEvent)
+	evidenceSub := s.cfg.Validator.EvidenceFeed.Subscribe(evidenceEvent)
+	checkpointSub := s.cfg.Validator.CheckpointFeed.Subscribe(checkpointEvent)
 
 	defer func() {
 		proposeSub.Unsubscribe()
 		attSub.Unsubscribe()
 		seedSub.Unsubscribe()
+		evidenceSub.Unsubscribe()
+		checkpointSub.Unsubscribe()
 	}()
 
 	for {
@@ -100,6 +130,26 @@ func (s *Service) gossipValidatorMessages() {
 			if err != nil {
 				log.Errorf("Error sending seed: %s", err)
 			}
+		case evidence := <-evidenceEvent:
+			raw, err := serialize.MarshalEvidence(evidence)
+			if err != nil {
+				log.Errorf("Error marshaling slashing evidence: %s", err)
+				continue
+			}
+			err = s.cfg.P2P.Publish(p2p.EvidenceTopic, raw)
+			if err != nil {
+				log.Errorf("Error sending slashing evidence: %s", err)
+			}
+		case vote := <-checkpointEvent:
+			raw, err := serialize.MarshalCheckpointVote(vote)
+			if err != nil {
+				log.Errorf("Error marshaling checkpoint vote: %s", err)
+				continue
+			}
+			err = s.cfg.P2P.Publish(p2p.CheckpointTopic, raw)
+			if err != nil {
+				log.Errorf("Error sending checkpoint vote: %s", err)
+			}
 		case att := <-attEvent:
 			raw, err := serialize.MarshalAttestation(att)
 			if err != nil {
@@ -112,7 +162,7 @@ func (s *Service) gossipValidatorMessages() {
 				log.Errorf("Error sending attestation: %s", err)
 			}
 
-			log.Debugf("Publish attestation for block #%d %s", att.Block.Num, common.Encode(att.Block.Hash))
+			log.Debugf("Publish attestation for block #%d %s", att.Header.Num, common.Encode(att.BlockHash))
 		case <-s.ctx.Done():
 			return
 		}
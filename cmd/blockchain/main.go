@@ -41,6 +41,9 @@ var appFlags = []cli.Flag{
 
 	// SQL config
 	cmd.SQLConfigPath,
+	cmd.UTxOBackend,
+	cmd.MigrateDryRun,
+	cmd.SpentOutputsRetention,
 
 	// p2p
 	flags.P2PHost,
@@ -114,6 +117,10 @@ func main() {
 }
 
 func startNode(ctx *cli.Context) error {
+	if ctx.Bool(cmd.MigrateDryRun.Name) {
+		return node.MigrateDryRun(ctx)
+	}
+
 	rdo, err := node.New(ctx)
 	if err != nil {
 		return errors.Wrap(err, "Node start error")
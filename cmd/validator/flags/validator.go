@@ -10,4 +10,9 @@ var (
 		Name:  "validator-key",
 		Usage: "Path to validator key path",
 	})
+	SlashingProtectionFile = &cli.StringFlag{
+		Name:     "file",
+		Usage:    "Path to slashing protection interchange JSON file",
+		Required: true,
+	}
 )
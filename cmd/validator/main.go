@@ -42,6 +42,9 @@ var appFlags = []cli.Flag{
 
 	// SQL config
 	cmd.SQLConfigPath,
+	cmd.UTxOBackend,
+	cmd.MigrateDryRun,
+	cmd.SpentOutputsRetention,
 
 	// p2p
 	flags.P2PHost,
@@ -70,7 +73,9 @@ func main() {
 	app.Usage = "Raido blockchain"
 	app.Action = startNode
 	app.Version = version.Version()
-	app.Commands = []*cli.Command{}
+	app.Commands = []*cli.Command{
+		slashingProtectionCommand,
+	}
 
 	app.Flags = appFlags
 
@@ -119,6 +124,10 @@ func main() {
 }
 
 func startNode(ctx *cli.Context) error {
+	if ctx.Bool(cmd.MigrateDryRun.Name) {
+		return node.MigrateDryRun(ctx)
+	}
+
 	rdo, err := node.New(ctx)
 	if err != nil {
 		return errors.Wrap(err, "Node start error")
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	vflags "github.com/raidoNetwork/RDO_v2/cmd/validator/flags"
+	"github.com/raidoNetwork/RDO_v2/shared/cmd"
+	"github.com/raidoNetwork/RDO_v2/validator"
+	"github.com/urfave/cli/v2"
+)
+
+var slashingProtectionFlags = []cli.Flag{
+	cmd.DataDirFlag,
+	vflags.ValidatorKey,
+	vflags.SlashingProtectionFile,
+}
+
+var slashingProtectionCommand = &cli.Command{
+	Name:  "slashing-protection",
+	Usage: "Import and export validator slashing protection data in EIP-3076 interchange format",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "export",
+			Usage:  "Export slashing protection data to the JSON file",
+			Flags:  slashingProtectionFlags,
+			Action: exportSlashingProtection,
+		},
+		{
+			Name:   "import",
+			Usage:  "Import slashing protection data from the JSON file",
+			Flags:  slashingProtectionFlags,
+			Action: importSlashingProtection,
+		},
+	},
+}
+
+func exportSlashingProtection(ctx *cli.Context) error {
+	store, err := validator.OpenProtection(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Error opening slashing protection database")
+	}
+	defer store.Close()
+
+	f, err := os.Create(ctx.String(vflags.SlashingProtectionFile.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := store.Export(f); err != nil {
+		return errors.Wrap(err, "Export error")
+	}
+
+	log.Infof("Slashing protection data exported to %s", f.Name())
+	return nil
+}
+
+func importSlashingProtection(ctx *cli.Context) error {
+	store, err := validator.OpenProtection(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Error opening slashing protection database")
+	}
+	defer store.Close()
+
+	f, err := os.Open(ctx.String(vflags.SlashingProtectionFile.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := store.Import(f); err != nil {
+		return errors.Wrap(err, "Import error")
+	}
+
+	log.Infof("Slashing protection data imported from %s", f.Name())
+	return nil
+}
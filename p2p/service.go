@@ -134,11 +134,13 @@ type Service struct {
 	startFail error
 
 	// Notifiers for both the validator and node
-	notifierTx       events.Feed
-	notifierBlock    events.Feed
-	notifierSeed     events.Feed
-	notifierAtt      events.Feed
-	notifierProposal events.Feed
+	notifierTx         events.Feed
+	notifierBlock      events.Feed
+	notifierSeed       events.Feed
+	notifierAtt        events.Feed
+	notifierProposal   events.Feed
+	notifierEvidence   events.Feed
+	notifierCheckpoint events.Feed
 
 	// discovery
 	dht *dht.IpfsDHT
@@ -422,6 +424,10 @@ func (s *Service) receiveMessage(msg *pubsub.Message, isValidatorMessage bool) {
 		s.notifierSeed.Send(n)
 	case AttestationTopic:
 		s.notifierAtt.Send(n)
+	case EvidenceTopic:
+		s.notifierEvidence.Send(n)
+	case CheckpointTopic:
+		s.notifierCheckpoint.Send(n)
 	default:
 		log.Errorf("Topic %s is not supported", n.Topic)
 	}
@@ -447,6 +453,14 @@ func (s *Service) ValidatorAttNotifier() *events.Feed {
 	return &s.notifierAtt
 }
 
+func (s *Service) ValidatorEvidenceNotifier() *events.Feed {
+	return &s.notifierEvidence
+}
+
+func (s *Service) ValidatorCheckpointNotifier() *events.Feed {
+	return &s.notifierCheckpoint
+}
+
 func (s *Service) AddConnectionHandlers(connectHandler, disconnectHandler ConnectionHandler) {
 	s.host.Network().Notify(&network.NotifyBundle{
 		ConnectedF: func(n network.Network, conn network.Conn) {
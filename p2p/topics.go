@@ -7,6 +7,8 @@ const (
 	seedSuffix        = "seed"
 	attestationSuffix = "attestation"
 	proposalSuffix    = "proposal"
+	evidenceSuffix    = "evidence"
+	checkpointSuffix  = "checkpoint-vote"
 	blockRangeSuffix  = "block-range"
 	metaSuffix        = "metadata"
 
@@ -17,6 +19,8 @@ const (
 	SeedTopic          = mainPrefix + seedSuffix
 	AttestationTopic   = mainPrefix + attestationSuffix
 	ProposalTopic      = mainPrefix + proposalSuffix
+	EvidenceTopic      = mainPrefix + evidenceSuffix
+	CheckpointTopic    = mainPrefix + checkpointSuffix
 )
 
 var topicMap = map[string]int{
@@ -28,4 +32,6 @@ var validatorMap = map[string]int{
 	SeedTopic:        3,
 	AttestationTopic: 4,
 	ProposalTopic:    5,
+	EvidenceTopic:    6,
+	CheckpointTopic:  7,
 }
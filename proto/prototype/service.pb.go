@@ -323,17 +323,19 @@ func (x *UTxOResponse) GetData() []*UTxO {
 	return nil
 }
 
-type StatusResponse struct {
+type AddressTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Cursor    string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit     uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Direction string `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"` // asc by default
 }
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
+func (x *AddressTransactionsRequest) Reset() {
+	*x = AddressTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,13 +343,13 @@ func (x *StatusResponse) Reset() {
 	}
 }
 
-func (x *StatusResponse) String() string {
+func (x *AddressTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*AddressTransactionsRequest) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+func (x *AddressTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -359,35 +361,51 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddressTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*AddressTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *StatusResponse) GetError() string {
+func (x *AddressTransactionsRequest) GetAddress() string {
 	if x != nil {
-		return x.Error
+		return x.Address
 	}
 	return ""
 }
 
-func (x *StatusResponse) GetData() string {
+func (x *AddressTransactionsRequest) GetCursor() string {
 	if x != nil {
-		return x.Data
+		return x.Cursor
 	}
 	return ""
 }
 
-type SendTxRequest struct {
+func (x *AddressTransactionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AddressTransactionsRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type AddressTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx *SignedTxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	BlockNum uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Index    uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"` // transaction index in the block
+	Tx       *TxValue `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
 }
 
-func (x *SendTxRequest) Reset() {
-	*x = SendTxRequest{}
+func (x *AddressTransaction) Reset() {
+	*x = AddressTransaction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,13 +413,13 @@ func (x *SendTxRequest) Reset() {
 	}
 }
 
-func (x *SendTxRequest) String() string {
+func (x *AddressTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTxRequest) ProtoMessage() {}
+func (*AddressTransaction) ProtoMessage() {}
 
-func (x *SendTxRequest) ProtoReflect() protoreflect.Message {
+func (x *AddressTransaction) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -413,28 +431,44 @@ func (x *SendTxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTxRequest.ProtoReflect.Descriptor instead.
-func (*SendTxRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddressTransaction.ProtoReflect.Descriptor instead.
+func (*AddressTransaction) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SendTxRequest) GetTx() *SignedTxValue {
+func (x *AddressTransaction) GetBlockNum() uint64 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *AddressTransaction) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *AddressTransaction) GetTx() *TxValue {
 	if x != nil {
 		return x.Tx
 	}
 	return nil
 }
 
-type ErrorResponse struct {
+type AddressTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Data       []*AddressTransaction `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Error      string                `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ErrorResponse) Reset() {
-	*x = ErrorResponse{}
+func (x *AddressTransactionsResponse) Reset() {
+	*x = AddressTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,13 +476,13 @@ func (x *ErrorResponse) Reset() {
 	}
 }
 
-func (x *ErrorResponse) String() string {
+func (x *AddressTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorResponse) ProtoMessage() {}
+func (*AddressTransactionsResponse) ProtoMessage() {}
 
-func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+func (x *AddressTransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,29 +494,43 @@ func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
-func (*ErrorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddressTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*AddressTransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ErrorResponse) GetError() string {
+func (x *AddressTransactionsResponse) GetData() []*AddressTransaction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AddressTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *AddressTransactionsResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type BlockResponse struct {
+type OutputRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Block *BlockValue `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	Error string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Hash  string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *BlockResponse) Reset() {
-	*x = BlockResponse{}
+func (x *OutputRequest) Reset() {
+	*x = OutputRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,13 +538,13 @@ func (x *BlockResponse) Reset() {
 	}
 }
 
-func (x *BlockResponse) String() string {
+func (x *OutputRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockResponse) ProtoMessage() {}
+func (*OutputRequest) ProtoMessage() {}
 
-func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+func (x *OutputRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,36 +556,36 @@ func (x *BlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
-func (*BlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputRequest.ProtoReflect.Descriptor instead.
+func (*OutputRequest) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BlockResponse) GetBlock() *BlockValue {
+func (x *OutputRequest) GetHash() string {
 	if x != nil {
-		return x.Block
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-func (x *BlockResponse) GetError() string {
+func (x *OutputRequest) GetIndex() uint32 {
 	if x != nil {
-		return x.Error
+		return x.Index
 	}
-	return ""
+	return 0
 }
 
-type TransactionResponse struct {
+type OutputResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx    *TxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	Error string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Output *UTxO  `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *TransactionResponse) Reset() {
-	*x = TransactionResponse{}
+func (x *OutputResponse) Reset() {
+	*x = OutputResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -545,13 +593,13 @@ func (x *TransactionResponse) Reset() {
 	}
 }
 
-func (x *TransactionResponse) String() string {
+func (x *OutputResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionResponse) ProtoMessage() {}
+func (*OutputResponse) ProtoMessage() {}
 
-func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *OutputResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -563,36 +611,37 @@ func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
-func (*TransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputResponse.ProtoReflect.Descriptor instead.
+func (*OutputResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TransactionResponse) GetTx() *TxValue {
+func (x *OutputResponse) GetOutput() *UTxO {
 	if x != nil {
-		return x.Tx
+		return x.Output
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetError() string {
+func (x *OutputResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type TransactionsResponse struct {
+type OutputsHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx    []*TxValue `protobuf:"bytes,1,rep,name=tx,proto3" json:"tx,omitempty"`
-	Error string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Cursor  uint64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit   uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *TransactionsResponse) Reset() {
-	*x = TransactionsResponse{}
+func (x *OutputsHistoryRequest) Reset() {
+	*x = OutputsHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,13 +649,13 @@ func (x *TransactionsResponse) Reset() {
 	}
 }
 
-func (x *TransactionsResponse) String() string {
+func (x *OutputsHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionsResponse) ProtoMessage() {}
+func (*OutputsHistoryRequest) ProtoMessage() {}
 
-func (x *TransactionsResponse) ProtoReflect() protoreflect.Message {
+func (x *OutputsHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -618,36 +667,44 @@ func (x *TransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionsResponse.ProtoReflect.Descriptor instead.
-func (*TransactionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*OutputsHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *TransactionsResponse) GetTx() []*TxValue {
+func (x *OutputsHistoryRequest) GetAddress() string {
 	if x != nil {
-		return x.Tx
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *TransactionsResponse) GetError() string {
+func (x *OutputsHistoryRequest) GetCursor() uint64 {
 	if x != nil {
-		return x.Error
+		return x.Cursor
 	}
-	return ""
+	return 0
 }
 
-type NumberResponse struct {
+func (x *OutputsHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type OutputsHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result uint64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Data       []*UTxO `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor uint64  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Error      string  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *NumberResponse) Reset() {
-	*x = NumberResponse{}
+func (x *OutputsHistoryResponse) Reset() {
+	*x = OutputsHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,13 +712,13 @@ func (x *NumberResponse) Reset() {
 	}
 }
 
-func (x *NumberResponse) String() string {
+func (x *OutputsHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumberResponse) ProtoMessage() {}
+func (*OutputsHistoryResponse) ProtoMessage() {}
 
-func (x *NumberResponse) ProtoReflect() protoreflect.Message {
+func (x *OutputsHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -673,37 +730,43 @@ func (x *NumberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumberResponse.ProtoReflect.Descriptor instead.
-func (*NumberResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*OutputsHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *NumberResponse) GetResult() uint64 {
+func (x *OutputsHistoryResponse) GetData() []*UTxO {
 	if x != nil {
-		return x.Result
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OutputsHistoryResponse) GetNextCursor() uint64 {
+	if x != nil {
+		return x.NextCursor
 	}
 	return 0
 }
 
-func (x *NumberResponse) GetError() string {
+func (x *OutputsHistoryResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type TxOptionsUnsafeRequest struct {
+type StatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fee     uint64           `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
-	Outputs []*TxOutputValue `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	Key     string           `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *TxOptionsUnsafeRequest) Reset() {
-	*x = TxOptionsUnsafeRequest{}
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -711,13 +774,13 @@ func (x *TxOptionsUnsafeRequest) Reset() {
 	}
 }
 
-func (x *TxOptionsUnsafeRequest) String() string {
+func (x *StatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxOptionsUnsafeRequest) ProtoMessage() {}
+func (*StatusResponse) ProtoMessage() {}
 
-func (x *TxOptionsUnsafeRequest) ProtoReflect() protoreflect.Message {
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -729,44 +792,35 @@ func (x *TxOptionsUnsafeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxOptionsUnsafeRequest.ProtoReflect.Descriptor instead.
-func (*TxOptionsUnsafeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TxOptionsUnsafeRequest) GetFee() uint64 {
-	if x != nil {
-		return x.Fee
-	}
-	return 0
-}
-
-func (x *TxOptionsUnsafeRequest) GetOutputs() []*TxOutputValue {
+func (x *StatusResponse) GetError() string {
 	if x != nil {
-		return x.Outputs
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *TxOptionsUnsafeRequest) GetKey() string {
+func (x *StatusResponse) GetData() string {
 	if x != nil {
-		return x.Key
+		return x.Data
 	}
 	return ""
 }
 
-type TxOptionsRequest struct {
+type SendTxRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fee     uint64           `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
-	Address string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Outputs []*TxOutputValue `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Tx *SignedTxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
 }
 
-func (x *TxOptionsRequest) Reset() {
-	*x = TxOptionsRequest{}
+func (x *SendTxRequest) Reset() {
+	*x = SendTxRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -774,13 +828,13 @@ func (x *TxOptionsRequest) Reset() {
 	}
 }
 
-func (x *TxOptionsRequest) String() string {
+func (x *SendTxRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxOptionsRequest) ProtoMessage() {}
+func (*SendTxRequest) ProtoMessage() {}
 
-func (x *TxOptionsRequest) ProtoReflect() protoreflect.Message {
+func (x *SendTxRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -792,45 +846,29 @@ func (x *TxOptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxOptionsRequest.ProtoReflect.Descriptor instead.
-func (*TxOptionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendTxRequest.ProtoReflect.Descriptor instead.
+func (*SendTxRequest) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TxOptionsRequest) GetFee() uint64 {
-	if x != nil {
-		return x.Fee
-	}
-	return 0
-}
-
-func (x *TxOptionsRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *TxOptionsRequest) GetOutputs() []*TxOutputValue {
+func (x *SendTxRequest) GetTx() *SignedTxValue {
 	if x != nil {
-		return x.Outputs
+		return x.Tx
 	}
 	return nil
 }
 
-type TxOptionsStakeUnsafeRequest struct {
+type ErrorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fee    uint64 `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
-	Key    string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Node   string `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	Error  string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // machine readable rejection reason of the transaction
 }
 
-func (x *TxOptionsStakeUnsafeRequest) Reset() {
-	*x = TxOptionsStakeUnsafeRequest{}
+func (x *ErrorResponse) Reset() {
+	*x = ErrorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -838,13 +876,13 @@ func (x *TxOptionsStakeUnsafeRequest) Reset() {
 	}
 }
 
-func (x *TxOptionsStakeUnsafeRequest) String() string {
+func (x *ErrorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxOptionsStakeUnsafeRequest) ProtoMessage() {}
+func (*ErrorResponse) ProtoMessage() {}
 
-func (x *TxOptionsStakeUnsafeRequest) ProtoReflect() protoreflect.Message {
+func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,52 +894,36 @@ func (x *TxOptionsStakeUnsafeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxOptionsStakeUnsafeRequest.ProtoReflect.Descriptor instead.
-func (*TxOptionsStakeUnsafeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TxOptionsStakeUnsafeRequest) GetFee() uint64 {
-	if x != nil {
-		return x.Fee
-	}
-	return 0
-}
-
-func (x *TxOptionsStakeUnsafeRequest) GetKey() string {
+func (x *ErrorResponse) GetError() string {
 	if x != nil {
-		return x.Key
+		return x.Error
 	}
 	return ""
 }
 
-func (x *TxOptionsStakeUnsafeRequest) GetAmount() uint64 {
+func (x *ErrorResponse) GetReason() string {
 	if x != nil {
-		return x.Amount
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *TxOptionsStakeUnsafeRequest) GetNode() string {
-	if x != nil {
-		return x.Node
-	}
-	return ""
-}
-
-type TxOptionsStakeRequest struct {
+type BlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fee     uint64 `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Node    string `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	Block *BlockValue `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Error string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *TxOptionsStakeRequest) Reset() {
-	*x = TxOptionsStakeRequest{}
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,13 +931,13 @@ func (x *TxOptionsStakeRequest) Reset() {
 	}
 }
 
-func (x *TxOptionsStakeRequest) String() string {
+func (x *BlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxOptionsStakeRequest) ProtoMessage() {}
+func (*BlockResponse) ProtoMessage() {}
 
-func (x *TxOptionsStakeRequest) ProtoReflect() protoreflect.Message {
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,49 +949,36 @@ func (x *TxOptionsStakeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxOptionsStakeRequest.ProtoReflect.Descriptor instead.
-func (*TxOptionsStakeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *TxOptionsStakeRequest) GetFee() uint64 {
-	if x != nil {
-		return x.Fee
-	}
-	return 0
-}
-
-func (x *TxOptionsStakeRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *TxOptionsStakeRequest) GetAmount() uint64 {
+func (x *BlockResponse) GetBlock() *BlockValue {
 	if x != nil {
-		return x.Amount
+		return x.Block
 	}
-	return 0
+	return nil
 }
 
-func (x *TxOptionsStakeRequest) GetNode() string {
+func (x *BlockResponse) GetError() string {
 	if x != nil {
-		return x.Node
+		return x.Error
 	}
 	return ""
 }
 
-type TxBodyUnsafeResponse struct {
+type TransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx *SignedTxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Tx    *TxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Error string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *TxBodyUnsafeResponse) Reset() {
-	*x = TxBodyUnsafeResponse{}
+func (x *TransactionResponse) Reset() {
+	*x = TransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,13 +986,13 @@ func (x *TxBodyUnsafeResponse) Reset() {
 	}
 }
 
-func (x *TxBodyUnsafeResponse) String() string {
+func (x *TransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxBodyUnsafeResponse) ProtoMessage() {}
+func (*TransactionResponse) ProtoMessage() {}
 
-func (x *TxBodyUnsafeResponse) ProtoReflect() protoreflect.Message {
+func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -995,28 +1004,36 @@ func (x *TxBodyUnsafeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxBodyUnsafeResponse.ProtoReflect.Descriptor instead.
-func (*TxBodyUnsafeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
+func (*TransactionResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TxBodyUnsafeResponse) GetTx() *SignedTxValue {
+func (x *TransactionResponse) GetTx() *TxValue {
 	if x != nil {
 		return x.Tx
 	}
 	return nil
 }
 
-type TxBodyResponse struct {
+func (x *TransactionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx *NotSignedTxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Tx    []*TxValue `protobuf:"bytes,1,rep,name=tx,proto3" json:"tx,omitempty"`
+	Error string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *TxBodyResponse) Reset() {
-	*x = TxBodyResponse{}
+func (x *TransactionsResponse) Reset() {
+	*x = TransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1024,13 +1041,13 @@ func (x *TxBodyResponse) Reset() {
 	}
 }
 
-func (x *TxBodyResponse) String() string {
+func (x *TransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxBodyResponse) ProtoMessage() {}
+func (*TransactionsResponse) ProtoMessage() {}
 
-func (x *TxBodyResponse) ProtoReflect() protoreflect.Message {
+func (x *TransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1042,28 +1059,37 @@ func (x *TxBodyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxBodyResponse.ProtoReflect.Descriptor instead.
-func (*TxBodyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionsResponse.ProtoReflect.Descriptor instead.
+func (*TransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TxBodyResponse) GetTx() *NotSignedTxValue {
+func (x *TransactionsResponse) GetTx() []*TxValue {
 	if x != nil {
 		return x.Tx
 	}
 	return nil
 }
 
-type RawTxRequest struct {
+func (x *TransactionsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PendingTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Tx     *TxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Error  string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Status string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pool status of the transaction: queued, pending, forged or dropped
 }
 
-func (x *RawTxRequest) Reset() {
-	*x = RawTxRequest{}
+func (x *PendingTransactionResponse) Reset() {
+	*x = PendingTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1071,13 +1097,13 @@ func (x *RawTxRequest) Reset() {
 	}
 }
 
-func (x *RawTxRequest) String() string {
+func (x *PendingTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RawTxRequest) ProtoMessage() {}
+func (*PendingTransactionResponse) ProtoMessage() {}
 
-func (x *RawTxRequest) ProtoReflect() protoreflect.Message {
+func (x *PendingTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,28 +1115,42 @@ func (x *RawTxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RawTxRequest.ProtoReflect.Descriptor instead.
-func (*RawTxRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PendingTransactionResponse.ProtoReflect.Descriptor instead.
+func (*PendingTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *RawTxRequest) GetData() string {
+func (x *PendingTransactionResponse) GetTx() *TxValue {
 	if x != nil {
-		return x.Data
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *PendingTransactionResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type ValidatorAddressesResponse struct {
+func (x *PendingTransactionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PendingSubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nodes []string `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *ValidatorAddressesResponse) Reset() {
-	*x = ValidatorAddressesResponse{}
+func (x *PendingSubscribeRequest) Reset() {
+	*x = PendingSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_prototype_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1118,13 +1158,13 @@ func (x *ValidatorAddressesResponse) Reset() {
 	}
 }
 
-func (x *ValidatorAddressesResponse) String() string {
+func (x *PendingSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorAddressesResponse) ProtoMessage() {}
+func (*PendingSubscribeRequest) ProtoMessage() {}
 
-func (x *ValidatorAddressesResponse) ProtoReflect() protoreflect.Message {
+func (x *PendingSubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_prototype_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1136,43 +1176,1092 @@ func (x *ValidatorAddressesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorAddressesResponse.ProtoReflect.Descriptor instead.
-func (*ValidatorAddressesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PendingSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*PendingSubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_prototype_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ValidatorAddressesResponse) GetNodes() []string {
+func (x *PendingSubscribeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *ReceiptValue `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Error   string        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ReceiptResponse) Reset() {
+	*x = ReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptResponse) ProtoMessage() {}
+
+func (x *ReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReceiptResponse) GetReceipt() *ReceiptValue {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *ReceiptResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type NumberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result uint64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *NumberResponse) Reset() {
+	*x = NumberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberResponse) ProtoMessage() {}
+
+func (x *NumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberResponse.ProtoReflect.Descriptor instead.
+func (*NumberResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NumberResponse) GetResult() uint64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *NumberResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result  uint64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"` // suggested fee price per byte
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	BaseFee uint64 `protobuf:"varint,3,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"` // base fee per byte of the next block
+	Tip     uint64 `protobuf:"varint,4,opt,name=tip,proto3" json:"tip,omitempty"`                        // suggested fee price above the base fee
+}
+
+func (x *FeeResponse) Reset() {
+	*x = FeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeResponse) ProtoMessage() {}
+
+func (x *FeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeResponse.ProtoReflect.Descriptor instead.
+func (*FeeResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FeeResponse) GetResult() uint64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *FeeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FeeResponse) GetBaseFee() uint64 {
+	if x != nil {
+		return x.BaseFee
+	}
+	return 0
+}
+
+func (x *FeeResponse) GetTip() uint64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+type TxOptionsUnsafeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fee     uint64           `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	Outputs []*TxOutputValue `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Key     string           `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TxOptionsUnsafeRequest) Reset() {
+	*x = TxOptionsUnsafeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxOptionsUnsafeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxOptionsUnsafeRequest) ProtoMessage() {}
+
+func (x *TxOptionsUnsafeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxOptionsUnsafeRequest.ProtoReflect.Descriptor instead.
+func (*TxOptionsUnsafeRequest) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TxOptionsUnsafeRequest) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TxOptionsUnsafeRequest) GetOutputs() []*TxOutputValue {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *TxOptionsUnsafeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TxOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fee     uint64           `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	Address string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Outputs []*TxOutputValue `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *TxOptionsRequest) Reset() {
+	*x = TxOptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxOptionsRequest) ProtoMessage() {}
+
+func (x *TxOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxOptionsRequest.ProtoReflect.Descriptor instead.
+func (*TxOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TxOptionsRequest) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TxOptionsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TxOptionsRequest) GetOutputs() []*TxOutputValue {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type TxOptionsStakeUnsafeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fee    uint64 `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Node   string `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *TxOptionsStakeUnsafeRequest) Reset() {
+	*x = TxOptionsStakeUnsafeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxOptionsStakeUnsafeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxOptionsStakeUnsafeRequest) ProtoMessage() {}
+
+func (x *TxOptionsStakeUnsafeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxOptionsStakeUnsafeRequest.ProtoReflect.Descriptor instead.
+func (*TxOptionsStakeUnsafeRequest) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TxOptionsStakeUnsafeRequest) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TxOptionsStakeUnsafeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TxOptionsStakeUnsafeRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TxOptionsStakeUnsafeRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type TxOptionsStakeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fee     uint64 `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Node    string `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *TxOptionsStakeRequest) Reset() {
+	*x = TxOptionsStakeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxOptionsStakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxOptionsStakeRequest) ProtoMessage() {}
+
+func (x *TxOptionsStakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxOptionsStakeRequest.ProtoReflect.Descriptor instead.
+func (*TxOptionsStakeRequest) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TxOptionsStakeRequest) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TxOptionsStakeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TxOptionsStakeRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TxOptionsStakeRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type TxBodyUnsafeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx *SignedTxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *TxBodyUnsafeResponse) Reset() {
+	*x = TxBodyUnsafeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxBodyUnsafeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxBodyUnsafeResponse) ProtoMessage() {}
+
+func (x *TxBodyUnsafeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxBodyUnsafeResponse.ProtoReflect.Descriptor instead.
+func (*TxBodyUnsafeResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TxBodyUnsafeResponse) GetTx() *SignedTxValue {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type TxBodyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx *NotSignedTxValue `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *TxBodyResponse) Reset() {
+	*x = TxBodyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxBodyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxBodyResponse) ProtoMessage() {}
+
+func (x *TxBodyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxBodyResponse.ProtoReflect.Descriptor instead.
+func (*TxBodyResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TxBodyResponse) GetTx() *NotSignedTxValue {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type RawTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RawTxRequest) Reset() {
+	*x = RawTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawTxRequest) ProtoMessage() {}
+
+func (x *RawTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawTxRequest.ProtoReflect.Descriptor instead.
+func (*RawTxRequest) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RawTxRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type RawTxBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RawTxBatchRequest) Reset() {
+	*x = RawTxBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawTxBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawTxBatchRequest) ProtoMessage() {}
+
+func (x *RawTxBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawTxBatchRequest.ProtoReflect.Descriptor instead.
+func (*RawTxBatchRequest) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RawTxBatchRequest) GetData() []string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TxBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`     // transaction hash, empty if transaction can't be decoded
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // accepted or rejected
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // machine readable rejection reason of the transaction
+}
+
+func (x *TxBatchResult) Reset() {
+	*x = TxBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxBatchResult) ProtoMessage() {}
+
+func (x *TxBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxBatchResult.ProtoReflect.Descriptor instead.
+func (*TxBatchResult) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TxBatchResult) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TxBatchResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TxBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TxBatchResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TxBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*TxBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // results in the order of the request transactions
+	Error   string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TxBatchResponse) Reset() {
+	*x = TxBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxBatchResponse) ProtoMessage() {}
+
+func (x *TxBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxBatchResponse.ProtoReflect.Descriptor instead.
+func (*TxBatchResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TxBatchResponse) GetResults() []*TxBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *TxBatchResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidatorAddressesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []string `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *ValidatorAddressesResponse) Reset() {
+	*x = ValidatorAddressesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorAddressesResponse) ProtoMessage() {}
+
+func (x *ValidatorAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ValidatorAddressesResponse) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type MarketCapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cap uint64 `protobuf:"varint,1,opt,name=cap,proto3" json:"cap,omitempty"`
+}
+
+func (x *MarketCapResponse) Reset() {
+	*x = MarketCapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketCapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketCapResponse) ProtoMessage() {}
+
+func (x *MarketCapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketCapResponse.ProtoReflect.Descriptor instead.
+func (*MarketCapResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MarketCapResponse) GetCap() uint64 {
+	if x != nil {
+		return x.Cap
+	}
+	return 0
+}
+
+type SlotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (x *SlotRequest) Reset() {
+	*x = SlotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotRequest) ProtoMessage() {}
+
+func (x *SlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotRequest.ProtoReflect.Descriptor instead.
+func (*SlotRequest) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SlotRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type CommitteeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error      string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Slot       uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch      uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Validators []string `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *CommitteeResponse) Reset() {
+	*x = CommitteeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitteeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitteeResponse) ProtoMessage() {}
+
+func (x *CommitteeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitteeResponse.ProtoReflect.Descriptor instead.
+func (*CommitteeResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CommitteeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CommitteeResponse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CommitteeResponse) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CommitteeResponse) GetValidators() []string {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+type ChainInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error            string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ChainId          uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GenesisHash      string `protobuf:"bytes,3,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	LegacyTxDeadline uint64 `protobuf:"varint,4,opt,name=legacy_tx_deadline,json=legacyTxDeadline,proto3" json:"legacy_tx_deadline,omitempty"` // block number until which transactions signed without domain are accepted
+}
+
+func (x *ChainInfoResponse) Reset() {
+	*x = ChainInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainInfoResponse) ProtoMessage() {}
+
+func (x *ChainInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainInfoResponse.ProtoReflect.Descriptor instead.
+func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChainInfoResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ChainInfoResponse) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ChainInfoResponse) GetGenesisHash() string {
+	if x != nil {
+		return x.GenesisHash
+	}
+	return ""
+}
+
+func (x *ChainInfoResponse) GetLegacyTxDeadline() uint64 {
 	if x != nil {
-		return x.Nodes
+		return x.LegacyTxDeadline
 	}
-	return nil
+	return 0
 }
 
-type MarketCapResponse struct {
+type FinalityStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cap uint64 `protobuf:"varint,1,opt,name=cap,proto3" json:"cap,omitempty"`
+	Error     string           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	HeadEpoch uint64           `protobuf:"varint,2,opt,name=headEpoch,proto3" json:"headEpoch,omitempty"`
+	Justified *CheckpointValue `protobuf:"bytes,3,opt,name=justified,proto3" json:"justified,omitempty"`
+	Finalized *CheckpointValue `protobuf:"bytes,4,opt,name=finalized,proto3" json:"finalized,omitempty"`
 }
 
-func (x *MarketCapResponse) Reset() {
-	*x = MarketCapResponse{}
+func (x *FinalityStatusResponse) Reset() {
+	*x = FinalityStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_prototype_service_proto_msgTypes[21]
+		mi := &file_prototype_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketCapResponse) String() string {
+func (x *FinalityStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketCapResponse) ProtoMessage() {}
+func (*FinalityStatusResponse) ProtoMessage() {}
 
-func (x *MarketCapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prototype_service_proto_msgTypes[21]
+func (x *FinalityStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,18 +2272,39 @@ func (x *MarketCapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketCapResponse.ProtoReflect.Descriptor instead.
-func (*MarketCapResponse) Descriptor() ([]byte, []int) {
-	return file_prototype_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use FinalityStatusResponse.ProtoReflect.Descriptor instead.
+func (*FinalityStatusResponse) Descriptor() ([]byte, []int) {
+	return file_prototype_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *MarketCapResponse) GetCap() uint64 {
+func (x *FinalityStatusResponse) GetError() string {
 	if x != nil {
-		return x.Cap
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FinalityStatusResponse) GetHeadEpoch() uint64 {
+	if x != nil {
+		return x.HeadEpoch
 	}
 	return 0
 }
 
+func (x *FinalityStatusResponse) GetJustified() *CheckpointValue {
+	if x != nil {
+		return x.Justified
+	}
+	return nil
+}
+
+func (x *FinalityStatusResponse) GetFinalized() *CheckpointValue {
+	if x != nil {
+		return x.Finalized
+	}
+	return nil
+}
+
 var File_prototype_service_proto protoreflect.FileDescriptor
 
 var file_prototype_service_proto_rawDesc = []byte{
@@ -1236,291 +2346,508 @@ var file_prototype_service_proto_rawDesc = []byte{
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x54, 0x78, 0x4f, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x41, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x02, 0x74, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x0d, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x64, 0x6f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x57, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x58, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01, 0x2a, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0x98, 0x01,
+	0x1a, 0xd0, 0x01, 0x01, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x2a, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x12, 0xfa, 0x42, 0x0f, 0x72, 0x0d, 0x52, 0x00, 0x52, 0x03, 0x61, 0x73, 0x63, 0x52, 0x04, 0x64,
+	0x65, 0x73, 0x63, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73,
+	0x0a, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x02, 0x74, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x0e, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x54, 0x78,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01, 0x42, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x84,
-	0x01, 0x0a, 0x10, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01, 0x2a,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x64, 0x6f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
-	0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x1b, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01, 0x42, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x22,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01, 0x2a, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x22, 0x48, 0x0a, 0x14, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x55, 0x6e, 0x73, 0x61, 0x66,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x02, 0x74, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x54, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x78, 0x22, 0x45, 0x0a, 0x0e, 0x54,
-	0x78, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
-	0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x64, 0x6f, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
-	0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
-	0x74, 0x78, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x61, 0x77, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x32, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x11, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x63, 0x61,
-	0x70, 0x32, 0x90, 0x0b, 0x0a, 0x0a, 0x52, 0x61, 0x69, 0x64, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x12, 0x67, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x54, 0x78, 0x4f, 0x12, 0x1b, 0x2e, 0x72, 0x64,
-	0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x54, 0x78, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x5e, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b,
-	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x12, 0x17, 0x2e, 0x72, 0x64, 0x6f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x7b, 0x6e, 0x75,
-	0x6d, 0x7d, 0x12, 0x6f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x2f, 0x7b, 0x68, 0x61,
-	0x73, 0x68, 0x7d, 0x12, 0x6f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x7d, 0x12, 0x76, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x74, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x02, 0x74, 0x78, 0x22, 0x89, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x43, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01, 0x42, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x57, 0x0a, 0x0e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x54, 0x78, 0x4f, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73, 0x0a,
+	0x15, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01,
+	0x2a, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x7c, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x64, 0x6f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
+	0x54, 0x78, 0x4f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x0d,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x78, 0x22,
+	0x3d, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5a,
+	0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x57, 0x0a, 0x13, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x54, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x58, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x02, 0x74,
+	0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x76, 0x0a,
+	0x1a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x02, 0x74,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x17, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0x98, 0x01, 0x2a, 0xd0, 0x01, 0x01, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x62, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x64,
+	0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x0e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x68, 0x0a, 0x0b, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65,
+	0x46, 0x65, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x74, 0x69, 0x70, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66,
+	0x65, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x1a,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0x98, 0x01, 0x42, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x54,
+	0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x12, 0x22, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01, 0x2a, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x22, 0x8a, 0x01, 0x0a, 0x1b, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x98, 0x01, 0x42, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x8c,
+	0x01, 0x0a, 0x15, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0x98, 0x01, 0x2a, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x0a,
+	0x14, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x78, 0x22, 0x45, 0x0a, 0x0e, 0x54, 0x78, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x02, 0x74, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x54, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x78, 0x22, 0x22,
+	0x0a, 0x0c, 0x52, 0x61, 0x77, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x34, 0x0a, 0x11, 0x52, 0x61, 0x77, 0x54, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x92, 0x01, 0x05, 0x10, 0xf4, 0x03,
+	0x08, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x69, 0x0a, 0x0d, 0x54, 0x78, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x0f, 0x54, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x32, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x43, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x63, 0x61, 0x70, 0x22, 0x21, 0x0a,
+	0x0b, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x22, 0x73, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x2c, 0x0a, 0x12, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x74, 0x78, 0x5f, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x54, 0x78, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xd0, 0x01,
+	0x0a, 0x16, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x40, 0x0a, 0x09,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x09, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x40,
+	0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x32, 0xfb, 0x11, 0x0a, 0x0a, 0x52, 0x61, 0x69, 0x64, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x67, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x54, 0x78, 0x4f, 0x12, 0x1b, 0x2e, 0x72, 0x64, 0x6f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x54, 0x78, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2f, 0x7b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x5e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e,
+	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x12, 0x17, 0x2e, 0x72, 0x64, 0x6f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x7b, 0x6e, 0x75, 0x6d,
+	0x7d, 0x12, 0x6f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x2f, 0x7b, 0x68, 0x61, 0x73,
+	0x68, 0x7d, 0x12, 0x6f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
 	0x12, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x54, 0x78, 0x4f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
-	0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x72, 0x64,
-	0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x24, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x72, 0x61, 0x6e, 0x67,
-	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27,
-	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
-	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6c,
-	0x69, 0x73, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x85,
-	0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27,
-	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
-	0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6c,
-	0x69, 0x73, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x6c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e,
-	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x63, 0x61, 0x70, 0x32, 0xbe, 0x05, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x67, 0x61,
-	0x63, 0x79, 0x54, 0x78, 0x12, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2f, 0x6c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e,
-	0x64, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x0d, 0x53, 0x65,
-	0x6e, 0x64, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x1a, 0x2e, 0x72, 0x64,
-	0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x78,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2f, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x01, 0x2a,
-	0x12, 0x6b, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x78, 0x12, 0x19, 0x2e,
-	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x77, 0x54,
-	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2f, 0x72, 0x61, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x5e, 0x0a,
-	0x06, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x12, 0x85, 0x01,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x12, 0x76, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x81, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x18, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x99, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x72, 0x64, 0x6f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x71, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2f,
+	0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0x8d,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x2f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x74,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x54,
+	0x78, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e,
+	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x64, 0x6f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12,
+	0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x27, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x85, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x27, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x6c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1e, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x63, 0x61, 0x70, 0x12, 0x70, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x2f, 0x7b, 0x73, 0x6c, 0x6f, 0x74, 0x7d, 0x12, 0x70, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x62, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x32, 0xff,
+	0x09, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72,
+	0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x54, 0x78, 0x12, 0x1a,
+	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x3a,
+	0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54,
+	0x78, 0x12, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2f, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2f,
+	0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x6b, 0x0a, 0x09, 0x53, 0x65,
+	0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x78, 0x12, 0x19, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64,
+	0x2f, 0x72, 0x61, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x52,
+	0x61, 0x77, 0x54, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x72, 0x64, 0x6f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x78, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x64, 0x6f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22,
+	0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2f, 0x72, 0x61, 0x77, 0x2f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x5b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x66, 0x65, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x12, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x21, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54,
 	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x61, 0x70,
+	0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xb1, 0x06, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x89, 0x01, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x53, 0x65,
-	0x6e, 0x64, 0x12, 0x23, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x55, 0x6e, 0x73, 0x61,
-	0x66, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2d, 0x22, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x12,
-	0x92, 0x01, 0x0a, 0x0d, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54,
-	0x78, 0x12, 0x28, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x55, 0x6e,
-	0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x64,
-	0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79,
-	0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x55,
-	0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x28, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12,
+	0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12,
+	0x9e, 0x01, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x24, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x30, 0x01,
+	0x32, 0xb1, 0x06, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x89,
+	0x01, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x23, 0x2e,
+	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x2e, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2b, 0x2f,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x28, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f,
 	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x75, 0x6e, 0x73, 0x61,
-	0x66, 0x65, 0x2f, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a,
-	0x04, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x1d, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x12,
-	0x79, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x22, 0x2e, 0x72, 0x64, 0x6f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42,
-	0x6f, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x09, 0x55, 0x6e,
-	0x73, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x22, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x64,
-	0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29,
-	0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x75,
-	0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x42, 0x80, 0x02, 0x5a, 0x15, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74,
-	0x79, 0x70, 0x65, 0x92, 0x41, 0xe5, 0x01, 0x12, 0x40, 0x0a, 0x14, 0x52, 0x61, 0x69, 0x64, 0x6f,
-	0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x20, 0x41, 0x50, 0x49, 0x12,
-	0x23, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x52, 0x45, 0x53, 0x54, 0x20, 0x41, 0x50, 0x49, 0x20, 0x66,
-	0x6f, 0x72, 0x20, 0x52, 0x61, 0x69, 0x64, 0x6f, 0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x1a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x68, 0x6f, 0x73, 0x74, 0x3a, 0x35, 0x35, 0x35, 0x35, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x32, 0x19,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2d, 0x77, 0x65, 0x62, 0x2d, 0x74, 0x65, 0x78, 0x74, 0x32, 0x19, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x77, 0x65, 0x62, 0x2d,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x19, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x77, 0x65, 0x62, 0x2d, 0x74, 0x65, 0x78,
-	0x74, 0x3a, 0x19, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67,
-	0x72, 0x70, 0x63, 0x2d, 0x77, 0x65, 0x62, 0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x65, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x92, 0x01, 0x0a, 0x0d, 0x55,
+	0x6e, 0x73, 0x61, 0x66, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x28, 0x2e, 0x72,
+	0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x55, 0x6e, 0x73, 0x61, 0x66,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2e, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x96, 0x01, 0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x54, 0x78, 0x12, 0x28, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x6f,
+	0x64, 0x79, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x2f, 0x75, 0x6e,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x04, 0x53, 0x65, 0x6e, 0x64,
+	0x12, 0x1d, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54,
+	0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x79, 0x0a, 0x07, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x22, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x22,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x09, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x54, 0x78, 0x12, 0x22, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x78, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x24, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x3a, 0x01, 0x2a, 0x42, 0x80, 0x02, 0x5a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79,
+	0x70, 0x65, 0x2f, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x92, 0x41,
+	0xe5, 0x01, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x32, 0x19, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x77, 0x65, 0x62, 0x2d, 0x74, 0x65,
+	0x78, 0x74, 0x32, 0x19, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2d, 0x77, 0x65, 0x62, 0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x19, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2d, 0x77, 0x65, 0x62, 0x2d, 0x74, 0x65, 0x78, 0x74, 0x3a, 0x19, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x77, 0x65, 0x62,
+	0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x14, 0x52, 0x61, 0x69, 0x64, 0x6f, 0x20, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x20, 0x41, 0x50, 0x49, 0x32, 0x03, 0x31,
+	0x2e, 0x30, 0x12, 0x23, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x52, 0x45, 0x53, 0x54, 0x20, 0x41, 0x50,
+	0x49, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x52, 0x61, 0x69, 0x64, 0x6f, 0x20, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x1a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f,
+	0x73, 0x74, 0x3a, 0x35, 0x35, 0x35, 0x35, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1535,7 +2862,7 @@ func file_prototype_service_proto_rawDescGZIP() []byte {
 	return file_prototype_service_proto_rawDescData
 }
 
-var file_prototype_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_prototype_service_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_prototype_service_proto_goTypes = []interface{}{
 	(*AddressRequest)(nil),              // 0: rdo.service.AddressRequest
 	(*NumRequest)(nil),                  // 1: rdo.service.NumRequest
@@ -1543,94 +2870,145 @@ var file_prototype_service_proto_goTypes = []interface{}{
 	(*BlocksStartCountRequest)(nil),     // 3: rdo.service.BlocksStartCountRequest
 	(*BlocksStartCountResponse)(nil),    // 4: rdo.service.BlocksStartCountResponse
 	(*UTxOResponse)(nil),                // 5: rdo.service.UTxOResponse
-	(*StatusResponse)(nil),              // 6: rdo.service.StatusResponse
-	(*SendTxRequest)(nil),               // 7: rdo.service.SendTxRequest
-	(*ErrorResponse)(nil),               // 8: rdo.service.ErrorResponse
-	(*BlockResponse)(nil),               // 9: rdo.service.BlockResponse
-	(*TransactionResponse)(nil),         // 10: rdo.service.TransactionResponse
-	(*TransactionsResponse)(nil),        // 11: rdo.service.TransactionsResponse
-	(*NumberResponse)(nil),              // 12: rdo.service.NumberResponse
-	(*TxOptionsUnsafeRequest)(nil),      // 13: rdo.service.TxOptionsUnsafeRequest
-	(*TxOptionsRequest)(nil),            // 14: rdo.service.TxOptionsRequest
-	(*TxOptionsStakeUnsafeRequest)(nil), // 15: rdo.service.TxOptionsStakeUnsafeRequest
-	(*TxOptionsStakeRequest)(nil),       // 16: rdo.service.TxOptionsStakeRequest
-	(*TxBodyUnsafeResponse)(nil),        // 17: rdo.service.TxBodyUnsafeResponse
-	(*TxBodyResponse)(nil),              // 18: rdo.service.TxBodyResponse
-	(*RawTxRequest)(nil),                // 19: rdo.service.RawTxRequest
-	(*ValidatorAddressesResponse)(nil),  // 20: rdo.service.ValidatorAddressesResponse
-	(*MarketCapResponse)(nil),           // 21: rdo.service.MarketCapResponse
-	(*BlockValue)(nil),                  // 22: rdo.service.types.BlockValue
-	(*UTxO)(nil),                        // 23: rdo.service.types.UTxO
-	(*SignedTxValue)(nil),               // 24: rdo.service.types.SignedTxValue
-	(*TxValue)(nil),                     // 25: rdo.service.types.TxValue
-	(*TxOutputValue)(nil),               // 26: rdo.service.types.TxOutputValue
-	(*NotSignedTxValue)(nil),            // 27: rdo.service.types.NotSignedTxValue
-	(*emptypb.Empty)(nil),               // 28: google.protobuf.Empty
+	(*AddressTransactionsRequest)(nil),  // 6: rdo.service.AddressTransactionsRequest
+	(*AddressTransaction)(nil),          // 7: rdo.service.AddressTransaction
+	(*AddressTransactionsResponse)(nil), // 8: rdo.service.AddressTransactionsResponse
+	(*OutputRequest)(nil),               // 9: rdo.service.OutputRequest
+	(*OutputResponse)(nil),              // 10: rdo.service.OutputResponse
+	(*OutputsHistoryRequest)(nil),       // 11: rdo.service.OutputsHistoryRequest
+	(*OutputsHistoryResponse)(nil),      // 12: rdo.service.OutputsHistoryResponse
+	(*StatusResponse)(nil),              // 13: rdo.service.StatusResponse
+	(*SendTxRequest)(nil),               // 14: rdo.service.SendTxRequest
+	(*ErrorResponse)(nil),               // 15: rdo.service.ErrorResponse
+	(*BlockResponse)(nil),               // 16: rdo.service.BlockResponse
+	(*TransactionResponse)(nil),         // 17: rdo.service.TransactionResponse
+	(*TransactionsResponse)(nil),        // 18: rdo.service.TransactionsResponse
+	(*PendingTransactionResponse)(nil),  // 19: rdo.service.PendingTransactionResponse
+	(*PendingSubscribeRequest)(nil),     // 20: rdo.service.PendingSubscribeRequest
+	(*ReceiptResponse)(nil),             // 21: rdo.service.ReceiptResponse
+	(*NumberResponse)(nil),              // 22: rdo.service.NumberResponse
+	(*FeeResponse)(nil),                 // 23: rdo.service.FeeResponse
+	(*TxOptionsUnsafeRequest)(nil),      // 24: rdo.service.TxOptionsUnsafeRequest
+	(*TxOptionsRequest)(nil),            // 25: rdo.service.TxOptionsRequest
+	(*TxOptionsStakeUnsafeRequest)(nil), // 26: rdo.service.TxOptionsStakeUnsafeRequest
+	(*TxOptionsStakeRequest)(nil),       // 27: rdo.service.TxOptionsStakeRequest
+	(*TxBodyUnsafeResponse)(nil),        // 28: rdo.service.TxBodyUnsafeResponse
+	(*TxBodyResponse)(nil),              // 29: rdo.service.TxBodyResponse
+	(*RawTxRequest)(nil),                // 30: rdo.service.RawTxRequest
+	(*RawTxBatchRequest)(nil),           // 31: rdo.service.RawTxBatchRequest
+	(*TxBatchResult)(nil),               // 32: rdo.service.TxBatchResult
+	(*TxBatchResponse)(nil),             // 33: rdo.service.TxBatchResponse
+	(*ValidatorAddressesResponse)(nil),  // 34: rdo.service.ValidatorAddressesResponse
+	(*MarketCapResponse)(nil),           // 35: rdo.service.MarketCapResponse
+	(*SlotRequest)(nil),                 // 36: rdo.service.SlotRequest
+	(*CommitteeResponse)(nil),           // 37: rdo.service.CommitteeResponse
+	(*ChainInfoResponse)(nil),           // 38: rdo.service.ChainInfoResponse
+	(*FinalityStatusResponse)(nil),      // 39: rdo.service.FinalityStatusResponse
+	(*BlockValue)(nil),                  // 40: rdo.service.types.BlockValue
+	(*UTxO)(nil),                        // 41: rdo.service.types.UTxO
+	(*TxValue)(nil),                     // 42: rdo.service.types.TxValue
+	(*SignedTxValue)(nil),               // 43: rdo.service.types.SignedTxValue
+	(*ReceiptValue)(nil),                // 44: rdo.service.types.ReceiptValue
+	(*TxOutputValue)(nil),               // 45: rdo.service.types.TxOutputValue
+	(*NotSignedTxValue)(nil),            // 46: rdo.service.types.NotSignedTxValue
+	(*CheckpointValue)(nil),             // 47: rdo.service.types.CheckpointValue
+	(*emptypb.Empty)(nil),               // 48: google.protobuf.Empty
 }
 var file_prototype_service_proto_depIdxs = []int32{
-	22, // 0: rdo.service.BlocksStartCountResponse.blocks:type_name -> rdo.service.types.BlockValue
-	23, // 1: rdo.service.UTxOResponse.data:type_name -> rdo.service.types.UTxO
-	24, // 2: rdo.service.SendTxRequest.tx:type_name -> rdo.service.types.SignedTxValue
-	22, // 3: rdo.service.BlockResponse.block:type_name -> rdo.service.types.BlockValue
-	25, // 4: rdo.service.TransactionResponse.tx:type_name -> rdo.service.types.TxValue
-	25, // 5: rdo.service.TransactionsResponse.tx:type_name -> rdo.service.types.TxValue
-	26, // 6: rdo.service.TxOptionsUnsafeRequest.outputs:type_name -> rdo.service.types.TxOutputValue
-	26, // 7: rdo.service.TxOptionsRequest.outputs:type_name -> rdo.service.types.TxOutputValue
-	24, // 8: rdo.service.TxBodyUnsafeResponse.tx:type_name -> rdo.service.types.SignedTxValue
-	27, // 9: rdo.service.TxBodyResponse.tx:type_name -> rdo.service.types.NotSignedTxValue
-	0,  // 10: rdo.service.RaidoChain.GetUTxO:input_type -> rdo.service.AddressRequest
-	28, // 11: rdo.service.RaidoChain.GetStatus:input_type -> google.protobuf.Empty
-	1,  // 12: rdo.service.RaidoChain.GetBlockByNum:input_type -> rdo.service.NumRequest
-	2,  // 13: rdo.service.RaidoChain.GetBlockByHash:input_type -> rdo.service.HashRequest
-	0,  // 14: rdo.service.RaidoChain.GetBalance:input_type -> rdo.service.AddressRequest
-	2,  // 15: rdo.service.RaidoChain.GetTransaction:input_type -> rdo.service.HashRequest
-	0,  // 16: rdo.service.RaidoChain.GetStakeDeposits:input_type -> rdo.service.AddressRequest
-	0,  // 17: rdo.service.RaidoChain.GetTransactionsCount:input_type -> rdo.service.AddressRequest
-	3,  // 18: rdo.service.RaidoChain.GetBlocksStartCount:input_type -> rdo.service.BlocksStartCountRequest
-	28, // 19: rdo.service.RaidoChain.ListValidators:input_type -> google.protobuf.Empty
-	28, // 20: rdo.service.RaidoChain.ListStakeValidators:input_type -> google.protobuf.Empty
-	28, // 21: rdo.service.RaidoChain.GetMarketCap:input_type -> google.protobuf.Empty
-	7,  // 22: rdo.service.Attestation.SendLegacyTx:input_type -> rdo.service.SendTxRequest
-	7,  // 23: rdo.service.Attestation.SendStakeTx:input_type -> rdo.service.SendTxRequest
-	7,  // 24: rdo.service.Attestation.SendUnstakeTx:input_type -> rdo.service.SendTxRequest
-	19, // 25: rdo.service.Attestation.SendRawTx:input_type -> rdo.service.RawTxRequest
-	28, // 26: rdo.service.Attestation.GetFee:input_type -> google.protobuf.Empty
-	28, // 27: rdo.service.Attestation.GetPendingTransactions:input_type -> google.protobuf.Empty
-	13, // 28: rdo.service.Generator.UnsafeSend:input_type -> rdo.service.TxOptionsUnsafeRequest
-	15, // 29: rdo.service.Generator.UnsafeStakeTx:input_type -> rdo.service.TxOptionsStakeUnsafeRequest
-	15, // 30: rdo.service.Generator.UnsafeUnstakeTx:input_type -> rdo.service.TxOptionsStakeUnsafeRequest
-	14, // 31: rdo.service.Generator.Send:input_type -> rdo.service.TxOptionsRequest
-	16, // 32: rdo.service.Generator.StakeTx:input_type -> rdo.service.TxOptionsStakeRequest
-	16, // 33: rdo.service.Generator.UnstakeTx:input_type -> rdo.service.TxOptionsStakeRequest
-	5,  // 34: rdo.service.RaidoChain.GetUTxO:output_type -> rdo.service.UTxOResponse
-	6,  // 35: rdo.service.RaidoChain.GetStatus:output_type -> rdo.service.StatusResponse
-	9,  // 36: rdo.service.RaidoChain.GetBlockByNum:output_type -> rdo.service.BlockResponse
-	9,  // 37: rdo.service.RaidoChain.GetBlockByHash:output_type -> rdo.service.BlockResponse
-	12, // 38: rdo.service.RaidoChain.GetBalance:output_type -> rdo.service.NumberResponse
-	10, // 39: rdo.service.RaidoChain.GetTransaction:output_type -> rdo.service.TransactionResponse
-	5,  // 40: rdo.service.RaidoChain.GetStakeDeposits:output_type -> rdo.service.UTxOResponse
-	12, // 41: rdo.service.RaidoChain.GetTransactionsCount:output_type -> rdo.service.NumberResponse
-	4,  // 42: rdo.service.RaidoChain.GetBlocksStartCount:output_type -> rdo.service.BlocksStartCountResponse
-	20, // 43: rdo.service.RaidoChain.ListValidators:output_type -> rdo.service.ValidatorAddressesResponse
-	20, // 44: rdo.service.RaidoChain.ListStakeValidators:output_type -> rdo.service.ValidatorAddressesResponse
-	21, // 45: rdo.service.RaidoChain.GetMarketCap:output_type -> rdo.service.MarketCapResponse
-	8,  // 46: rdo.service.Attestation.SendLegacyTx:output_type -> rdo.service.ErrorResponse
-	8,  // 47: rdo.service.Attestation.SendStakeTx:output_type -> rdo.service.ErrorResponse
-	8,  // 48: rdo.service.Attestation.SendUnstakeTx:output_type -> rdo.service.ErrorResponse
-	8,  // 49: rdo.service.Attestation.SendRawTx:output_type -> rdo.service.ErrorResponse
-	12, // 50: rdo.service.Attestation.GetFee:output_type -> rdo.service.NumberResponse
-	11, // 51: rdo.service.Attestation.GetPendingTransactions:output_type -> rdo.service.TransactionsResponse
-	17, // 52: rdo.service.Generator.UnsafeSend:output_type -> rdo.service.TxBodyUnsafeResponse
-	17, // 53: rdo.service.Generator.UnsafeStakeTx:output_type -> rdo.service.TxBodyUnsafeResponse
-	17, // 54: rdo.service.Generator.UnsafeUnstakeTx:output_type -> rdo.service.TxBodyUnsafeResponse
-	18, // 55: rdo.service.Generator.Send:output_type -> rdo.service.TxBodyResponse
-	18, // 56: rdo.service.Generator.StakeTx:output_type -> rdo.service.TxBodyResponse
-	18, // 57: rdo.service.Generator.UnstakeTx:output_type -> rdo.service.TxBodyResponse
-	34, // [34:58] is the sub-list for method output_type
-	10, // [10:34] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	40, // 0: rdo.service.BlocksStartCountResponse.blocks:type_name -> rdo.service.types.BlockValue
+	41, // 1: rdo.service.UTxOResponse.data:type_name -> rdo.service.types.UTxO
+	42, // 2: rdo.service.AddressTransaction.tx:type_name -> rdo.service.types.TxValue
+	7,  // 3: rdo.service.AddressTransactionsResponse.data:type_name -> rdo.service.AddressTransaction
+	41, // 4: rdo.service.OutputResponse.output:type_name -> rdo.service.types.UTxO
+	41, // 5: rdo.service.OutputsHistoryResponse.data:type_name -> rdo.service.types.UTxO
+	43, // 6: rdo.service.SendTxRequest.tx:type_name -> rdo.service.types.SignedTxValue
+	40, // 7: rdo.service.BlockResponse.block:type_name -> rdo.service.types.BlockValue
+	42, // 8: rdo.service.TransactionResponse.tx:type_name -> rdo.service.types.TxValue
+	42, // 9: rdo.service.TransactionsResponse.tx:type_name -> rdo.service.types.TxValue
+	42, // 10: rdo.service.PendingTransactionResponse.tx:type_name -> rdo.service.types.TxValue
+	44, // 11: rdo.service.ReceiptResponse.receipt:type_name -> rdo.service.types.ReceiptValue
+	45, // 12: rdo.service.TxOptionsUnsafeRequest.outputs:type_name -> rdo.service.types.TxOutputValue
+	45, // 13: rdo.service.TxOptionsRequest.outputs:type_name -> rdo.service.types.TxOutputValue
+	43, // 14: rdo.service.TxBodyUnsafeResponse.tx:type_name -> rdo.service.types.SignedTxValue
+	46, // 15: rdo.service.TxBodyResponse.tx:type_name -> rdo.service.types.NotSignedTxValue
+	32, // 16: rdo.service.TxBatchResponse.results:type_name -> rdo.service.TxBatchResult
+	47, // 17: rdo.service.FinalityStatusResponse.justified:type_name -> rdo.service.types.CheckpointValue
+	47, // 18: rdo.service.FinalityStatusResponse.finalized:type_name -> rdo.service.types.CheckpointValue
+	0,  // 19: rdo.service.RaidoChain.GetUTxO:input_type -> rdo.service.AddressRequest
+	48, // 20: rdo.service.RaidoChain.GetStatus:input_type -> google.protobuf.Empty
+	1,  // 21: rdo.service.RaidoChain.GetBlockByNum:input_type -> rdo.service.NumRequest
+	2,  // 22: rdo.service.RaidoChain.GetBlockByHash:input_type -> rdo.service.HashRequest
+	0,  // 23: rdo.service.RaidoChain.GetBalance:input_type -> rdo.service.AddressRequest
+	2,  // 24: rdo.service.RaidoChain.GetTransaction:input_type -> rdo.service.HashRequest
+	2,  // 25: rdo.service.RaidoChain.GetTransactionReceipt:input_type -> rdo.service.HashRequest
+	6,  // 26: rdo.service.RaidoChain.GetAddressTransactions:input_type -> rdo.service.AddressTransactionsRequest
+	9,  // 27: rdo.service.RaidoChain.GetOutput:input_type -> rdo.service.OutputRequest
+	11, // 28: rdo.service.RaidoChain.GetOutputsHistory:input_type -> rdo.service.OutputsHistoryRequest
+	0,  // 29: rdo.service.RaidoChain.GetStakeDeposits:input_type -> rdo.service.AddressRequest
+	0,  // 30: rdo.service.RaidoChain.GetTransactionsCount:input_type -> rdo.service.AddressRequest
+	3,  // 31: rdo.service.RaidoChain.GetBlocksStartCount:input_type -> rdo.service.BlocksStartCountRequest
+	48, // 32: rdo.service.RaidoChain.ListValidators:input_type -> google.protobuf.Empty
+	48, // 33: rdo.service.RaidoChain.ListStakeValidators:input_type -> google.protobuf.Empty
+	48, // 34: rdo.service.RaidoChain.GetMarketCap:input_type -> google.protobuf.Empty
+	36, // 35: rdo.service.RaidoChain.GetCommittee:input_type -> rdo.service.SlotRequest
+	48, // 36: rdo.service.RaidoChain.GetFinalityStatus:input_type -> google.protobuf.Empty
+	48, // 37: rdo.service.RaidoChain.GetChainInfo:input_type -> google.protobuf.Empty
+	14, // 38: rdo.service.Attestation.SendLegacyTx:input_type -> rdo.service.SendTxRequest
+	14, // 39: rdo.service.Attestation.SendStakeTx:input_type -> rdo.service.SendTxRequest
+	14, // 40: rdo.service.Attestation.SendUnstakeTx:input_type -> rdo.service.SendTxRequest
+	30, // 41: rdo.service.Attestation.SendRawTx:input_type -> rdo.service.RawTxRequest
+	31, // 42: rdo.service.Attestation.SendRawTxBatch:input_type -> rdo.service.RawTxBatchRequest
+	48, // 43: rdo.service.Attestation.GetFee:input_type -> google.protobuf.Empty
+	48, // 44: rdo.service.Attestation.GetPendingTransactions:input_type -> google.protobuf.Empty
+	0,  // 45: rdo.service.Attestation.GetPendingByAddress:input_type -> rdo.service.AddressRequest
+	2,  // 46: rdo.service.Attestation.GetPendingTransaction:input_type -> rdo.service.HashRequest
+	20, // 47: rdo.service.Attestation.SubscribePendingTransactions:input_type -> rdo.service.PendingSubscribeRequest
+	24, // 48: rdo.service.Generator.UnsafeSend:input_type -> rdo.service.TxOptionsUnsafeRequest
+	26, // 49: rdo.service.Generator.UnsafeStakeTx:input_type -> rdo.service.TxOptionsStakeUnsafeRequest
+	26, // 50: rdo.service.Generator.UnsafeUnstakeTx:input_type -> rdo.service.TxOptionsStakeUnsafeRequest
+	25, // 51: rdo.service.Generator.Send:input_type -> rdo.service.TxOptionsRequest
+	27, // 52: rdo.service.Generator.StakeTx:input_type -> rdo.service.TxOptionsStakeRequest
+	27, // 53: rdo.service.Generator.UnstakeTx:input_type -> rdo.service.TxOptionsStakeRequest
+	5,  // 54: rdo.service.RaidoChain.GetUTxO:output_type -> rdo.service.UTxOResponse
+	13, // 55: rdo.service.RaidoChain.GetStatus:output_type -> rdo.service.StatusResponse
+	16, // 56: rdo.service.RaidoChain.GetBlockByNum:output_type -> rdo.service.BlockResponse
+	16, // 57: rdo.service.RaidoChain.GetBlockByHash:output_type -> rdo.service.BlockResponse
+	22, // 58: rdo.service.RaidoChain.GetBalance:output_type -> rdo.service.NumberResponse
+	17, // 59: rdo.service.RaidoChain.GetTransaction:output_type -> rdo.service.TransactionResponse
+	21, // 60: rdo.service.RaidoChain.GetTransactionReceipt:output_type -> rdo.service.ReceiptResponse
+	8,  // 61: rdo.service.RaidoChain.GetAddressTransactions:output_type -> rdo.service.AddressTransactionsResponse
+	10, // 62: rdo.service.RaidoChain.GetOutput:output_type -> rdo.service.OutputResponse
+	12, // 63: rdo.service.RaidoChain.GetOutputsHistory:output_type -> rdo.service.OutputsHistoryResponse
+	5,  // 64: rdo.service.RaidoChain.GetStakeDeposits:output_type -> rdo.service.UTxOResponse
+	22, // 65: rdo.service.RaidoChain.GetTransactionsCount:output_type -> rdo.service.NumberResponse
+	4,  // 66: rdo.service.RaidoChain.GetBlocksStartCount:output_type -> rdo.service.BlocksStartCountResponse
+	34, // 67: rdo.service.RaidoChain.ListValidators:output_type -> rdo.service.ValidatorAddressesResponse
+	34, // 68: rdo.service.RaidoChain.ListStakeValidators:output_type -> rdo.service.ValidatorAddressesResponse
+	35, // 69: rdo.service.RaidoChain.GetMarketCap:output_type -> rdo.service.MarketCapResponse
+	37, // 70: rdo.service.RaidoChain.GetCommittee:output_type -> rdo.service.CommitteeResponse
+	39, // 71: rdo.service.RaidoChain.GetFinalityStatus:output_type -> rdo.service.FinalityStatusResponse
+	38, // 72: rdo.service.RaidoChain.GetChainInfo:output_type -> rdo.service.ChainInfoResponse
+	15, // 73: rdo.service.Attestation.SendLegacyTx:output_type -> rdo.service.ErrorResponse
+	15, // 74: rdo.service.Attestation.SendStakeTx:output_type -> rdo.service.ErrorResponse
+	15, // 75: rdo.service.Attestation.SendUnstakeTx:output_type -> rdo.service.ErrorResponse
+	15, // 76: rdo.service.Attestation.SendRawTx:output_type -> rdo.service.ErrorResponse
+	33, // 77: rdo.service.Attestation.SendRawTxBatch:output_type -> rdo.service.TxBatchResponse
+	23, // 78: rdo.service.Attestation.GetFee:output_type -> rdo.service.FeeResponse
+	18, // 79: rdo.service.Attestation.GetPendingTransactions:output_type -> rdo.service.TransactionsResponse
+	18, // 80: rdo.service.Attestation.GetPendingByAddress:output_type -> rdo.service.TransactionsResponse
+	19, // 81: rdo.service.Attestation.GetPendingTransaction:output_type -> rdo.service.PendingTransactionResponse
+	19, // 82: rdo.service.Attestation.SubscribePendingTransactions:output_type -> rdo.service.PendingTransactionResponse
+	28, // 83: rdo.service.Generator.UnsafeSend:output_type -> rdo.service.TxBodyUnsafeResponse
+	28, // 84: rdo.service.Generator.UnsafeStakeTx:output_type -> rdo.service.TxBodyUnsafeResponse
+	28, // 85: rdo.service.Generator.UnsafeUnstakeTx:output_type -> rdo.service.TxBodyUnsafeResponse
+	29, // 86: rdo.service.Generator.Send:output_type -> rdo.service.TxBodyResponse
+	29, // 87: rdo.service.Generator.StakeTx:output_type -> rdo.service.TxBodyResponse
+	29, // 88: rdo.service.Generator.UnstakeTx:output_type -> rdo.service.TxBodyResponse
+	54, // [54:89] is the sub-list for method output_type
+	19, // [19:54] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_prototype_service_proto_init() }
@@ -1713,7 +3091,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+			switch v := v.(*AddressTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1725,7 +3103,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTxRequest); i {
+			switch v := v.(*AddressTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1737,7 +3115,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrorResponse); i {
+			switch v := v.(*AddressTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1749,7 +3127,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockResponse); i {
+			switch v := v.(*OutputRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1761,7 +3139,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionResponse); i {
+			switch v := v.(*OutputResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1773,7 +3151,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionsResponse); i {
+			switch v := v.(*OutputsHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1785,7 +3163,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NumberResponse); i {
+			switch v := v.(*OutputsHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1797,7 +3175,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxOptionsUnsafeRequest); i {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1809,7 +3187,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxOptionsRequest); i {
+			switch v := v.(*SendTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1821,7 +3199,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxOptionsStakeUnsafeRequest); i {
+			switch v := v.(*ErrorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1833,7 +3211,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxOptionsStakeRequest); i {
+			switch v := v.(*BlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1845,7 +3223,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxBodyUnsafeResponse); i {
+			switch v := v.(*TransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1857,7 +3235,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxBodyResponse); i {
+			switch v := v.(*TransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1869,7 +3247,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawTxRequest); i {
+			switch v := v.(*PendingTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1881,7 +3259,7 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorAddressesResponse); i {
+			switch v := v.(*PendingSubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1893,6 +3271,174 @@ func file_prototype_service_proto_init() {
 			}
 		}
 		file_prototype_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxOptionsUnsafeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxOptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxOptionsStakeUnsafeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxOptionsStakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxBodyUnsafeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxBodyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawTxBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorAddressesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketCapResponse); i {
 			case 0:
 				return &v.state
@@ -1904,6 +3450,54 @@ func file_prototype_service_proto_init() {
 				return nil
 			}
 		}
+		file_prototype_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitteeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalityStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1911,7 +3505,7 @@ func file_prototype_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_prototype_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -745,46 +745,92 @@ var _ interface {
 	ErrorName() string
 } = UTxOResponseValidationError{}
 
-// Validate checks the field values on StatusResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *StatusResponse) Validate() error {
+// Validate checks the field values on AddressTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddressTransactionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StatusResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StatusResponseMultiError,
-// or nil if none found.
-func (m *StatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddressTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddressTransactionsRequestMultiError, or nil if none found.
+func (m *AddressTransactionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StatusResponse) validate(all bool) error {
+func (m *AddressTransactionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Error
+	if utf8.RuneCountInString(m.GetAddress()) != 42 {
+		err := AddressTransactionsRequestValidationError{
+			field:  "Address",
+			reason: "value length must be 42 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 
-	// no validation rules for Data
+	}
+
+	if m.GetCursor() != "" {
+
+		if utf8.RuneCountInString(m.GetCursor()) != 26 {
+			err := AddressTransactionsRequestValidationError{
+				field:  "Cursor",
+				reason: "value length must be 26 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+	}
+
+	if m.GetLimit() > 1000 {
+		err := AddressTransactionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AddressTransactionsRequest_Direction_InLookup[m.GetDirection()]; !ok {
+		err := AddressTransactionsRequestValidationError{
+			field:  "Direction",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return StatusResponseMultiError(errors)
+		return AddressTransactionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StatusResponseMultiError is an error wrapping multiple validation errors
-// returned by StatusResponse.ValidateAll() if the designated constraints
-// aren't met.
-type StatusResponseMultiError []error
+// AddressTransactionsRequestMultiError is an error wrapping multiple
+// validation errors returned by AddressTransactionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddressTransactionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StatusResponseMultiError) Error() string {
+func (m AddressTransactionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -793,11 +839,11 @@ func (m StatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StatusResponseMultiError) AllErrors() []error { return m }
+func (m AddressTransactionsRequestMultiError) AllErrors() []error { return m }
 
-// StatusResponseValidationError is the validation error returned by
-// StatusResponse.Validate if the designated constraints aren't met.
-type StatusResponseValidationError struct {
+// AddressTransactionsRequestValidationError is the validation error returned
+// by AddressTransactionsRequest.Validate if the designated constraints aren't met.
+type AddressTransactionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -805,22 +851,24 @@ type StatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StatusResponseValidationError) Field() string { return e.field }
+func (e AddressTransactionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StatusResponseValidationError) Reason() string { return e.reason }
+func (e AddressTransactionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StatusResponseValidationError) Cause() error { return e.cause }
+func (e AddressTransactionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StatusResponseValidationError) Key() bool { return e.key }
+func (e AddressTransactionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StatusResponseValidationError) ErrorName() string { return "StatusResponseValidationError" }
+func (e AddressTransactionsRequestValidationError) ErrorName() string {
+	return "AddressTransactionsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StatusResponseValidationError) Error() string {
+func (e AddressTransactionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -832,14 +880,14 @@ func (e StatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStatusResponse.%s: %s%s",
+		"invalid %sAddressTransactionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StatusResponseValidationError{}
+var _ error = AddressTransactionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -847,35 +895,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StatusResponseValidationError{}
+} = AddressTransactionsRequestValidationError{}
 
-// Validate checks the field values on SendTxRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SendTxRequest) Validate() error {
+var _AddressTransactionsRequest_Direction_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on AddressTransaction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddressTransaction) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SendTxRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SendTxRequestMultiError, or
-// nil if none found.
-func (m *SendTxRequest) ValidateAll() error {
+// ValidateAll checks the field values on AddressTransaction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddressTransactionMultiError, or nil if none found.
+func (m *AddressTransaction) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SendTxRequest) validate(all bool) error {
+func (m *AddressTransaction) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for BlockNum
+
+	// no validation rules for Index
+
 	if all {
 		switch v := interface{}(m.GetTx()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SendTxRequestValidationError{
+				errors = append(errors, AddressTransactionValidationError{
 					field:  "Tx",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -883,7 +941,7 @@ func (m *SendTxRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SendTxRequestValidationError{
+				errors = append(errors, AddressTransactionValidationError{
 					field:  "Tx",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -892,7 +950,7 @@ func (m *SendTxRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SendTxRequestValidationError{
+			return AddressTransactionValidationError{
 				field:  "Tx",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -901,19 +959,19 @@ func (m *SendTxRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SendTxRequestMultiError(errors)
+		return AddressTransactionMultiError(errors)
 	}
 
 	return nil
 }
 
-// SendTxRequestMultiError is an error wrapping multiple validation errors
-// returned by SendTxRequest.ValidateAll() if the designated constraints
+// AddressTransactionMultiError is an error wrapping multiple validation errors
+// returned by AddressTransaction.ValidateAll() if the designated constraints
 // aren't met.
-type SendTxRequestMultiError []error
+type AddressTransactionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SendTxRequestMultiError) Error() string {
+func (m AddressTransactionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -922,11 +980,11 @@ func (m SendTxRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SendTxRequestMultiError) AllErrors() []error { return m }
+func (m AddressTransactionMultiError) AllErrors() []error { return m }
 
-// SendTxRequestValidationError is the validation error returned by
-// SendTxRequest.Validate if the designated constraints aren't met.
-type SendTxRequestValidationError struct {
+// AddressTransactionValidationError is the validation error returned by
+// AddressTransaction.Validate if the designated constraints aren't met.
+type AddressTransactionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -934,22 +992,24 @@ type SendTxRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendTxRequestValidationError) Field() string { return e.field }
+func (e AddressTransactionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendTxRequestValidationError) Reason() string { return e.reason }
+func (e AddressTransactionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendTxRequestValidationError) Cause() error { return e.cause }
+func (e AddressTransactionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendTxRequestValidationError) Key() bool { return e.key }
+func (e AddressTransactionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendTxRequestValidationError) ErrorName() string { return "SendTxRequestValidationError" }
+func (e AddressTransactionValidationError) ErrorName() string {
+	return "AddressTransactionValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SendTxRequestValidationError) Error() string {
+func (e AddressTransactionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -961,14 +1021,14 @@ func (e SendTxRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendTxRequest.%s: %s%s",
+		"invalid %sAddressTransaction.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendTxRequestValidationError{}
+var _ error = AddressTransactionValidationError{}
 
 var _ interface {
 	Field() string
@@ -976,46 +1036,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendTxRequestValidationError{}
+} = AddressTransactionValidationError{}
 
-// Validate checks the field values on ErrorResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ErrorResponse) Validate() error {
+// Validate checks the field values on AddressTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddressTransactionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ErrorResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ErrorResponseMultiError, or
-// nil if none found.
-func (m *ErrorResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddressTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddressTransactionsResponseMultiError, or nil if none found.
+func (m *AddressTransactionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ErrorResponse) validate(all bool) error {
+func (m *AddressTransactionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddressTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddressTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddressTransactionsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
 	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return ErrorResponseMultiError(errors)
+		return AddressTransactionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ErrorResponseMultiError is an error wrapping multiple validation errors
-// returned by ErrorResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ErrorResponseMultiError []error
+// AddressTransactionsResponseMultiError is an error wrapping multiple
+// validation errors returned by AddressTransactionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddressTransactionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ErrorResponseMultiError) Error() string {
+func (m AddressTransactionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1024,11 +1120,12 @@ func (m ErrorResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ErrorResponseMultiError) AllErrors() []error { return m }
+func (m AddressTransactionsResponseMultiError) AllErrors() []error { return m }
 
-// ErrorResponseValidationError is the validation error returned by
-// ErrorResponse.Validate if the designated constraints aren't met.
-type ErrorResponseValidationError struct {
+// AddressTransactionsResponseValidationError is the validation error returned
+// by AddressTransactionsResponse.Validate if the designated constraints
+// aren't met.
+type AddressTransactionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1036,22 +1133,24 @@ type ErrorResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ErrorResponseValidationError) Field() string { return e.field }
+func (e AddressTransactionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ErrorResponseValidationError) Reason() string { return e.reason }
+func (e AddressTransactionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ErrorResponseValidationError) Cause() error { return e.cause }
+func (e AddressTransactionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ErrorResponseValidationError) Key() bool { return e.key }
+func (e AddressTransactionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ErrorResponseValidationError) ErrorName() string { return "ErrorResponseValidationError" }
+func (e AddressTransactionsResponseValidationError) ErrorName() string {
+	return "AddressTransactionsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ErrorResponseValidationError) Error() string {
+func (e AddressTransactionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1063,14 +1162,14 @@ func (e ErrorResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sErrorResponse.%s: %s%s",
+		"invalid %sAddressTransactionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ErrorResponseValidationError{}
+var _ error = AddressTransactionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1078,75 +1177,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ErrorResponseValidationError{}
+} = AddressTransactionsResponseValidationError{}
 
-// Validate checks the field values on BlockResponse with the rules defined in
+// Validate checks the field values on OutputRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *BlockResponse) Validate() error {
+func (m *OutputRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BlockResponse with the rules defined
+// ValidateAll checks the field values on OutputRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BlockResponseMultiError, or
+// result is a list of violation errors wrapped in OutputRequestMultiError, or
 // nil if none found.
-func (m *BlockResponse) ValidateAll() error {
+func (m *OutputRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BlockResponse) validate(all bool) error {
+func (m *OutputRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBlock()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BlockResponseValidationError{
-					field:  "Block",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BlockResponseValidationError{
-					field:  "Block",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetHash()) != 66 {
+		err := OutputRequestValidationError{
+			field:  "Hash",
+			reason: "value length must be 66 runes",
 		}
-	} else if v, ok := interface{}(m.GetBlock()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BlockResponseValidationError{
-				field:  "Block",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+
 	}
 
-	// no validation rules for Error
+	// no validation rules for Index
 
 	if len(errors) > 0 {
-		return BlockResponseMultiError(errors)
+		return OutputRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BlockResponseMultiError is an error wrapping multiple validation errors
-// returned by BlockResponse.ValidateAll() if the designated constraints
+// OutputRequestMultiError is an error wrapping multiple validation errors
+// returned by OutputRequest.ValidateAll() if the designated constraints
 // aren't met.
-type BlockResponseMultiError []error
+type OutputRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BlockResponseMultiError) Error() string {
+func (m OutputRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1155,11 +1237,11 @@ func (m BlockResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BlockResponseMultiError) AllErrors() []error { return m }
+func (m OutputRequestMultiError) AllErrors() []error { return m }
 
-// BlockResponseValidationError is the validation error returned by
-// BlockResponse.Validate if the designated constraints aren't met.
-type BlockResponseValidationError struct {
+// OutputRequestValidationError is the validation error returned by
+// OutputRequest.Validate if the designated constraints aren't met.
+type OutputRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1167,22 +1249,22 @@ type BlockResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BlockResponseValidationError) Field() string { return e.field }
+func (e OutputRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BlockResponseValidationError) Reason() string { return e.reason }
+func (e OutputRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BlockResponseValidationError) Cause() error { return e.cause }
+func (e OutputRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BlockResponseValidationError) Key() bool { return e.key }
+func (e OutputRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BlockResponseValidationError) ErrorName() string { return "BlockResponseValidationError" }
+func (e OutputRequestValidationError) ErrorName() string { return "OutputRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BlockResponseValidationError) Error() string {
+func (e OutputRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1194,14 +1276,14 @@ func (e BlockResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBlockResponse.%s: %s%s",
+		"invalid %sOutputRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BlockResponseValidationError{}
+var _ error = OutputRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1209,24 +1291,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BlockResponseValidationError{}
+} = OutputRequestValidationError{}
 
-// Validate checks the field values on TransactionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransactionResponse) Validate() error {
+// Validate checks the field values on OutputResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutputResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransactionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransactionResponseMultiError, or nil if none found.
-func (m *TransactionResponse) ValidateAll() error {
+// ValidateAll checks the field values on OutputResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutputResponseMultiError,
+// or nil if none found.
+func (m *OutputResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransactionResponse) validate(all bool) error {
+func (m *OutputResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1234,28 +1316,28 @@ func (m *TransactionResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTx()).(type) {
+		switch v := interface{}(m.GetOutput()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TransactionResponseValidationError{
-					field:  "Tx",
+				errors = append(errors, OutputResponseValidationError{
+					field:  "Output",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TransactionResponseValidationError{
-					field:  "Tx",
+				errors = append(errors, OutputResponseValidationError{
+					field:  "Output",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOutput()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TransactionResponseValidationError{
-				field:  "Tx",
+			return OutputResponseValidationError{
+				field:  "Output",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1265,19 +1347,19 @@ func (m *TransactionResponse) validate(all bool) error {
 	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return TransactionResponseMultiError(errors)
+		return OutputResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransactionResponseMultiError is an error wrapping multiple validation
-// errors returned by TransactionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TransactionResponseMultiError []error
+// OutputResponseMultiError is an error wrapping multiple validation errors
+// returned by OutputResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OutputResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransactionResponseMultiError) Error() string {
+func (m OutputResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1286,11 +1368,11 @@ func (m TransactionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransactionResponseMultiError) AllErrors() []error { return m }
+func (m OutputResponseMultiError) AllErrors() []error { return m }
 
-// TransactionResponseValidationError is the validation error returned by
-// TransactionResponse.Validate if the designated constraints aren't met.
-type TransactionResponseValidationError struct {
+// OutputResponseValidationError is the validation error returned by
+// OutputResponse.Validate if the designated constraints aren't met.
+type OutputResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1298,24 +1380,22 @@ type TransactionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransactionResponseValidationError) Field() string { return e.field }
+func (e OutputResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransactionResponseValidationError) Reason() string { return e.reason }
+func (e OutputResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransactionResponseValidationError) Cause() error { return e.cause }
+func (e OutputResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransactionResponseValidationError) Key() bool { return e.key }
+func (e OutputResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransactionResponseValidationError) ErrorName() string {
-	return "TransactionResponseValidationError"
-}
+func (e OutputResponseValidationError) ErrorName() string { return "OutputResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransactionResponseValidationError) Error() string {
+func (e OutputResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1327,14 +1407,14 @@ func (e TransactionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransactionResponse.%s: %s%s",
+		"invalid %sOutputResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransactionResponseValidationError{}
+var _ error = OutputResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1342,80 +1422,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransactionResponseValidationError{}
+} = OutputResponseValidationError{}
 
-// Validate checks the field values on TransactionsResponse with the rules
+// Validate checks the field values on OutputsHistoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransactionsResponse) Validate() error {
+func (m *OutputsHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransactionsResponse with the rules
+// ValidateAll checks the field values on OutputsHistoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransactionsResponseMultiError, or nil if none found.
-func (m *TransactionsResponse) ValidateAll() error {
+// OutputsHistoryRequestMultiError, or nil if none found.
+func (m *OutputsHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransactionsResponse) validate(all bool) error {
+func (m *OutputsHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTx() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TransactionsResponseValidationError{
-						field:  fmt.Sprintf("Tx[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TransactionsResponseValidationError{
-						field:  fmt.Sprintf("Tx[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TransactionsResponseValidationError{
-					field:  fmt.Sprintf("Tx[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetAddress()) != 42 {
+		err := OutputsHistoryRequestValidationError{
+			field:  "Address",
+			reason: "value length must be 42 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 
 	}
 
-	// no validation rules for Error
+	// no validation rules for Cursor
+
+	if m.GetLimit() > 1000 {
+		err := OutputsHistoryRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return TransactionsResponseMultiError(errors)
+		return OutputsHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransactionsResponseMultiError is an error wrapping multiple validation
-// errors returned by TransactionsResponse.ValidateAll() if the designated
+// OutputsHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by OutputsHistoryRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TransactionsResponseMultiError []error
+type OutputsHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransactionsResponseMultiError) Error() string {
+func (m OutputsHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1424,11 +1493,11 @@ func (m TransactionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransactionsResponseMultiError) AllErrors() []error { return m }
+func (m OutputsHistoryRequestMultiError) AllErrors() []error { return m }
 
-// TransactionsResponseValidationError is the validation error returned by
-// TransactionsResponse.Validate if the designated constraints aren't met.
-type TransactionsResponseValidationError struct {
+// OutputsHistoryRequestValidationError is the validation error returned by
+// OutputsHistoryRequest.Validate if the designated constraints aren't met.
+type OutputsHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1436,24 +1505,24 @@ type TransactionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransactionsResponseValidationError) Field() string { return e.field }
+func (e OutputsHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransactionsResponseValidationError) Reason() string { return e.reason }
+func (e OutputsHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransactionsResponseValidationError) Cause() error { return e.cause }
+func (e OutputsHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransactionsResponseValidationError) Key() bool { return e.key }
+func (e OutputsHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransactionsResponseValidationError) ErrorName() string {
-	return "TransactionsResponseValidationError"
+func (e OutputsHistoryRequestValidationError) ErrorName() string {
+	return "OutputsHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransactionsResponseValidationError) Error() string {
+func (e OutputsHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1465,14 +1534,14 @@ func (e TransactionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransactionsResponse.%s: %s%s",
+		"invalid %sOutputsHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransactionsResponseValidationError{}
+var _ error = OutputsHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1480,48 +1549,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransactionsResponseValidationError{}
+} = OutputsHistoryRequestValidationError{}
 
-// Validate checks the field values on NumberResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *NumberResponse) Validate() error {
+// Validate checks the field values on OutputsHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OutputsHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NumberResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NumberResponseMultiError,
-// or nil if none found.
-func (m *NumberResponse) ValidateAll() error {
+// ValidateAll checks the field values on OutputsHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OutputsHistoryResponseMultiError, or nil if none found.
+func (m *OutputsHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NumberResponse) validate(all bool) error {
+func (m *OutputsHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Result
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OutputsHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OutputsHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OutputsHistoryResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
 
 	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return NumberResponseMultiError(errors)
+		return OutputsHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumberResponseMultiError is an error wrapping multiple validation errors
-// returned by NumberResponse.ValidateAll() if the designated constraints
-// aren't met.
-type NumberResponseMultiError []error
+// OutputsHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by OutputsHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OutputsHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumberResponseMultiError) Error() string {
+func (m OutputsHistoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1530,11 +1633,11 @@ func (m NumberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumberResponseMultiError) AllErrors() []error { return m }
+func (m OutputsHistoryResponseMultiError) AllErrors() []error { return m }
 
-// NumberResponseValidationError is the validation error returned by
-// NumberResponse.Validate if the designated constraints aren't met.
-type NumberResponseValidationError struct {
+// OutputsHistoryResponseValidationError is the validation error returned by
+// OutputsHistoryResponse.Validate if the designated constraints aren't met.
+type OutputsHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1542,22 +1645,24 @@ type NumberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumberResponseValidationError) Field() string { return e.field }
+func (e OutputsHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumberResponseValidationError) Reason() string { return e.reason }
+func (e OutputsHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumberResponseValidationError) Cause() error { return e.cause }
+func (e OutputsHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumberResponseValidationError) Key() bool { return e.key }
+func (e OutputsHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumberResponseValidationError) ErrorName() string { return "NumberResponseValidationError" }
+func (e OutputsHistoryResponseValidationError) ErrorName() string {
+	return "OutputsHistoryResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NumberResponseValidationError) Error() string {
+func (e OutputsHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1569,14 +1674,14 @@ func (e NumberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumberResponse.%s: %s%s",
+		"invalid %sOutputsHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumberResponseValidationError{}
+var _ error = OutputsHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1584,92 +1689,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumberResponseValidationError{}
+} = OutputsHistoryResponseValidationError{}
 
-// Validate checks the field values on TxOptionsUnsafeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TxOptionsUnsafeRequest) Validate() error {
+// Validate checks the field values on StatusResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxOptionsUnsafeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TxOptionsUnsafeRequestMultiError, or nil if none found.
-func (m *TxOptionsUnsafeRequest) ValidateAll() error {
+// ValidateAll checks the field values on StatusResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusResponseMultiError,
+// or nil if none found.
+func (m *StatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxOptionsUnsafeRequest) validate(all bool) error {
+func (m *StatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Fee
-
-	for idx, item := range m.GetOutputs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TxOptionsUnsafeRequestValidationError{
-						field:  fmt.Sprintf("Outputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TxOptionsUnsafeRequestValidationError{
-						field:  fmt.Sprintf("Outputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TxOptionsUnsafeRequestValidationError{
-					field:  fmt.Sprintf("Outputs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if utf8.RuneCountInString(m.GetKey()) != 66 {
-		err := TxOptionsUnsafeRequestValidationError{
-			field:  "Key",
-			reason: "value length must be 66 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	// no validation rules for Error
 
-	}
+	// no validation rules for Data
 
 	if len(errors) > 0 {
-		return TxOptionsUnsafeRequestMultiError(errors)
+		return StatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxOptionsUnsafeRequestMultiError is an error wrapping multiple validation
-// errors returned by TxOptionsUnsafeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TxOptionsUnsafeRequestMultiError []error
+// StatusResponseMultiError is an error wrapping multiple validation errors
+// returned by StatusResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxOptionsUnsafeRequestMultiError) Error() string {
+func (m StatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1678,11 +1739,11 @@ func (m TxOptionsUnsafeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxOptionsUnsafeRequestMultiError) AllErrors() []error { return m }
+func (m StatusResponseMultiError) AllErrors() []error { return m }
 
-// TxOptionsUnsafeRequestValidationError is the validation error returned by
-// TxOptionsUnsafeRequest.Validate if the designated constraints aren't met.
-type TxOptionsUnsafeRequestValidationError struct {
+// StatusResponseValidationError is the validation error returned by
+// StatusResponse.Validate if the designated constraints aren't met.
+type StatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1690,24 +1751,2073 @@ type TxOptionsUnsafeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxOptionsUnsafeRequestValidationError) Field() string { return e.field }
+func (e StatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxOptionsUnsafeRequestValidationError) Reason() string { return e.reason }
+func (e StatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxOptionsUnsafeRequestValidationError) Cause() error { return e.cause }
+func (e StatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxOptionsUnsafeRequestValidationError) Key() bool { return e.key }
+func (e StatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxOptionsUnsafeRequestValidationError) ErrorName() string {
-	return "TxOptionsUnsafeRequestValidationError"
+func (e StatusResponseValidationError) ErrorName() string { return "StatusResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusResponseValidationError{}
+
+// Validate checks the field values on SendTxRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendTxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendTxRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendTxRequestMultiError, or
+// nil if none found.
+func (m *SendTxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendTxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTx()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendTxRequestValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendTxRequestValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendTxRequestValidationError{
+				field:  "Tx",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendTxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendTxRequestMultiError is an error wrapping multiple validation errors
+// returned by SendTxRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SendTxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendTxRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendTxRequestMultiError) AllErrors() []error { return m }
+
+// SendTxRequestValidationError is the validation error returned by
+// SendTxRequest.Validate if the designated constraints aren't met.
+type SendTxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendTxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendTxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendTxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendTxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendTxRequestValidationError) ErrorName() string { return "SendTxRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendTxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendTxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendTxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendTxRequestValidationError{}
+
+// Validate checks the field values on ErrorResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorResponseMultiError, or
+// nil if none found.
+func (m *ErrorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Error
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ErrorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorResponseMultiError is an error wrapping multiple validation errors
+// returned by ErrorResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ErrorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorResponseMultiError) AllErrors() []error { return m }
+
+// ErrorResponseValidationError is the validation error returned by
+// ErrorResponse.Validate if the designated constraints aren't met.
+type ErrorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorResponseValidationError) ErrorName() string { return "ErrorResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorResponseValidationError{}
+
+// Validate checks the field values on BlockResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockResponseMultiError, or
+// nil if none found.
+func (m *BlockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlockResponseValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlockResponseValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlockResponseValidationError{
+				field:  "Block",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return BlockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockResponseMultiError is an error wrapping multiple validation errors
+// returned by BlockResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BlockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockResponseMultiError) AllErrors() []error { return m }
+
+// BlockResponseValidationError is the validation error returned by
+// BlockResponse.Validate if the designated constraints aren't met.
+type BlockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockResponseValidationError) ErrorName() string { return "BlockResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockResponseValidationError{}
+
+// Validate checks the field values on TransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransactionResponseMultiError, or nil if none found.
+func (m *TransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTx()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionResponseValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionResponseValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionResponseValidationError{
+				field:  "Tx",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return TransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by TransactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionResponseMultiError) AllErrors() []error { return m }
+
+// TransactionResponseValidationError is the validation error returned by
+// TransactionResponse.Validate if the designated constraints aren't met.
+type TransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionResponseValidationError) ErrorName() string {
+	return "TransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionResponseValidationError{}
+
+// Validate checks the field values on TransactionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransactionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransactionsResponseMultiError, or nil if none found.
+func (m *TransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTx() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransactionsResponseValidationError{
+						field:  fmt.Sprintf("Tx[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransactionsResponseValidationError{
+						field:  fmt.Sprintf("Tx[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransactionsResponseValidationError{
+					field:  fmt.Sprintf("Tx[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return TransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionsResponseMultiError is an error wrapping multiple validation
+// errors returned by TransactionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionsResponseMultiError) AllErrors() []error { return m }
+
+// TransactionsResponseValidationError is the validation error returned by
+// TransactionsResponse.Validate if the designated constraints aren't met.
+type TransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionsResponseValidationError) ErrorName() string {
+	return "TransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionsResponseValidationError{}
+
+// Validate checks the field values on PendingTransactionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PendingTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingTransactionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingTransactionResponseMultiError, or nil if none found.
+func (m *PendingTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTx()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PendingTransactionResponseValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PendingTransactionResponseValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PendingTransactionResponseValidationError{
+				field:  "Tx",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return PendingTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingTransactionResponseMultiError is an error wrapping multiple
+// validation errors returned by PendingTransactionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PendingTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingTransactionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingTransactionResponseMultiError) AllErrors() []error { return m }
+
+// PendingTransactionResponseValidationError is the validation error returned
+// by PendingTransactionResponse.Validate if the designated constraints aren't met.
+type PendingTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingTransactionResponseValidationError) ErrorName() string {
+	return "PendingTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PendingTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingTransactionResponseValidationError{}
+
+// Validate checks the field values on PendingSubscribeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PendingSubscribeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingSubscribeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingSubscribeRequestMultiError, or nil if none found.
+func (m *PendingSubscribeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingSubscribeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() != "" {
+
+		if utf8.RuneCountInString(m.GetAddress()) != 42 {
+			err := PendingSubscribeRequestValidationError{
+				field:  "Address",
+				reason: "value length must be 42 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PendingSubscribeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingSubscribeRequestMultiError is an error wrapping multiple validation
+// errors returned by PendingSubscribeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PendingSubscribeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingSubscribeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingSubscribeRequestMultiError) AllErrors() []error { return m }
+
+// PendingSubscribeRequestValidationError is the validation error returned by
+// PendingSubscribeRequest.Validate if the designated constraints aren't met.
+type PendingSubscribeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingSubscribeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingSubscribeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingSubscribeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingSubscribeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingSubscribeRequestValidationError) ErrorName() string {
+	return "PendingSubscribeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PendingSubscribeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingSubscribeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingSubscribeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingSubscribeRequestValidationError{}
+
+// Validate checks the field values on ReceiptResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReceiptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReceiptResponseMultiError, or nil if none found.
+func (m *ReceiptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReceipt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReceiptResponseValidationError{
+					field:  "Receipt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReceiptResponseValidationError{
+					field:  "Receipt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReceipt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiptResponseValidationError{
+				field:  "Receipt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return ReceiptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiptResponseMultiError is an error wrapping multiple validation errors
+// returned by ReceiptResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReceiptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiptResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiptResponseMultiError) AllErrors() []error { return m }
+
+// ReceiptResponseValidationError is the validation error returned by
+// ReceiptResponse.Validate if the designated constraints aren't met.
+type ReceiptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiptResponseValidationError) ErrorName() string { return "ReceiptResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiptResponseValidationError{}
+
+// Validate checks the field values on NumberResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NumberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NumberResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NumberResponseMultiError,
+// or nil if none found.
+func (m *NumberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NumberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return NumberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// NumberResponseMultiError is an error wrapping multiple validation errors
+// returned by NumberResponse.ValidateAll() if the designated constraints
+// aren't met.
+type NumberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NumberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NumberResponseMultiError) AllErrors() []error { return m }
+
+// NumberResponseValidationError is the validation error returned by
+// NumberResponse.Validate if the designated constraints aren't met.
+type NumberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NumberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NumberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NumberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NumberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NumberResponseValidationError) ErrorName() string { return "NumberResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NumberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNumberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NumberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NumberResponseValidationError{}
+
+// Validate checks the field values on FeeResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeeResponseMultiError, or
+// nil if none found.
+func (m *FeeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	// no validation rules for Error
+
+	// no validation rules for BaseFee
+
+	// no validation rules for Tip
+
+	if len(errors) > 0 {
+		return FeeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeResponseMultiError is an error wrapping multiple validation errors
+// returned by FeeResponse.ValidateAll() if the designated constraints aren't met.
+type FeeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeResponseMultiError) AllErrors() []error { return m }
+
+// FeeResponseValidationError is the validation error returned by
+// FeeResponse.Validate if the designated constraints aren't met.
+type FeeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeResponseValidationError) ErrorName() string { return "FeeResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeResponseValidationError{}
+
+// Validate checks the field values on TxOptionsUnsafeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TxOptionsUnsafeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxOptionsUnsafeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxOptionsUnsafeRequestMultiError, or nil if none found.
+func (m *TxOptionsUnsafeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxOptionsUnsafeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fee
+
+	for idx, item := range m.GetOutputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TxOptionsUnsafeRequestValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TxOptionsUnsafeRequestValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TxOptionsUnsafeRequestValidationError{
+					field:  fmt.Sprintf("Outputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) != 66 {
+		err := TxOptionsUnsafeRequestValidationError{
+			field:  "Key",
+			reason: "value length must be 66 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return TxOptionsUnsafeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxOptionsUnsafeRequestMultiError is an error wrapping multiple validation
+// errors returned by TxOptionsUnsafeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TxOptionsUnsafeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxOptionsUnsafeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxOptionsUnsafeRequestMultiError) AllErrors() []error { return m }
+
+// TxOptionsUnsafeRequestValidationError is the validation error returned by
+// TxOptionsUnsafeRequest.Validate if the designated constraints aren't met.
+type TxOptionsUnsafeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxOptionsUnsafeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxOptionsUnsafeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxOptionsUnsafeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxOptionsUnsafeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxOptionsUnsafeRequestValidationError) ErrorName() string {
+	return "TxOptionsUnsafeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TxOptionsUnsafeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxOptionsUnsafeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxOptionsUnsafeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxOptionsUnsafeRequestValidationError{}
+
+// Validate checks the field values on TxOptionsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxOptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxOptionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxOptionsRequestMultiError, or nil if none found.
+func (m *TxOptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxOptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fee
+
+	if utf8.RuneCountInString(m.GetAddress()) != 42 {
+		err := TxOptionsRequestValidationError{
+			field:  "Address",
+			reason: "value length must be 42 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	for idx, item := range m.GetOutputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TxOptionsRequestValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TxOptionsRequestValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TxOptionsRequestValidationError{
+					field:  fmt.Sprintf("Outputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TxOptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxOptionsRequestMultiError is an error wrapping multiple validation errors
+// returned by TxOptionsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TxOptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxOptionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxOptionsRequestMultiError) AllErrors() []error { return m }
+
+// TxOptionsRequestValidationError is the validation error returned by
+// TxOptionsRequest.Validate if the designated constraints aren't met.
+type TxOptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxOptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxOptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxOptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxOptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxOptionsRequestValidationError) ErrorName() string { return "TxOptionsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxOptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxOptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxOptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxOptionsRequestValidationError{}
+
+// Validate checks the field values on TxOptionsStakeUnsafeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TxOptionsStakeUnsafeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxOptionsStakeUnsafeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxOptionsStakeUnsafeRequestMultiError, or nil if none found.
+func (m *TxOptionsStakeUnsafeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxOptionsStakeUnsafeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fee
+
+	if utf8.RuneCountInString(m.GetKey()) != 66 {
+		err := TxOptionsStakeUnsafeRequestValidationError{
+			field:  "Key",
+			reason: "value length must be 66 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if m.GetAmount() <= 0 {
+		err := TxOptionsStakeUnsafeRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetNode() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return TxOptionsStakeUnsafeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxOptionsStakeUnsafeRequestMultiError is an error wrapping multiple
+// validation errors returned by TxOptionsStakeUnsafeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type TxOptionsStakeUnsafeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxOptionsStakeUnsafeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxOptionsStakeUnsafeRequestMultiError) AllErrors() []error { return m }
+
+// TxOptionsStakeUnsafeRequestValidationError is the validation error returned
+// by TxOptionsStakeUnsafeRequest.Validate if the designated constraints
+// aren't met.
+type TxOptionsStakeUnsafeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxOptionsStakeUnsafeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxOptionsStakeUnsafeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxOptionsStakeUnsafeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxOptionsStakeUnsafeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxOptionsStakeUnsafeRequestValidationError) ErrorName() string {
+	return "TxOptionsStakeUnsafeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TxOptionsStakeUnsafeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxOptionsStakeUnsafeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxOptionsStakeUnsafeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxOptionsStakeUnsafeRequestValidationError{}
+
+// Validate checks the field values on TxOptionsStakeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TxOptionsStakeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxOptionsStakeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxOptionsStakeRequestMultiError, or nil if none found.
+func (m *TxOptionsStakeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxOptionsStakeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fee
+
+	if utf8.RuneCountInString(m.GetAddress()) != 42 {
+		err := TxOptionsStakeRequestValidationError{
+			field:  "Address",
+			reason: "value length must be 42 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if m.GetAmount() <= 0 {
+		err := TxOptionsStakeRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetNode() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return TxOptionsStakeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxOptionsStakeRequestMultiError is an error wrapping multiple validation
+// errors returned by TxOptionsStakeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TxOptionsStakeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxOptionsStakeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxOptionsStakeRequestMultiError) AllErrors() []error { return m }
+
+// TxOptionsStakeRequestValidationError is the validation error returned by
+// TxOptionsStakeRequest.Validate if the designated constraints aren't met.
+type TxOptionsStakeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxOptionsStakeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxOptionsStakeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxOptionsStakeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxOptionsStakeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxOptionsStakeRequestValidationError) ErrorName() string {
+	return "TxOptionsStakeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TxOptionsStakeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxOptionsStakeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxOptionsStakeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxOptionsStakeRequestValidationError{}
+
+// Validate checks the field values on TxBodyUnsafeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TxBodyUnsafeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxBodyUnsafeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxBodyUnsafeResponseMultiError, or nil if none found.
+func (m *TxBodyUnsafeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxBodyUnsafeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTx()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TxBodyUnsafeResponseValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TxBodyUnsafeResponseValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TxBodyUnsafeResponseValidationError{
+				field:  "Tx",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TxBodyUnsafeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxBodyUnsafeResponseMultiError is an error wrapping multiple validation
+// errors returned by TxBodyUnsafeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TxBodyUnsafeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxBodyUnsafeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxBodyUnsafeResponseMultiError) AllErrors() []error { return m }
+
+// TxBodyUnsafeResponseValidationError is the validation error returned by
+// TxBodyUnsafeResponse.Validate if the designated constraints aren't met.
+type TxBodyUnsafeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxBodyUnsafeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxBodyUnsafeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxBodyUnsafeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxBodyUnsafeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxBodyUnsafeResponseValidationError) ErrorName() string {
+	return "TxBodyUnsafeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TxBodyUnsafeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxBodyUnsafeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxBodyUnsafeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxBodyUnsafeResponseValidationError{}
+
+// Validate checks the field values on TxBodyResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TxBodyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxBodyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TxBodyResponseMultiError,
+// or nil if none found.
+func (m *TxBodyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxBodyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTx()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TxBodyResponseValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TxBodyResponseValidationError{
+					field:  "Tx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TxBodyResponseValidationError{
+				field:  "Tx",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TxBodyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxBodyResponseMultiError is an error wrapping multiple validation errors
+// returned by TxBodyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TxBodyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxBodyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxBodyResponseMultiError) AllErrors() []error { return m }
+
+// TxBodyResponseValidationError is the validation error returned by
+// TxBodyResponse.Validate if the designated constraints aren't met.
+type TxBodyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
 }
 
+// Field function returns field value.
+func (e TxBodyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxBodyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxBodyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxBodyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxBodyResponseValidationError) ErrorName() string { return "TxBodyResponseValidationError" }
+
 // Error satisfies the builtin error interface
-func (e TxOptionsUnsafeRequestValidationError) Error() string {
+func (e TxBodyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1719,14 +3829,14 @@ func (e TxOptionsUnsafeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxOptionsUnsafeRequest.%s: %s%s",
+		"invalid %sTxBodyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxOptionsUnsafeRequestValidationError{}
+var _ error = TxBodyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1734,92 +3844,266 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxOptionsUnsafeRequestValidationError{}
+} = TxBodyResponseValidationError{}
 
-// Validate checks the field values on TxOptionsRequest with the rules defined
+// Validate checks the field values on RawTxRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RawTxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RawTxRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RawTxRequestMultiError, or
+// nil if none found.
+func (m *RawTxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RawTxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return RawTxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RawTxRequestMultiError is an error wrapping multiple validation errors
+// returned by RawTxRequest.ValidateAll() if the designated constraints aren't met.
+type RawTxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RawTxRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RawTxRequestMultiError) AllErrors() []error { return m }
+
+// RawTxRequestValidationError is the validation error returned by
+// RawTxRequest.Validate if the designated constraints aren't met.
+type RawTxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RawTxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RawTxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RawTxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RawTxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RawTxRequestValidationError) ErrorName() string { return "RawTxRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RawTxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRawTxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RawTxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RawTxRequestValidationError{}
+
+// Validate checks the field values on RawTxBatchRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *TxOptionsRequest) Validate() error {
+func (m *RawTxBatchRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxOptionsRequest with the rules
+// ValidateAll checks the field values on RawTxBatchRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TxOptionsRequestMultiError, or nil if none found.
-func (m *TxOptionsRequest) ValidateAll() error {
+// RawTxBatchRequestMultiError, or nil if none found.
+func (m *RawTxBatchRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxOptionsRequest) validate(all bool) error {
+func (m *RawTxBatchRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Fee
-
-	if utf8.RuneCountInString(m.GetAddress()) != 42 {
-		err := TxOptionsRequestValidationError{
-			field:  "Address",
-			reason: "value length must be 42 runes",
+	if l := len(m.GetData()); l < 1 || l > 500 {
+		err := RawTxBatchRequestValidationError{
+			field:  "Data",
+			reason: "value must contain between 1 and 500 items, inclusive",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
+	}
 
+	if len(errors) > 0 {
+		return RawTxBatchRequestMultiError(errors)
 	}
 
-	for idx, item := range m.GetOutputs() {
-		_, _ = idx, item
+	return nil
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TxOptionsRequestValidationError{
-						field:  fmt.Sprintf("Outputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TxOptionsRequestValidationError{
-						field:  fmt.Sprintf("Outputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TxOptionsRequestValidationError{
-					field:  fmt.Sprintf("Outputs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// RawTxBatchRequestMultiError is an error wrapping multiple validation errors
+// returned by RawTxBatchRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RawTxBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RawTxBatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RawTxBatchRequestMultiError) AllErrors() []error { return m }
+
+// RawTxBatchRequestValidationError is the validation error returned by
+// RawTxBatchRequest.Validate if the designated constraints aren't met.
+type RawTxBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RawTxBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RawTxBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RawTxBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RawTxBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RawTxBatchRequestValidationError) ErrorName() string {
+	return "RawTxBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RawTxBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRawTxBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RawTxBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RawTxBatchRequestValidationError{}
+
+// Validate checks the field values on TxBatchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TxBatchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxBatchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TxBatchResultMultiError, or
+// nil if none found.
+func (m *TxBatchResult) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *TxBatchResult) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Hash
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return TxOptionsRequestMultiError(errors)
+		return TxBatchResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxOptionsRequestMultiError is an error wrapping multiple validation errors
-// returned by TxOptionsRequest.ValidateAll() if the designated constraints
+// TxBatchResultMultiError is an error wrapping multiple validation errors
+// returned by TxBatchResult.ValidateAll() if the designated constraints
 // aren't met.
-type TxOptionsRequestMultiError []error
+type TxBatchResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxOptionsRequestMultiError) Error() string {
+func (m TxBatchResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1828,11 +4112,11 @@ func (m TxOptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxOptionsRequestMultiError) AllErrors() []error { return m }
+func (m TxBatchResultMultiError) AllErrors() []error { return m }
 
-// TxOptionsRequestValidationError is the validation error returned by
-// TxOptionsRequest.Validate if the designated constraints aren't met.
-type TxOptionsRequestValidationError struct {
+// TxBatchResultValidationError is the validation error returned by
+// TxBatchResult.Validate if the designated constraints aren't met.
+type TxBatchResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1840,22 +4124,22 @@ type TxOptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxOptionsRequestValidationError) Field() string { return e.field }
+func (e TxBatchResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxOptionsRequestValidationError) Reason() string { return e.reason }
+func (e TxBatchResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxOptionsRequestValidationError) Cause() error { return e.cause }
+func (e TxBatchResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxOptionsRequestValidationError) Key() bool { return e.key }
+func (e TxBatchResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxOptionsRequestValidationError) ErrorName() string { return "TxOptionsRequestValidationError" }
+func (e TxBatchResultValidationError) ErrorName() string { return "TxBatchResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TxOptionsRequestValidationError) Error() string {
+func (e TxBatchResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1867,14 +4151,14 @@ func (e TxOptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxOptionsRequest.%s: %s%s",
+		"invalid %sTxBatchResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxOptionsRequestValidationError{}
+var _ error = TxBatchResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1882,73 +4166,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxOptionsRequestValidationError{}
+} = TxBatchResultValidationError{}
 
-// Validate checks the field values on TxOptionsStakeUnsafeRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TxOptionsStakeUnsafeRequest) Validate() error {
+// Validate checks the field values on TxBatchResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxBatchResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxOptionsStakeUnsafeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TxBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TxOptionsStakeUnsafeRequestMultiError, or nil if none found.
-func (m *TxOptionsStakeUnsafeRequest) ValidateAll() error {
+// TxBatchResponseMultiError, or nil if none found.
+func (m *TxBatchResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxOptionsStakeUnsafeRequest) validate(all bool) error {
+func (m *TxBatchResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Fee
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetKey()) != 66 {
-		err := TxOptionsStakeUnsafeRequestValidationError{
-			field:  "Key",
-			reason: "value length must be 66 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TxBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TxBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TxBatchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-
-	}
 
-	if m.GetAmount() <= 0 {
-		err := TxOptionsStakeUnsafeRequestValidationError{
-			field:  "Amount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if m.GetNode() != "" {
-
-	}
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return TxOptionsStakeUnsafeRequestMultiError(errors)
+		return TxBatchResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxOptionsStakeUnsafeRequestMultiError is an error wrapping multiple
-// validation errors returned by TxOptionsStakeUnsafeRequest.ValidateAll() if
-// the designated constraints aren't met.
-type TxOptionsStakeUnsafeRequestMultiError []error
+// TxBatchResponseMultiError is an error wrapping multiple validation errors
+// returned by TxBatchResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TxBatchResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxOptionsStakeUnsafeRequestMultiError) Error() string {
+func (m TxBatchResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1957,12 +4248,11 @@ func (m TxOptionsStakeUnsafeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxOptionsStakeUnsafeRequestMultiError) AllErrors() []error { return m }
+func (m TxBatchResponseMultiError) AllErrors() []error { return m }
 
-// TxOptionsStakeUnsafeRequestValidationError is the validation error returned
-// by TxOptionsStakeUnsafeRequest.Validate if the designated constraints
-// aren't met.
-type TxOptionsStakeUnsafeRequestValidationError struct {
+// TxBatchResponseValidationError is the validation error returned by
+// TxBatchResponse.Validate if the designated constraints aren't met.
+type TxBatchResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1970,24 +4260,22 @@ type TxOptionsStakeUnsafeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxOptionsStakeUnsafeRequestValidationError) Field() string { return e.field }
+func (e TxBatchResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxOptionsStakeUnsafeRequestValidationError) Reason() string { return e.reason }
+func (e TxBatchResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxOptionsStakeUnsafeRequestValidationError) Cause() error { return e.cause }
+func (e TxBatchResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxOptionsStakeUnsafeRequestValidationError) Key() bool { return e.key }
+func (e TxBatchResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxOptionsStakeUnsafeRequestValidationError) ErrorName() string {
-	return "TxOptionsStakeUnsafeRequestValidationError"
-}
+func (e TxBatchResponseValidationError) ErrorName() string { return "TxBatchResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TxOptionsStakeUnsafeRequestValidationError) Error() string {
+func (e TxBatchResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1999,14 +4287,14 @@ func (e TxOptionsStakeUnsafeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxOptionsStakeUnsafeRequest.%s: %s%s",
+		"invalid %sTxBatchResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxOptionsStakeUnsafeRequestValidationError{}
+var _ error = TxBatchResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2014,73 +4302,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxOptionsStakeUnsafeRequestValidationError{}
+} = TxBatchResponseValidationError{}
 
-// Validate checks the field values on TxOptionsStakeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ValidatorAddressesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TxOptionsStakeRequest) Validate() error {
+func (m *ValidatorAddressesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxOptionsStakeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ValidatorAddressesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TxOptionsStakeRequestMultiError, or nil if none found.
-func (m *TxOptionsStakeRequest) ValidateAll() error {
+// ValidatorAddressesResponseMultiError, or nil if none found.
+func (m *ValidatorAddressesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxOptionsStakeRequest) validate(all bool) error {
+func (m *ValidatorAddressesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Fee
-
-	if utf8.RuneCountInString(m.GetAddress()) != 42 {
-		err := TxOptionsStakeRequestValidationError{
-			field:  "Address",
-			reason: "value length must be 42 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-
-	}
-
-	if m.GetAmount() <= 0 {
-		err := TxOptionsStakeRequestValidationError{
-			field:  "Amount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetNode() != "" {
-
-	}
-
 	if len(errors) > 0 {
-		return TxOptionsStakeRequestMultiError(errors)
+		return ValidatorAddressesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxOptionsStakeRequestMultiError is an error wrapping multiple validation
-// errors returned by TxOptionsStakeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TxOptionsStakeRequestMultiError []error
+// ValidatorAddressesResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidatorAddressesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ValidatorAddressesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxOptionsStakeRequestMultiError) Error() string {
+func (m ValidatorAddressesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2089,11 +4348,11 @@ func (m TxOptionsStakeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxOptionsStakeRequestMultiError) AllErrors() []error { return m }
+func (m ValidatorAddressesResponseMultiError) AllErrors() []error { return m }
 
-// TxOptionsStakeRequestValidationError is the validation error returned by
-// TxOptionsStakeRequest.Validate if the designated constraints aren't met.
-type TxOptionsStakeRequestValidationError struct {
+// ValidatorAddressesResponseValidationError is the validation error returned
+// by ValidatorAddressesResponse.Validate if the designated constraints aren't met.
+type ValidatorAddressesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2101,24 +4360,24 @@ type TxOptionsStakeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxOptionsStakeRequestValidationError) Field() string { return e.field }
+func (e ValidatorAddressesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxOptionsStakeRequestValidationError) Reason() string { return e.reason }
+func (e ValidatorAddressesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxOptionsStakeRequestValidationError) Cause() error { return e.cause }
+func (e ValidatorAddressesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxOptionsStakeRequestValidationError) Key() bool { return e.key }
+func (e ValidatorAddressesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxOptionsStakeRequestValidationError) ErrorName() string {
-	return "TxOptionsStakeRequestValidationError"
+func (e ValidatorAddressesResponseValidationError) ErrorName() string {
+	return "ValidatorAddressesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TxOptionsStakeRequestValidationError) Error() string {
+func (e ValidatorAddressesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2130,14 +4389,14 @@ func (e TxOptionsStakeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxOptionsStakeRequest.%s: %s%s",
+		"invalid %sValidatorAddressesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxOptionsStakeRequestValidationError{}
+var _ error = ValidatorAddressesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2145,73 +4404,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxOptionsStakeRequestValidationError{}
+} = ValidatorAddressesResponseValidationError{}
 
-// Validate checks the field values on TxBodyUnsafeResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TxBodyUnsafeResponse) Validate() error {
+// Validate checks the field values on MarketCapResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarketCapResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxBodyUnsafeResponse with the rules
+// ValidateAll checks the field values on MarketCapResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TxBodyUnsafeResponseMultiError, or nil if none found.
-func (m *TxBodyUnsafeResponse) ValidateAll() error {
+// MarketCapResponseMultiError, or nil if none found.
+func (m *MarketCapResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxBodyUnsafeResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetTx()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TxBodyUnsafeResponseValidationError{
-					field:  "Tx",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TxBodyUnsafeResponseValidationError{
-					field:  "Tx",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TxBodyUnsafeResponseValidationError{
-				field:  "Tx",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+func (m *MarketCapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Cap
+
 	if len(errors) > 0 {
-		return TxBodyUnsafeResponseMultiError(errors)
+		return MarketCapResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxBodyUnsafeResponseMultiError is an error wrapping multiple validation
-// errors returned by TxBodyUnsafeResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TxBodyUnsafeResponseMultiError []error
+// MarketCapResponseMultiError is an error wrapping multiple validation errors
+// returned by MarketCapResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MarketCapResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxBodyUnsafeResponseMultiError) Error() string {
+func (m MarketCapResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2220,11 +4452,11 @@ func (m TxBodyUnsafeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxBodyUnsafeResponseMultiError) AllErrors() []error { return m }
+func (m MarketCapResponseMultiError) AllErrors() []error { return m }
 
-// TxBodyUnsafeResponseValidationError is the validation error returned by
-// TxBodyUnsafeResponse.Validate if the designated constraints aren't met.
-type TxBodyUnsafeResponseValidationError struct {
+// MarketCapResponseValidationError is the validation error returned by
+// MarketCapResponse.Validate if the designated constraints aren't met.
+type MarketCapResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2232,24 +4464,24 @@ type TxBodyUnsafeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxBodyUnsafeResponseValidationError) Field() string { return e.field }
+func (e MarketCapResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxBodyUnsafeResponseValidationError) Reason() string { return e.reason }
+func (e MarketCapResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxBodyUnsafeResponseValidationError) Cause() error { return e.cause }
+func (e MarketCapResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxBodyUnsafeResponseValidationError) Key() bool { return e.key }
+func (e MarketCapResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxBodyUnsafeResponseValidationError) ErrorName() string {
-	return "TxBodyUnsafeResponseValidationError"
+func (e MarketCapResponseValidationError) ErrorName() string {
+	return "MarketCapResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TxBodyUnsafeResponseValidationError) Error() string {
+func (e MarketCapResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2261,14 +4493,14 @@ func (e TxBodyUnsafeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxBodyUnsafeResponse.%s: %s%s",
+		"invalid %sMarketCapResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxBodyUnsafeResponseValidationError{}
+var _ error = MarketCapResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2276,73 +4508,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxBodyUnsafeResponseValidationError{}
+} = MarketCapResponseValidationError{}
 
-// Validate checks the field values on TxBodyResponse with the rules defined in
+// Validate checks the field values on SlotRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TxBodyResponse) Validate() error {
+func (m *SlotRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxBodyResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TxBodyResponseMultiError,
-// or nil if none found.
-func (m *TxBodyResponse) ValidateAll() error {
+// ValidateAll checks the field values on SlotRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SlotRequestMultiError, or
+// nil if none found.
+func (m *SlotRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxBodyResponse) validate(all bool) error {
+func (m *SlotRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTx()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TxBodyResponseValidationError{
-					field:  "Tx",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TxBodyResponseValidationError{
-					field:  "Tx",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTx()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TxBodyResponseValidationError{
-				field:  "Tx",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Slot
 
 	if len(errors) > 0 {
-		return TxBodyResponseMultiError(errors)
+		return SlotRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxBodyResponseMultiError is an error wrapping multiple validation errors
-// returned by TxBodyResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TxBodyResponseMultiError []error
+// SlotRequestMultiError is an error wrapping multiple validation errors
+// returned by SlotRequest.ValidateAll() if the designated constraints aren't met.
+type SlotRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxBodyResponseMultiError) Error() string {
+func (m SlotRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2351,11 +4555,11 @@ func (m TxBodyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxBodyResponseMultiError) AllErrors() []error { return m }
+func (m SlotRequestMultiError) AllErrors() []error { return m }
 
-// TxBodyResponseValidationError is the validation error returned by
-// TxBodyResponse.Validate if the designated constraints aren't met.
-type TxBodyResponseValidationError struct {
+// SlotRequestValidationError is the validation error returned by
+// SlotRequest.Validate if the designated constraints aren't met.
+type SlotRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2363,22 +4567,22 @@ type TxBodyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxBodyResponseValidationError) Field() string { return e.field }
+func (e SlotRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxBodyResponseValidationError) Reason() string { return e.reason }
+func (e SlotRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxBodyResponseValidationError) Cause() error { return e.cause }
+func (e SlotRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxBodyResponseValidationError) Key() bool { return e.key }
+func (e SlotRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxBodyResponseValidationError) ErrorName() string { return "TxBodyResponseValidationError" }
+func (e SlotRequestValidationError) ErrorName() string { return "SlotRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TxBodyResponseValidationError) Error() string {
+func (e SlotRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2390,14 +4594,14 @@ func (e TxBodyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxBodyResponse.%s: %s%s",
+		"invalid %sSlotRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxBodyResponseValidationError{}
+var _ error = SlotRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2405,45 +4609,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxBodyResponseValidationError{}
+} = SlotRequestValidationError{}
 
-// Validate checks the field values on RawTxRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RawTxRequest) Validate() error {
+// Validate checks the field values on CommitteeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CommitteeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RawTxRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RawTxRequestMultiError, or
-// nil if none found.
-func (m *RawTxRequest) ValidateAll() error {
+// ValidateAll checks the field values on CommitteeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitteeResponseMultiError, or nil if none found.
+func (m *CommitteeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RawTxRequest) validate(all bool) error {
+func (m *CommitteeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for Error
+
+	// no validation rules for Slot
+
+	// no validation rules for Epoch
 
 	if len(errors) > 0 {
-		return RawTxRequestMultiError(errors)
+		return CommitteeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RawTxRequestMultiError is an error wrapping multiple validation errors
-// returned by RawTxRequest.ValidateAll() if the designated constraints aren't met.
-type RawTxRequestMultiError []error
+// CommitteeResponseMultiError is an error wrapping multiple validation errors
+// returned by CommitteeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CommitteeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RawTxRequestMultiError) Error() string {
+func (m CommitteeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2452,11 +4661,11 @@ func (m RawTxRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RawTxRequestMultiError) AllErrors() []error { return m }
+func (m CommitteeResponseMultiError) AllErrors() []error { return m }
 
-// RawTxRequestValidationError is the validation error returned by
-// RawTxRequest.Validate if the designated constraints aren't met.
-type RawTxRequestValidationError struct {
+// CommitteeResponseValidationError is the validation error returned by
+// CommitteeResponse.Validate if the designated constraints aren't met.
+type CommitteeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2464,22 +4673,24 @@ type RawTxRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RawTxRequestValidationError) Field() string { return e.field }
+func (e CommitteeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RawTxRequestValidationError) Reason() string { return e.reason }
+func (e CommitteeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RawTxRequestValidationError) Cause() error { return e.cause }
+func (e CommitteeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RawTxRequestValidationError) Key() bool { return e.key }
+func (e CommitteeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RawTxRequestValidationError) ErrorName() string { return "RawTxRequestValidationError" }
+func (e CommitteeResponseValidationError) ErrorName() string {
+	return "CommitteeResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RawTxRequestValidationError) Error() string {
+func (e CommitteeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2491,14 +4702,14 @@ func (e RawTxRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRawTxRequest.%s: %s%s",
+		"invalid %sCommitteeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RawTxRequestValidationError{}
+var _ error = CommitteeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2506,44 +4717,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RawTxRequestValidationError{}
+} = CommitteeResponseValidationError{}
 
-// Validate checks the field values on ValidatorAddressesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidatorAddressesResponse) Validate() error {
+// Validate checks the field values on ChainInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChainInfoResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidatorAddressesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ChainInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ValidatorAddressesResponseMultiError, or nil if none found.
-func (m *ValidatorAddressesResponse) ValidateAll() error {
+// ChainInfoResponseMultiError, or nil if none found.
+func (m *ChainInfoResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidatorAddressesResponse) validate(all bool) error {
+func (m *ChainInfoResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Error
+
+	// no validation rules for ChainId
+
+	// no validation rules for GenesisHash
+
+	// no validation rules for LegacyTxDeadline
+
 	if len(errors) > 0 {
-		return ValidatorAddressesResponseMultiError(errors)
+		return ChainInfoResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidatorAddressesResponseMultiError is an error wrapping multiple
-// validation errors returned by ValidatorAddressesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ValidatorAddressesResponseMultiError []error
+// ChainInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by ChainInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ChainInfoResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidatorAddressesResponseMultiError) Error() string {
+func (m ChainInfoResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2552,11 +4771,11 @@ func (m ValidatorAddressesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidatorAddressesResponseMultiError) AllErrors() []error { return m }
+func (m ChainInfoResponseMultiError) AllErrors() []error { return m }
 
-// ValidatorAddressesResponseValidationError is the validation error returned
-// by ValidatorAddressesResponse.Validate if the designated constraints aren't met.
-type ValidatorAddressesResponseValidationError struct {
+// ChainInfoResponseValidationError is the validation error returned by
+// ChainInfoResponse.Validate if the designated constraints aren't met.
+type ChainInfoResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2564,24 +4783,24 @@ type ValidatorAddressesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidatorAddressesResponseValidationError) Field() string { return e.field }
+func (e ChainInfoResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidatorAddressesResponseValidationError) Reason() string { return e.reason }
+func (e ChainInfoResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidatorAddressesResponseValidationError) Cause() error { return e.cause }
+func (e ChainInfoResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidatorAddressesResponseValidationError) Key() bool { return e.key }
+func (e ChainInfoResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidatorAddressesResponseValidationError) ErrorName() string {
-	return "ValidatorAddressesResponseValidationError"
+func (e ChainInfoResponseValidationError) ErrorName() string {
+	return "ChainInfoResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidatorAddressesResponseValidationError) Error() string {
+func (e ChainInfoResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2593,14 +4812,14 @@ func (e ValidatorAddressesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidatorAddressesResponse.%s: %s%s",
+		"invalid %sChainInfoResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidatorAddressesResponseValidationError{}
+var _ error = ChainInfoResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2608,46 +4827,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidatorAddressesResponseValidationError{}
+} = ChainInfoResponseValidationError{}
 
-// Validate checks the field values on MarketCapResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MarketCapResponse) Validate() error {
+// Validate checks the field values on FinalityStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinalityStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MarketCapResponse with the rules
+// ValidateAll checks the field values on FinalityStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MarketCapResponseMultiError, or nil if none found.
-func (m *MarketCapResponse) ValidateAll() error {
+// FinalityStatusResponseMultiError, or nil if none found.
+func (m *FinalityStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MarketCapResponse) validate(all bool) error {
+func (m *FinalityStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cap
+	// no validation rules for Error
+
+	// no validation rules for HeadEpoch
+
+	if all {
+		switch v := interface{}(m.GetJustified()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinalityStatusResponseValidationError{
+					field:  "Justified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinalityStatusResponseValidationError{
+					field:  "Justified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJustified()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinalityStatusResponseValidationError{
+				field:  "Justified",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinalized()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinalityStatusResponseValidationError{
+					field:  "Finalized",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinalityStatusResponseValidationError{
+					field:  "Finalized",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinalized()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinalityStatusResponseValidationError{
+				field:  "Finalized",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return MarketCapResponseMultiError(errors)
+		return FinalityStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MarketCapResponseMultiError is an error wrapping multiple validation errors
-// returned by MarketCapResponse.ValidateAll() if the designated constraints
-// aren't met.
-type MarketCapResponseMultiError []error
+// FinalityStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by FinalityStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FinalityStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MarketCapResponseMultiError) Error() string {
+func (m FinalityStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2656,11 +4935,11 @@ func (m MarketCapResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MarketCapResponseMultiError) AllErrors() []error { return m }
+func (m FinalityStatusResponseMultiError) AllErrors() []error { return m }
 
-// MarketCapResponseValidationError is the validation error returned by
-// MarketCapResponse.Validate if the designated constraints aren't met.
-type MarketCapResponseValidationError struct {
+// FinalityStatusResponseValidationError is the validation error returned by
+// FinalityStatusResponse.Validate if the designated constraints aren't met.
+type FinalityStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2668,24 +4947,24 @@ type MarketCapResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MarketCapResponseValidationError) Field() string { return e.field }
+func (e FinalityStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MarketCapResponseValidationError) Reason() string { return e.reason }
+func (e FinalityStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MarketCapResponseValidationError) Cause() error { return e.cause }
+func (e FinalityStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MarketCapResponseValidationError) Key() bool { return e.key }
+func (e FinalityStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MarketCapResponseValidationError) ErrorName() string {
-	return "MarketCapResponseValidationError"
+func (e FinalityStatusResponseValidationError) ErrorName() string {
+	return "FinalityStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MarketCapResponseValidationError) Error() string {
+func (e FinalityStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2697,14 +4976,14 @@ func (e MarketCapResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMarketCapResponse.%s: %s%s",
+		"invalid %sFinalityStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MarketCapResponseValidationError{}
+var _ error = FinalityStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2712,4 +4991,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MarketCapResponseValidationError{}
+} = FinalityStatusResponseValidationError{}
@@ -103,6 +103,8 @@ func (m *BlockValue) validate(all bool) error {
 
 	}
 
+	// no validation rules for BaseFee
+
 	if len(errors) > 0 {
 		return BlockValueMultiError(errors)
 	}
@@ -331,6 +333,12 @@ func (m *TxValue) validate(all bool) error {
 
 	}
 
+	// no validation rules for Version
+
+	// no validation rules for ValidAfter
+
+	// no validation rules for ValidUntil
+
 	if len(errors) > 0 {
 		return TxValueMultiError(errors)
 	}
@@ -986,6 +994,14 @@ func (m *UTxO) validate(all bool) error {
 
 	// no validation rules for Txtype
 
+	// no validation rules for Id
+
+	// no validation rules for SpentBlock
+
+	// no validation rules for SpentTxHash
+
+	// no validation rules for SpentIndex
+
 	if len(errors) > 0 {
 		return UTxOMultiError(errors)
 	}
@@ -1062,3 +1078,402 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UTxOValidationError{}
+
+// Validate checks the field values on ReceiptValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReceiptValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiptValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReceiptValueMultiError, or
+// nil if none found.
+func (m *ReceiptValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiptValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TxHash
+
+	// no validation rules for BlockHash
+
+	// no validation rules for BlockNum
+
+	// no validation rules for Index
+
+	// no validation rules for Status
+
+	// no validation rules for Fee
+
+	// no validation rules for BurnedFee
+
+	// no validation rules for Tip
+
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiptValueValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiptValueValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiptValueValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSpent() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiptValueValidationError{
+						field:  fmt.Sprintf("Spent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiptValueValidationError{
+						field:  fmt.Sprintf("Spent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiptValueValidationError{
+					field:  fmt.Sprintf("Spent[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ReceiptValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiptValueMultiError is an error wrapping multiple validation errors
+// returned by ReceiptValue.ValidateAll() if the designated constraints aren't met.
+type ReceiptValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiptValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiptValueMultiError) AllErrors() []error { return m }
+
+// ReceiptValueValidationError is the validation error returned by
+// ReceiptValue.Validate if the designated constraints aren't met.
+type ReceiptValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiptValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiptValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiptValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiptValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiptValueValidationError) ErrorName() string { return "ReceiptValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiptValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiptValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiptValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiptValueValidationError{}
+
+// Validate checks the field values on OutputRefValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutputRefValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutputRefValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutputRefValueMultiError,
+// or nil if none found.
+func (m *OutputRefValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutputRefValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hash
+
+	// no validation rules for Index
+
+	if len(errors) > 0 {
+		return OutputRefValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutputRefValueMultiError is an error wrapping multiple validation errors
+// returned by OutputRefValue.ValidateAll() if the designated constraints
+// aren't met.
+type OutputRefValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutputRefValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutputRefValueMultiError) AllErrors() []error { return m }
+
+// OutputRefValueValidationError is the validation error returned by
+// OutputRefValue.Validate if the designated constraints aren't met.
+type OutputRefValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutputRefValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutputRefValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutputRefValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutputRefValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutputRefValueValidationError) ErrorName() string { return "OutputRefValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutputRefValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutputRefValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutputRefValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutputRefValueValidationError{}
+
+// Validate checks the field values on CheckpointValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckpointValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckpointValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckpointValueMultiError, or nil if none found.
+func (m *CheckpointValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckpointValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Epoch
+
+	// no validation rules for Num
+
+	// no validation rules for Hash
+
+	// no validation rules for VotedStake
+
+	// no validation rules for TotalStake
+
+	if len(errors) > 0 {
+		return CheckpointValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckpointValueMultiError is an error wrapping multiple validation errors
+// returned by CheckpointValue.ValidateAll() if the designated constraints
+// aren't met.
+type CheckpointValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckpointValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckpointValueMultiError) AllErrors() []error { return m }
+
+// CheckpointValueValidationError is the validation error returned by
+// CheckpointValue.Validate if the designated constraints aren't met.
+type CheckpointValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckpointValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckpointValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckpointValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckpointValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckpointValueValidationError) ErrorName() string { return "CheckpointValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckpointValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckpointValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckpointValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckpointValueValidationError{}
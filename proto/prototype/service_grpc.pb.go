@@ -35,6 +35,16 @@ type RaidoChainClient interface {
 	GetBalance(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*NumberResponse, error)
 	// GetTransaction returns transaction with given hash.
 	GetTransaction(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	// GetTransactionReceipt returns receipt of the transaction with given hash.
+	GetTransactionReceipt(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*ReceiptResponse, error)
+	// GetAddressTransactions get transactions sent or received by given address in the chain order.
+	// Next page starts after the returned cursor.
+	GetAddressTransactions(ctx context.Context, in *AddressTransactionsRequest, opts ...grpc.CallOption) (*AddressTransactionsResponse, error)
+	// GetOutput get transaction output with given hash and index including spent one.
+	GetOutput(ctx context.Context, in *OutputRequest, opts ...grpc.CallOption) (*OutputResponse, error)
+	// GetOutputsHistory get all outputs of given address including spent ones ordered by creation.
+	// Next page starts after the returned cursor.
+	GetOutputsHistory(ctx context.Context, in *OutputsHistoryRequest, opts ...grpc.CallOption) (*OutputsHistoryResponse, error)
 	// GetStakeDeposits get all unspent transaction outputs of given address
 	GetStakeDeposits(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*UTxOResponse, error)
 	// GetTransactionsCount get number of transactions sent by given address.
@@ -47,6 +57,14 @@ type RaidoChainClient interface {
 	ListStakeValidators(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ValidatorAddressesResponse, error)
 	// GetMarketCap returns the total amount in the system
 	GetMarketCap(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MarketCapResponse, error)
+	// GetCommittee returns validators committee voting for the blocks of the given slot.
+	GetCommittee(ctx context.Context, in *SlotRequest, opts ...grpc.CallOption) (*CommitteeResponse, error)
+	// GetFinalityStatus returns the last justified and finalized epoch checkpoints.
+	// Blocks up to the finalized checkpoint can no longer be reverted.
+	GetFinalityStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FinalityStatusResponse, error)
+	// GetChainInfo returns network chain id and Genesis hash bound into every signature,
+	// so wallets can build the signing domain without hardcoding it.
+	GetChainInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ChainInfoResponse, error)
 }
 
 type raidoChainClient struct {
@@ -111,6 +129,42 @@ func (c *raidoChainClient) GetTransaction(ctx context.Context, in *HashRequest,
 	return out, nil
 }
 
+func (c *raidoChainClient) GetTransactionReceipt(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*ReceiptResponse, error) {
+	out := new(ReceiptResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.RaidoChain/GetTransactionReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidoChainClient) GetAddressTransactions(ctx context.Context, in *AddressTransactionsRequest, opts ...grpc.CallOption) (*AddressTransactionsResponse, error) {
+	out := new(AddressTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.RaidoChain/GetAddressTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidoChainClient) GetOutput(ctx context.Context, in *OutputRequest, opts ...grpc.CallOption) (*OutputResponse, error) {
+	out := new(OutputResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.RaidoChain/GetOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidoChainClient) GetOutputsHistory(ctx context.Context, in *OutputsHistoryRequest, opts ...grpc.CallOption) (*OutputsHistoryResponse, error) {
+	out := new(OutputsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.RaidoChain/GetOutputsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *raidoChainClient) GetStakeDeposits(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*UTxOResponse, error) {
 	out := new(UTxOResponse)
 	err := c.cc.Invoke(ctx, "/rdo.service.RaidoChain/GetStakeDeposits", in, out, opts...)
@@ -165,6 +219,33 @@ func (c *raidoChainClient) GetMarketCap(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *raidoChainClient) GetCommittee(ctx context.Context, in *SlotRequest, opts ...grpc.CallOption) (*CommitteeResponse, error) {
+	out := new(CommitteeResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.RaidoChain/GetCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidoChainClient) GetFinalityStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FinalityStatusResponse, error) {
+	out := new(FinalityStatusResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.RaidoChain/GetFinalityStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidoChainClient) GetChainInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ChainInfoResponse, error) {
+	out := new(ChainInfoResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.RaidoChain/GetChainInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RaidoChainServer is the server API for RaidoChain service.
 // All implementations must embed UnimplementedRaidoChainServer
 // for forward compatibility
@@ -181,6 +262,16 @@ type RaidoChainServer interface {
 	GetBalance(context.Context, *AddressRequest) (*NumberResponse, error)
 	// GetTransaction returns transaction with given hash.
 	GetTransaction(context.Context, *HashRequest) (*TransactionResponse, error)
+	// GetTransactionReceipt returns receipt of the transaction with given hash.
+	GetTransactionReceipt(context.Context, *HashRequest) (*ReceiptResponse, error)
+	// GetAddressTransactions get transactions sent or received by given address in the chain order.
+	// Next page starts after the returned cursor.
+	GetAddressTransactions(context.Context, *AddressTransactionsRequest) (*AddressTransactionsResponse, error)
+	// GetOutput get transaction output with given hash and index including spent one.
+	GetOutput(context.Context, *OutputRequest) (*OutputResponse, error)
+	// GetOutputsHistory get all outputs of given address including spent ones ordered by creation.
+	// Next page starts after the returned cursor.
+	GetOutputsHistory(context.Context, *OutputsHistoryRequest) (*OutputsHistoryResponse, error)
 	// GetStakeDeposits get all unspent transaction outputs of given address
 	GetStakeDeposits(context.Context, *AddressRequest) (*UTxOResponse, error)
 	// GetTransactionsCount get number of transactions sent by given address.
@@ -193,6 +284,14 @@ type RaidoChainServer interface {
 	ListStakeValidators(context.Context, *emptypb.Empty) (*ValidatorAddressesResponse, error)
 	// GetMarketCap returns the total amount in the system
 	GetMarketCap(context.Context, *emptypb.Empty) (*MarketCapResponse, error)
+	// GetCommittee returns validators committee voting for the blocks of the given slot.
+	GetCommittee(context.Context, *SlotRequest) (*CommitteeResponse, error)
+	// GetFinalityStatus returns the last justified and finalized epoch checkpoints.
+	// Blocks up to the finalized checkpoint can no longer be reverted.
+	GetFinalityStatus(context.Context, *emptypb.Empty) (*FinalityStatusResponse, error)
+	// GetChainInfo returns network chain id and Genesis hash bound into every signature,
+	// so wallets can build the signing domain without hardcoding it.
+	GetChainInfo(context.Context, *emptypb.Empty) (*ChainInfoResponse, error)
 	mustEmbedUnimplementedRaidoChainServer()
 }
 
@@ -218,6 +317,18 @@ func (UnimplementedRaidoChainServer) GetBalance(context.Context, *AddressRequest
 func (UnimplementedRaidoChainServer) GetTransaction(context.Context, *HashRequest) (*TransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
 }
+func (UnimplementedRaidoChainServer) GetTransactionReceipt(context.Context, *HashRequest) (*ReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionReceipt not implemented")
+}
+func (UnimplementedRaidoChainServer) GetAddressTransactions(context.Context, *AddressTransactionsRequest) (*AddressTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressTransactions not implemented")
+}
+func (UnimplementedRaidoChainServer) GetOutput(context.Context, *OutputRequest) (*OutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutput not implemented")
+}
+func (UnimplementedRaidoChainServer) GetOutputsHistory(context.Context, *OutputsHistoryRequest) (*OutputsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutputsHistory not implemented")
+}
 func (UnimplementedRaidoChainServer) GetStakeDeposits(context.Context, *AddressRequest) (*UTxOResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStakeDeposits not implemented")
 }
@@ -236,6 +347,15 @@ func (UnimplementedRaidoChainServer) ListStakeValidators(context.Context, *empty
 func (UnimplementedRaidoChainServer) GetMarketCap(context.Context, *emptypb.Empty) (*MarketCapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMarketCap not implemented")
 }
+func (UnimplementedRaidoChainServer) GetCommittee(context.Context, *SlotRequest) (*CommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommittee not implemented")
+}
+func (UnimplementedRaidoChainServer) GetFinalityStatus(context.Context, *emptypb.Empty) (*FinalityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFinalityStatus not implemented")
+}
+func (UnimplementedRaidoChainServer) GetChainInfo(context.Context, *emptypb.Empty) (*ChainInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainInfo not implemented")
+}
 func (UnimplementedRaidoChainServer) mustEmbedUnimplementedRaidoChainServer() {}
 
 // UnsafeRaidoChainServer may be embedded to opt out of forward compatibility for this service.
@@ -357,6 +477,78 @@ func _RaidoChain_GetTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RaidoChain_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidoChainServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.RaidoChain/GetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidoChainServer).GetTransactionReceipt(ctx, req.(*HashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidoChain_GetAddressTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidoChainServer).GetAddressTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.RaidoChain/GetAddressTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidoChainServer).GetAddressTransactions(ctx, req.(*AddressTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidoChain_GetOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidoChainServer).GetOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.RaidoChain/GetOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidoChainServer).GetOutput(ctx, req.(*OutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidoChain_GetOutputsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutputsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidoChainServer).GetOutputsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.RaidoChain/GetOutputsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidoChainServer).GetOutputsHistory(ctx, req.(*OutputsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RaidoChain_GetStakeDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddressRequest)
 	if err := dec(in); err != nil {
@@ -465,6 +657,60 @@ func _RaidoChain_GetMarketCap_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RaidoChain_GetCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidoChainServer).GetCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.RaidoChain/GetCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidoChainServer).GetCommittee(ctx, req.(*SlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidoChain_GetFinalityStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidoChainServer).GetFinalityStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.RaidoChain/GetFinalityStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidoChainServer).GetFinalityStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidoChain_GetChainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidoChainServer).GetChainInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.RaidoChain/GetChainInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidoChainServer).GetChainInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RaidoChain_ServiceDesc is the grpc.ServiceDesc for RaidoChain service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -496,6 +742,22 @@ var RaidoChain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransaction",
 			Handler:    _RaidoChain_GetTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransactionReceipt",
+			Handler:    _RaidoChain_GetTransactionReceipt_Handler,
+		},
+		{
+			MethodName: "GetAddressTransactions",
+			Handler:    _RaidoChain_GetAddressTransactions_Handler,
+		},
+		{
+			MethodName: "GetOutput",
+			Handler:    _RaidoChain_GetOutput_Handler,
+		},
+		{
+			MethodName: "GetOutputsHistory",
+			Handler:    _RaidoChain_GetOutputsHistory_Handler,
+		},
 		{
 			MethodName: "GetStakeDeposits",
 			Handler:    _RaidoChain_GetStakeDeposits_Handler,
@@ -520,6 +782,18 @@ var RaidoChain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMarketCap",
 			Handler:    _RaidoChain_GetMarketCap_Handler,
 		},
+		{
+			MethodName: "GetCommittee",
+			Handler:    _RaidoChain_GetCommittee_Handler,
+		},
+		{
+			MethodName: "GetFinalityStatus",
+			Handler:    _RaidoChain_GetFinalityStatus_Handler,
+		},
+		{
+			MethodName: "GetChainInfo",
+			Handler:    _RaidoChain_GetChainInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "prototype/service.proto",
@@ -537,10 +811,21 @@ type AttestationClient interface {
 	SendUnstakeTx(ctx context.Context, in *SendTxRequest, opts ...grpc.CallOption) (*ErrorResponse, error)
 	// SendRawTx send raw transaction to the node.
 	SendRawTx(ctx context.Context, in *RawTxRequest, opts ...grpc.CallOption) (*ErrorResponse, error)
+	// SendRawTxBatch sends several raw transactions to the node. Every transaction is inserted to the pool separately
+	// and gets its own result, so rejected transactions don't fail the whole batch.
+	SendRawTxBatch(ctx context.Context, in *RawTxBatchRequest, opts ...grpc.CallOption) (*TxBatchResponse, error)
 	// GetFee returns minimal fee price needed to add transaction to the future block.
-	GetFee(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NumberResponse, error)
+	GetFee(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FeeResponse, error)
 	// GetPendingTransactions returns pending transactions list.
 	GetPendingTransactions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TransactionsResponse, error)
+	// GetPendingByAddress returns pending and queued transactions of the given address.
+	GetPendingByAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*TransactionsResponse, error)
+	// GetPendingTransaction returns pool transaction with given hash and its pool status.
+	// Transactions recently removed from the pool have forged or dropped status.
+	GetPendingTransaction(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*PendingTransactionResponse, error)
+	// SubscribePendingTransactions streams pool transactions status changes.
+	// Stream is filtered by the sender address if it is given.
+	SubscribePendingTransactions(ctx context.Context, in *PendingSubscribeRequest, opts ...grpc.CallOption) (Attestation_SubscribePendingTransactionsClient, error)
 }
 
 type attestationClient struct {
@@ -587,8 +872,17 @@ func (c *attestationClient) SendRawTx(ctx context.Context, in *RawTxRequest, opt
 	return out, nil
 }
 
-func (c *attestationClient) GetFee(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NumberResponse, error) {
-	out := new(NumberResponse)
+func (c *attestationClient) SendRawTxBatch(ctx context.Context, in *RawTxBatchRequest, opts ...grpc.CallOption) (*TxBatchResponse, error) {
+	out := new(TxBatchResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.Attestation/SendRawTxBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attestationClient) GetFee(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FeeResponse, error) {
+	out := new(FeeResponse)
 	err := c.cc.Invoke(ctx, "/rdo.service.Attestation/GetFee", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -605,6 +899,56 @@ func (c *attestationClient) GetPendingTransactions(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *attestationClient) GetPendingByAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*TransactionsResponse, error) {
+	out := new(TransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.Attestation/GetPendingByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attestationClient) GetPendingTransaction(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*PendingTransactionResponse, error) {
+	out := new(PendingTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rdo.service.Attestation/GetPendingTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attestationClient) SubscribePendingTransactions(ctx context.Context, in *PendingSubscribeRequest, opts ...grpc.CallOption) (Attestation_SubscribePendingTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Attestation_ServiceDesc.Streams[0], "/rdo.service.Attestation/SubscribePendingTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attestationSubscribePendingTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Attestation_SubscribePendingTransactionsClient interface {
+	Recv() (*PendingTransactionResponse, error)
+	grpc.ClientStream
+}
+
+type attestationSubscribePendingTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *attestationSubscribePendingTransactionsClient) Recv() (*PendingTransactionResponse, error) {
+	m := new(PendingTransactionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AttestationServer is the server API for Attestation service.
 // All implementations must embed UnimplementedAttestationServer
 // for forward compatibility
@@ -617,10 +961,21 @@ type AttestationServer interface {
 	SendUnstakeTx(context.Context, *SendTxRequest) (*ErrorResponse, error)
 	// SendRawTx send raw transaction to the node.
 	SendRawTx(context.Context, *RawTxRequest) (*ErrorResponse, error)
+	// SendRawTxBatch sends several raw transactions to the node. Every transaction is inserted to the pool separately
+	// and gets its own result, so rejected transactions don't fail the whole batch.
+	SendRawTxBatch(context.Context, *RawTxBatchRequest) (*TxBatchResponse, error)
 	// GetFee returns minimal fee price needed to add transaction to the future block.
-	GetFee(context.Context, *emptypb.Empty) (*NumberResponse, error)
+	GetFee(context.Context, *emptypb.Empty) (*FeeResponse, error)
 	// GetPendingTransactions returns pending transactions list.
 	GetPendingTransactions(context.Context, *emptypb.Empty) (*TransactionsResponse, error)
+	// GetPendingByAddress returns pending and queued transactions of the given address.
+	GetPendingByAddress(context.Context, *AddressRequest) (*TransactionsResponse, error)
+	// GetPendingTransaction returns pool transaction with given hash and its pool status.
+	// Transactions recently removed from the pool have forged or dropped status.
+	GetPendingTransaction(context.Context, *HashRequest) (*PendingTransactionResponse, error)
+	// SubscribePendingTransactions streams pool transactions status changes.
+	// Stream is filtered by the sender address if it is given.
+	SubscribePendingTransactions(*PendingSubscribeRequest, Attestation_SubscribePendingTransactionsServer) error
 	mustEmbedUnimplementedAttestationServer()
 }
 
@@ -640,12 +995,24 @@ func (UnimplementedAttestationServer) SendUnstakeTx(context.Context, *SendTxRequ
 func (UnimplementedAttestationServer) SendRawTx(context.Context, *RawTxRequest) (*ErrorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendRawTx not implemented")
 }
-func (UnimplementedAttestationServer) GetFee(context.Context, *emptypb.Empty) (*NumberResponse, error) {
+func (UnimplementedAttestationServer) SendRawTxBatch(context.Context, *RawTxBatchRequest) (*TxBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRawTxBatch not implemented")
+}
+func (UnimplementedAttestationServer) GetFee(context.Context, *emptypb.Empty) (*FeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFee not implemented")
 }
 func (UnimplementedAttestationServer) GetPendingTransactions(context.Context, *emptypb.Empty) (*TransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTransactions not implemented")
 }
+func (UnimplementedAttestationServer) GetPendingByAddress(context.Context, *AddressRequest) (*TransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingByAddress not implemented")
+}
+func (UnimplementedAttestationServer) GetPendingTransaction(context.Context, *HashRequest) (*PendingTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTransaction not implemented")
+}
+func (UnimplementedAttestationServer) SubscribePendingTransactions(*PendingSubscribeRequest, Attestation_SubscribePendingTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePendingTransactions not implemented")
+}
 func (UnimplementedAttestationServer) mustEmbedUnimplementedAttestationServer() {}
 
 // UnsafeAttestationServer may be embedded to opt out of forward compatibility for this service.
@@ -731,6 +1098,24 @@ func _Attestation_SendRawTx_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Attestation_SendRawTxBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawTxBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttestationServer).SendRawTxBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.Attestation/SendRawTxBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttestationServer).SendRawTxBatch(ctx, req.(*RawTxBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Attestation_GetFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -767,6 +1152,63 @@ func _Attestation_GetPendingTransactions_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Attestation_GetPendingByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttestationServer).GetPendingByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.Attestation/GetPendingByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttestationServer).GetPendingByAddress(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attestation_GetPendingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttestationServer).GetPendingTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rdo.service.Attestation/GetPendingTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttestationServer).GetPendingTransaction(ctx, req.(*HashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attestation_SubscribePendingTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PendingSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttestationServer).SubscribePendingTransactions(m, &attestationSubscribePendingTransactionsServer{stream})
+}
+
+type Attestation_SubscribePendingTransactionsServer interface {
+	Send(*PendingTransactionResponse) error
+	grpc.ServerStream
+}
+
+type attestationSubscribePendingTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *attestationSubscribePendingTransactionsServer) Send(m *PendingTransactionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Attestation_ServiceDesc is the grpc.ServiceDesc for Attestation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -790,6 +1232,10 @@ var Attestation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendRawTx",
 			Handler:    _Attestation_SendRawTx_Handler,
 		},
+		{
+			MethodName: "SendRawTxBatch",
+			Handler:    _Attestation_SendRawTxBatch_Handler,
+		},
 		{
 			MethodName: "GetFee",
 			Handler:    _Attestation_GetFee_Handler,
@@ -798,8 +1244,22 @@ var Attestation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingTransactions",
 			Handler:    _Attestation_GetPendingTransactions_Handler,
 		},
+		{
+			MethodName: "GetPendingByAddress",
+			Handler:    _Attestation_GetPendingByAddress_Handler,
+		},
+		{
+			MethodName: "GetPendingTransaction",
+			Handler:    _Attestation_GetPendingTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePendingTransactions",
+			Handler:       _Attestation_SubscribePendingTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "prototype/service.proto",
 }
 
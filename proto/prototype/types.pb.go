@@ -28,17 +28,23 @@ type Block struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Num          uint64         `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
-	Slot         uint64         `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Version      []byte         `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty" ssz-size:"3"`
-	Hash         []byte         `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty" ssz-size:"32"`
-	Parent       []byte         `protobuf:"bytes,5,opt,name=parent,proto3" json:"parent,omitempty" ssz-size:"32"`
-	Timestamp    uint64         `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Txroot       []byte         `protobuf:"bytes,7,opt,name=txroot,proto3" json:"txroot,omitempty" ssz-size:"32"`
-	Proposer     *Sign          `protobuf:"bytes,8,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	Approvers    []*Sign        `protobuf:"bytes,9,rep,name=approvers,proto3" json:"approvers,omitempty" ssz-max:"128"`
-	Slashers     []*Sign        `protobuf:"bytes,10,rep,name=slashers,proto3" json:"slashers,omitempty" ssz-max:"128"`
-	Transactions []*Transaction `protobuf:"bytes,11,rep,name=transactions,proto3" json:"transactions,omitempty" ssz-max:"1500"`
+	Num             uint64            `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Slot            uint64            `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Version         []byte            `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty" ssz-size:"3"`
+	Hash            []byte            `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty" ssz-size:"32"`
+	Parent          []byte            `protobuf:"bytes,5,opt,name=parent,proto3" json:"parent,omitempty" ssz-size:"32"`
+	Timestamp       uint64            `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Txroot          []byte            `protobuf:"bytes,7,opt,name=txroot,proto3" json:"txroot,omitempty" ssz-size:"32"`
+	Proposer        *Sign             `protobuf:"bytes,8,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Approvers       []*Sign           `protobuf:"bytes,9,rep,name=approvers,proto3" json:"approvers,omitempty" ssz-max:"128"`
+	Slashers        []*Sign           `protobuf:"bytes,10,rep,name=slashers,proto3" json:"slashers,omitempty" ssz-max:"128"`
+	Transactions    []*Transaction    `protobuf:"bytes,11,rep,name=transactions,proto3" json:"transactions,omitempty" ssz-max:"1500"`
+	Seed            []byte            `protobuf:"bytes,12,opt,name=seed,proto3" json:"seed,omitempty" ssz-max:"32"`                                               // randomness mix after the block, empty for Genesis
+	Reveals         []*Seed           `protobuf:"bytes,13,rep,name=reveals,proto3" json:"reveals,omitempty" ssz-max:"128"`                                        // validators seeds revealed in the block slot
+	Approvals       *AggregatedVotes  `protobuf:"bytes,14,opt,name=approvals,proto3" json:"approvals,omitempty"`                                                  // committee approvals aggregated by the proposer
+	Rejections      *AggregatedVotes  `protobuf:"bytes,15,opt,name=rejections,proto3" json:"rejections,omitempty"`                                                // committee rejections aggregated by the proposer
+	BaseFee         uint64            `protobuf:"varint,16,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`                                      // base fee per byte burned by the block transactions
+	CheckpointVotes []*CheckpointVote `protobuf:"bytes,17,rep,name=checkpoint_votes,json=checkpointVotes,proto3" json:"checkpoint_votes,omitempty" ssz-max:"128"` // validators votes for the epoch checkpoint
 }
 
 func (x *Block) Reset() {
@@ -150,6 +156,105 @@ func (x *Block) GetTransactions() []*Transaction {
 	return nil
 }
 
+func (x *Block) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *Block) GetReveals() []*Seed {
+	if x != nil {
+		return x.Reveals
+	}
+	return nil
+}
+
+func (x *Block) GetApprovals() *AggregatedVotes {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *Block) GetRejections() *AggregatedVotes {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *Block) GetBaseFee() uint64 {
+	if x != nil {
+		return x.BaseFee
+	}
+	return 0
+}
+
+func (x *Block) GetCheckpointVotes() []*CheckpointVote {
+	if x != nil {
+		return x.CheckpointVotes
+	}
+	return nil
+}
+
+// AggregatedVotes is the compact form of the committee votes. Bit i is set when i-th member of the committee
+// ordered by address voted, signatures are ordered by the set bits.
+type AggregatedVotes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bits       []byte   `protobuf:"bytes,1,opt,name=bits,proto3" json:"bits,omitempty" ssz-max:"16"`
+	Signatures [][]byte `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty" ssz-size:"?,65" ssz-max:"128"`
+}
+
+func (x *AggregatedVotes) Reset() {
+	*x = AggregatedVotes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregatedVotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregatedVotes) ProtoMessage() {}
+
+func (x *AggregatedVotes) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregatedVotes.ProtoReflect.Descriptor instead.
+func (*AggregatedVotes) Descriptor() ([]byte, []int) {
+	return file_prototype_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AggregatedVotes) GetBits() []byte {
+	if x != nil {
+		return x.Bits
+	}
+	return nil
+}
+
+func (x *AggregatedVotes) GetSignatures() [][]byte {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 type Sign struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -162,7 +267,7 @@ type Sign struct {
 func (x *Sign) Reset() {
 	*x = Sign{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_prototype_types_proto_msgTypes[1]
+		mi := &file_prototype_types_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -175,7 +280,7 @@ func (x *Sign) String() string {
 func (*Sign) ProtoMessage() {}
 
 func (x *Sign) ProtoReflect() protoreflect.Message {
-	mi := &file_prototype_types_proto_msgTypes[1]
+	mi := &file_prototype_types_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +293,7 @@ func (x *Sign) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sign.ProtoReflect.Descriptor instead.
 func (*Sign) Descriptor() ([]byte, []int) {
-	return file_prototype_types_proto_rawDescGZIP(), []int{1}
+	return file_prototype_types_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Sign) GetAddress() []byte {
@@ -210,22 +315,25 @@ type Transaction struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Num       uint64      `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
-	Type      uint32      `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Timestamp uint64      `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Hash      []byte      `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty" ssz-size:"32"`
-	Fee       uint64      `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
-	Data      []byte      `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty" ssz-max:"1000000"` // external byte data
-	Inputs    []*TxInput  `protobuf:"bytes,7,rep,name=inputs,proto3" json:"inputs,omitempty" ssz-max:"2000"`
-	Outputs   []*TxOutput `protobuf:"bytes,8,rep,name=outputs,proto3" json:"outputs,omitempty" ssz-max:"2000"`
-	Signature []byte      `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty" ssz-size:"65"`
-	Status    uint32      `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	Num        uint64      `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Type       uint32      `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp  uint64      `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Hash       []byte      `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty" ssz-size:"32"`
+	Fee        uint64      `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Data       []byte      `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty" ssz-max:"1000000"` // external byte data
+	Inputs     []*TxInput  `protobuf:"bytes,7,rep,name=inputs,proto3" json:"inputs,omitempty" ssz-max:"2000"`
+	Outputs    []*TxOutput `protobuf:"bytes,8,rep,name=outputs,proto3" json:"outputs,omitempty" ssz-max:"2000"`
+	Signature  []byte      `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty" ssz-size:"65"`
+	Status     uint32      `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	Version    uint32      `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                         // signature scheme version, 0 is legacy signature without network domain
+	ValidAfter uint64      `protobuf:"varint,12,opt,name=valid_after,json=validAfter,proto3" json:"valid_after,omitempty"` // the first block number the transaction can be included in, 0 means no bound
+	ValidUntil uint64      `protobuf:"varint,13,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"` // the last block number the transaction can be included in, 0 means no bound
 }
 
 func (x *Transaction) Reset() {
 	*x = Transaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_prototype_types_proto_msgTypes[2]
+		mi := &file_prototype_types_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -238,7 +346,7 @@ func (x *Transaction) String() string {
 func (*Transaction) ProtoMessage() {}
 
 func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_prototype_types_proto_msgTypes[2]
+	mi := &file_prototype_types_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +359,7 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_prototype_types_proto_rawDescGZIP(), []int{2}
+	return file_prototype_types_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Transaction) GetNum() uint64 {
@@ -324,6 +432,211 @@ func (x *Transaction) GetStatus() uint32 {
 	return 0
 }
 
+func (x *Transaction) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Transaction) GetValidAfter() uint64 {
+	if x != nil {
+		return x.ValidAfter
+	}
+	return 0
+}
+
+func (x *Transaction) GetValidUntil() uint64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+// Receipt describes inclusion and execution result of the transaction
+type Receipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash    []byte       `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" ssz-size:"32"`
+	BlockHash []byte       `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty" ssz-size:"32"`
+	BlockNum  uint64       `protobuf:"varint,3,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Index     uint32       `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`                          // transaction index in the block
+	Status    uint32       `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                        // execution status
+	Fee       uint64       `protobuf:"varint,6,opt,name=fee,proto3" json:"fee,omitempty"`                              // fee paid by the transaction
+	BurnedFee uint64       `protobuf:"varint,7,opt,name=burned_fee,json=burnedFee,proto3" json:"burned_fee,omitempty"` // part of the fee burned by the block base fee
+	Tip       uint64       `protobuf:"varint,8,opt,name=tip,proto3" json:"tip,omitempty"`                              // part of the fee paid to the block proposer
+	Created   []*OutputRef `protobuf:"bytes,9,rep,name=created,proto3" json:"created,omitempty" ssz-max:"2000"`
+	Spent     []*OutputRef `protobuf:"bytes,10,rep,name=spent,proto3" json:"spent,omitempty" ssz-max:"2000"`
+	Reason    []byte       `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty" ssz-max:"256"` // validation error of the failed transaction
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_prototype_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Receipt) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *Receipt) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Receipt) GetBlockNum() uint64 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *Receipt) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Receipt) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Receipt) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Receipt) GetBurnedFee() uint64 {
+	if x != nil {
+		return x.BurnedFee
+	}
+	return 0
+}
+
+func (x *Receipt) GetTip() uint64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+func (x *Receipt) GetCreated() []*OutputRef {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Receipt) GetSpent() []*OutputRef {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *Receipt) GetReason() []byte {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+// OutputRef points to the transaction output
+type OutputRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash  []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" ssz-size:"32"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *OutputRef) Reset() {
+	*x = OutputRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputRef) ProtoMessage() {}
+
+func (x *OutputRef) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputRef.ProtoReflect.Descriptor instead.
+func (*OutputRef) Descriptor() ([]byte, []int) {
+	return file_prototype_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OutputRef) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *OutputRef) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
 type TxInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -339,7 +652,7 @@ type TxInput struct {
 func (x *TxInput) Reset() {
 	*x = TxInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_prototype_types_proto_msgTypes[3]
+		mi := &file_prototype_types_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -352,7 +665,7 @@ func (x *TxInput) String() string {
 func (*TxInput) ProtoMessage() {}
 
 func (x *TxInput) ProtoReflect() protoreflect.Message {
-	mi := &file_prototype_types_proto_msgTypes[3]
+	mi := &file_prototype_types_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +678,7 @@ func (x *TxInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxInput.ProtoReflect.Descriptor instead.
 func (*TxInput) Descriptor() ([]byte, []int) {
-	return file_prototype_types_proto_rawDescGZIP(), []int{3}
+	return file_prototype_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TxInput) GetHash() []byte {
@@ -416,7 +729,7 @@ type TxOutput struct {
 func (x *TxOutput) Reset() {
 	*x = TxOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_prototype_types_proto_msgTypes[4]
+		mi := &file_prototype_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -429,7 +742,7 @@ func (x *TxOutput) String() string {
 func (*TxOutput) ProtoMessage() {}
 
 func (x *TxOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_prototype_types_proto_msgTypes[4]
+	mi := &file_prototype_types_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +755,7 @@ func (x *TxOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxOutput.ProtoReflect.Descriptor instead.
 func (*TxOutput) Descriptor() ([]byte, []int) {
-	return file_prototype_types_proto_rawDescGZIP(), []int{4}
+	return file_prototype_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TxOutput) GetAddress() []byte {
@@ -479,7 +792,7 @@ type Metadata struct {
 func (x *Metadata) Reset() {
 	*x = Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_prototype_types_proto_msgTypes[5]
+		mi := &file_prototype_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -492,7 +805,7 @@ func (x *Metadata) String() string {
 func (*Metadata) ProtoMessage() {}
 
 func (x *Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_prototype_types_proto_msgTypes[5]
+	mi := &file_prototype_types_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +818,7 @@ func (x *Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return file_prototype_types_proto_rawDescGZIP(), []int{5}
+	return file_prototype_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Metadata) GetHeadSlot() uint64 {
@@ -542,7 +855,7 @@ type BlockRequest struct {
 func (x *BlockRequest) Reset() {
 	*x = BlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_prototype_types_proto_msgTypes[6]
+		mi := &file_prototype_types_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -555,7 +868,7 @@ func (x *BlockRequest) String() string {
 func (*BlockRequest) ProtoMessage() {}
 
 func (x *BlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prototype_types_proto_msgTypes[6]
+	mi := &file_prototype_types_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +881,7 @@ func (x *BlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
 func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return file_prototype_types_proto_rawDescGZIP(), []int{6}
+	return file_prototype_types_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BlockRequest) GetStartSlot() uint64 {
@@ -597,14 +910,17 @@ type Seed struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seed     uint32 `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty" ssz-max:"32"`
-	Proposer *Sign  `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Proposer   *Sign  `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Slot       uint64 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch      uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Commitment []byte `protobuf:"bytes,5,opt,name=commitment,proto3" json:"commitment,omitempty" ssz-size:"32"` // hash of the secret revealed in the next block
+	Reveal     []byte `protobuf:"bytes,6,opt,name=reveal,proto3" json:"reveal,omitempty" ssz-size:"32"`         // secret committed in the parent block
 }
 
 func (x *Seed) Reset() {
 	*x = Seed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_prototype_types_proto_msgTypes[7]
+		mi := &file_prototype_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -617,7 +933,7 @@ func (x *Seed) String() string {
 func (*Seed) ProtoMessage() {}
 
 func (x *Seed) ProtoReflect() protoreflect.Message {
-	mi := &file_prototype_types_proto_msgTypes[7]
+	mi := &file_prototype_types_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,19 +946,296 @@ func (x *Seed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Seed.ProtoReflect.Descriptor instead.
 func (*Seed) Descriptor() ([]byte, []int) {
-	return file_prototype_types_proto_rawDescGZIP(), []int{7}
+	return file_prototype_types_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Seed) GetSeed() uint32 {
+func (x *Seed) GetProposer() *Sign {
 	if x != nil {
-		return x.Seed
+		return x.Proposer
 	}
-	return 0
+	return nil
 }
 
-func (x *Seed) GetProposer() *Sign {
+func (x *Seed) GetSlot() uint64 {
 	if x != nil {
-		return x.Proposer
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *Seed) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Seed) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *Seed) GetReveal() []byte {
+	if x != nil {
+		return x.Reveal
+	}
+	return nil
+}
+
+// CheckpointVote links the justified source checkpoint with the target checkpoint of the current epoch
+type CheckpointVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceEpoch uint64 `protobuf:"varint,1,opt,name=source_epoch,json=sourceEpoch,proto3" json:"source_epoch,omitempty"`
+	SourceHash  []byte `protobuf:"bytes,2,opt,name=source_hash,json=sourceHash,proto3" json:"source_hash,omitempty" ssz-size:"32"`
+	TargetEpoch uint64 `protobuf:"varint,3,opt,name=target_epoch,json=targetEpoch,proto3" json:"target_epoch,omitempty"`
+	TargetHash  []byte `protobuf:"bytes,4,opt,name=target_hash,json=targetHash,proto3" json:"target_hash,omitempty" ssz-size:"32"`
+	Voter       *Sign  `protobuf:"bytes,5,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (x *CheckpointVote) Reset() {
+	*x = CheckpointVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointVote) ProtoMessage() {}
+
+func (x *CheckpointVote) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointVote.ProtoReflect.Descriptor instead.
+func (*CheckpointVote) Descriptor() ([]byte, []int) {
+	return file_prototype_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CheckpointVote) GetSourceEpoch() uint64 {
+	if x != nil {
+		return x.SourceEpoch
+	}
+	return 0
+}
+
+func (x *CheckpointVote) GetSourceHash() []byte {
+	if x != nil {
+		return x.SourceHash
+	}
+	return nil
+}
+
+func (x *CheckpointVote) GetTargetEpoch() uint64 {
+	if x != nil {
+		return x.TargetEpoch
+	}
+	return 0
+}
+
+func (x *CheckpointVote) GetTargetHash() []byte {
+	if x != nil {
+		return x.TargetHash
+	}
+	return nil
+}
+
+func (x *CheckpointVote) GetVoter() *Sign {
+	if x != nil {
+		return x.Voter
+	}
+	return nil
+}
+
+// SignedHeader is the block header with the proposer or attester signature
+type SignedHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Num            uint64 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Slot           uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Version        []byte `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty" ssz-size:"3"`
+	Parent         []byte `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty" ssz-size:"32"`
+	Txroot         []byte `protobuf:"bytes,5,opt,name=txroot,proto3" json:"txroot,omitempty" ssz-size:"32"`
+	Seed           []byte `protobuf:"bytes,6,opt,name=seed,proto3" json:"seed,omitempty" ssz-max:"32"`
+	Vote           uint32 `protobuf:"varint,7,opt,name=vote,proto3" json:"vote,omitempty"` // attestation type, used only for votes
+	Signature      *Sign  `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	CheckpointRoot []byte `protobuf:"bytes,9,opt,name=checkpoint_root,json=checkpointRoot,proto3" json:"checkpoint_root,omitempty" ssz-max:"32"` // root of the block checkpoint votes, empty if block has no votes
+}
+
+func (x *SignedHeader) Reset() {
+	*x = SignedHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedHeader) ProtoMessage() {}
+
+func (x *SignedHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedHeader.ProtoReflect.Descriptor instead.
+func (*SignedHeader) Descriptor() ([]byte, []int) {
+	return file_prototype_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SignedHeader) GetNum() uint64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *SignedHeader) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SignedHeader) GetVersion() []byte {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *SignedHeader) GetParent() []byte {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *SignedHeader) GetTxroot() []byte {
+	if x != nil {
+		return x.Txroot
+	}
+	return nil
+}
+
+func (x *SignedHeader) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *SignedHeader) GetVote() uint32 {
+	if x != nil {
+		return x.Vote
+	}
+	return 0
+}
+
+func (x *SignedHeader) GetSignature() *Sign {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *SignedHeader) GetCheckpointRoot() []byte {
+	if x != nil {
+		return x.CheckpointRoot
+	}
+	return nil
+}
+
+// SlashingEvidence proves that validator signed two conflicting messages in the same slot
+type SlashingEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   uint32        `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"` // 1 - double proposal, 2 - double vote
+	First  *SignedHeader `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	Second *SignedHeader `protobuf:"bytes,3,opt,name=second,proto3" json:"second,omitempty"`
+}
+
+func (x *SlashingEvidence) Reset() {
+	*x = SlashingEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prototype_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingEvidence) ProtoMessage() {}
+
+func (x *SlashingEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_prototype_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingEvidence.ProtoReflect.Descriptor instead.
+func (*SlashingEvidence) Descriptor() ([]byte, []int) {
+	return file_prototype_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SlashingEvidence) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SlashingEvidence) GetFirst() *SignedHeader {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *SlashingEvidence) GetSecond() *SignedHeader {
+	if x != nil {
+		return x.Second
 	}
 	return nil
 }
@@ -657,7 +1250,7 @@ var file_prototype_types_proto_rawDesc = []byte{
 	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11,
 	0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x03, 0x0a, 0x05, 0x42,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x06, 0x0a, 0x05, 0x42,
 	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x76, 0x65,
@@ -686,77 +1279,187 @@ var file_prototype_types_proto_rawDesc = []byte{
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
 	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x31, 0x35, 0x30, 0x30,
-	0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4e,
-	0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x20, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x32, 0x30, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5, 0x18,
-	0x02, 0x36, 0x35, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9d,
-	0x03, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x32, 0x02, 0x28, 0x00, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x2a, 0x06, 0x30, 0x01,
-	0x30, 0x05, 0x30, 0x06, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x21, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x0d, 0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x20, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12,
-	0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0x8a,
-	0xb5, 0x18, 0x05, 0x31, 0x30, 0x30, 0x30, 0x30, 0xfa, 0x42, 0x05, 0x7a, 0x03, 0x18, 0x90, 0x4e,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x32, 0x30, 0x30, 0x30, 0x52, 0x06,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x32, 0x30, 0x30, 0x30,
-	0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0x82, 0xb5,
-	0x18, 0x02, 0x36, 0x35, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x41, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb6,
-	0x01, 0x0a, 0x07, 0x54, 0x78, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x02, 0x33, 0x32,
-	0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x20, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x02, 0x32, 0x30, 0xfa, 0x42, 0x04, 0x7a,
-	0x02, 0x68, 0x14, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x14, 0x8a, 0xb5, 0x18,
-	0x02, 0x32, 0x30, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x14, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x70,
-	0x01, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x7e, 0x0a, 0x08, 0x54, 0x78, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x02, 0x32, 0x30, 0xfa, 0x42, 0x04, 0x7a,
-	0x02, 0x68, 0x14, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x14, 0x8a, 0xb5, 0x18,
-	0x02, 0x32, 0x30, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x14, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x70,
-	0x01, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x7f, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5,
+	0x18, 0x02, 0x33, 0x32, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x64,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x42, 0x07, 0x8a, 0xb5, 0x18, 0x03, 0x31, 0x32, 0x38, 0x52,
+	0x07, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x64,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x44, 0x0a, 0x0a,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x57, 0x0a,
+	0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x07, 0x8a, 0xb5,
+	0x18, 0x03, 0x31, 0x32, 0x38, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x04, 0x62, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x31, 0x36, 0x52,
+	0x04, 0x62, 0x69, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x04, 0x3f,
+	0x2c, 0x36, 0x35, 0x8a, 0xb5, 0x18, 0x03, 0x31, 0x32, 0x38, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x20,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x06, 0x82, 0xb5, 0x18, 0x02, 0x32, 0x30, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x36, 0x35, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x03, 0x6e, 0x75,
+	0x6d, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x0b, 0xfa, 0x42, 0x08, 0x2a, 0x06, 0x30, 0x01, 0x30, 0x05, 0x30, 0x06, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x20,
+	0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02,
+	0x28, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xfa, 0x42, 0x05, 0x7a, 0x03, 0x18, 0x90, 0x4e, 0x8a,
+	0xb5, 0x18, 0x05, 0x31, 0x30, 0x30, 0x30, 0x30, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3e,
+	0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x08, 0x8a, 0xb5,
+	0x18, 0x04, 0x32, 0x30, 0x30, 0x30, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x41,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x08,
+	0x8a, 0xb5, 0x18, 0x04, 0x32, 0x30, 0x30, 0x30, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x41, 0x82, 0xb5, 0x18,
+	0x02, 0x36, 0x35, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x22, 0x84, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1f,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x06, 0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x25, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x46,
+	0x65, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x74, 0x69, 0x70, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x66, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x32, 0x30, 0x30, 0x30, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x66, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x32, 0x30, 0x30,
+	0x30, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0x8a, 0xb5, 0x18, 0x03, 0x32, 0x35,
+	0x36, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x09, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x65, 0x66, 0x12, 0x1a, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x54, 0x78, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x0d, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x20, 0x82, 0xb5, 0x18, 0x02, 0x33,
+	0x32, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d,
+	0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x14, 0x82, 0xb5, 0x18, 0x02, 0x32, 0x30, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0f, 0xfa, 0x42, 0x06, 0x7a, 0x04, 0x68, 0x14, 0x70, 0x01,
+	0x8a, 0xb5, 0x18, 0x02, 0x32, 0x30, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x79, 0x0a, 0x08,
+	0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0xfa, 0x42, 0x04, 0x7a, 0x02,
+	0x68, 0x14, 0x82, 0xb5, 0x18, 0x02, 0x32, 0x30, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x0f, 0xfa, 0x42, 0x06, 0x7a, 0x04, 0x68, 0x14, 0x70, 0x01, 0x8a, 0xb5, 0x18, 0x02, 0x32,
+	0x30, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x7f, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
 	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12,
 	0x22, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
 	0x4e, 0x75, 0x6d, 0x12, 0x33, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0x8a, 0xb5, 0x18, 0x02,
-	0x33, 0x32, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x68, 0x20, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x42,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0xfa, 0x42, 0x04, 0x7a,
+	0x02, 0x68, 0x20, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x42,
 	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x56, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63,
 	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
 	0x74, 0x53, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61,
 	0x72, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
 	0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
-	0x22, 0x51, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x22, 0xb5, 0x01, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x64,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06,
+	0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x06, 0x72, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27,
+	0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0a, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x0b, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x06, 0x82, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x22, 0xaf, 0x02, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0x82, 0xb5,
+	0x18, 0x01, 0x33, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5,
+	0x18, 0x02, 0x33, 0x32, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x06,
+	0x74, 0x78, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xb5,
+	0x18, 0x02, 0x33, 0x32, 0x52, 0x06, 0x74, 0x78, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x33, 0x32, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06,
+	0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x37, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x42, 0x17, 0x5a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x64, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x42, 0x17, 0x5a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
 	0x2f, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x62, 0x06, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x33,
 }
@@ -773,30 +1476,46 @@ func file_prototype_types_proto_rawDescGZIP() []byte {
 	return file_prototype_types_proto_rawDescData
 }
 
-var file_prototype_types_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_prototype_types_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_prototype_types_proto_goTypes = []interface{}{
-	(*Block)(nil),        // 0: rdo.prototype.types.Block
-	(*Sign)(nil),         // 1: rdo.prototype.types.Sign
-	(*Transaction)(nil),  // 2: rdo.prototype.types.Transaction
-	(*TxInput)(nil),      // 3: rdo.prototype.types.TxInput
-	(*TxOutput)(nil),     // 4: rdo.prototype.types.TxOutput
-	(*Metadata)(nil),     // 5: rdo.prototype.types.Metadata
-	(*BlockRequest)(nil), // 6: rdo.prototype.types.BlockRequest
-	(*Seed)(nil),         // 7: rdo.prototype.types.Seed
+	(*Block)(nil),            // 0: rdo.prototype.types.Block
+	(*AggregatedVotes)(nil),  // 1: rdo.prototype.types.AggregatedVotes
+	(*Sign)(nil),             // 2: rdo.prototype.types.Sign
+	(*Transaction)(nil),      // 3: rdo.prototype.types.Transaction
+	(*Receipt)(nil),          // 4: rdo.prototype.types.Receipt
+	(*OutputRef)(nil),        // 5: rdo.prototype.types.OutputRef
+	(*TxInput)(nil),          // 6: rdo.prototype.types.TxInput
+	(*TxOutput)(nil),         // 7: rdo.prototype.types.TxOutput
+	(*Metadata)(nil),         // 8: rdo.prototype.types.Metadata
+	(*BlockRequest)(nil),     // 9: rdo.prototype.types.BlockRequest
+	(*Seed)(nil),             // 10: rdo.prototype.types.Seed
+	(*CheckpointVote)(nil),   // 11: rdo.prototype.types.CheckpointVote
+	(*SignedHeader)(nil),     // 12: rdo.prototype.types.SignedHeader
+	(*SlashingEvidence)(nil), // 13: rdo.prototype.types.SlashingEvidence
 }
 var file_prototype_types_proto_depIdxs = []int32{
-	1, // 0: rdo.prototype.types.Block.proposer:type_name -> rdo.prototype.types.Sign
-	1, // 1: rdo.prototype.types.Block.approvers:type_name -> rdo.prototype.types.Sign
-	1, // 2: rdo.prototype.types.Block.slashers:type_name -> rdo.prototype.types.Sign
-	2, // 3: rdo.prototype.types.Block.transactions:type_name -> rdo.prototype.types.Transaction
-	3, // 4: rdo.prototype.types.Transaction.inputs:type_name -> rdo.prototype.types.TxInput
-	4, // 5: rdo.prototype.types.Transaction.outputs:type_name -> rdo.prototype.types.TxOutput
-	1, // 6: rdo.prototype.types.Seed.proposer:type_name -> rdo.prototype.types.Sign
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2,  // 0: rdo.prototype.types.Block.proposer:type_name -> rdo.prototype.types.Sign
+	2,  // 1: rdo.prototype.types.Block.approvers:type_name -> rdo.prototype.types.Sign
+	2,  // 2: rdo.prototype.types.Block.slashers:type_name -> rdo.prototype.types.Sign
+	3,  // 3: rdo.prototype.types.Block.transactions:type_name -> rdo.prototype.types.Transaction
+	10, // 4: rdo.prototype.types.Block.reveals:type_name -> rdo.prototype.types.Seed
+	1,  // 5: rdo.prototype.types.Block.approvals:type_name -> rdo.prototype.types.AggregatedVotes
+	1,  // 6: rdo.prototype.types.Block.rejections:type_name -> rdo.prototype.types.AggregatedVotes
+	11, // 7: rdo.prototype.types.Block.checkpoint_votes:type_name -> rdo.prototype.types.CheckpointVote
+	6,  // 8: rdo.prototype.types.Transaction.inputs:type_name -> rdo.prototype.types.TxInput
+	7,  // 9: rdo.prototype.types.Transaction.outputs:type_name -> rdo.prototype.types.TxOutput
+	5,  // 10: rdo.prototype.types.Receipt.created:type_name -> rdo.prototype.types.OutputRef
+	5,  // 11: rdo.prototype.types.Receipt.spent:type_name -> rdo.prototype.types.OutputRef
+	2,  // 12: rdo.prototype.types.Seed.proposer:type_name -> rdo.prototype.types.Sign
+	2,  // 13: rdo.prototype.types.CheckpointVote.voter:type_name -> rdo.prototype.types.Sign
+	2,  // 14: rdo.prototype.types.SignedHeader.signature:type_name -> rdo.prototype.types.Sign
+	12, // 15: rdo.prototype.types.SlashingEvidence.first:type_name -> rdo.prototype.types.SignedHeader
+	12, // 16: rdo.prototype.types.SlashingEvidence.second:type_name -> rdo.prototype.types.SignedHeader
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_prototype_types_proto_init() }
@@ -818,7 +1537,7 @@ func file_prototype_types_proto_init() {
 			}
 		}
 		file_prototype_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sign); i {
+			switch v := v.(*AggregatedVotes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -830,7 +1549,7 @@ func file_prototype_types_proto_init() {
 			}
 		}
 		file_prototype_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Transaction); i {
+			switch v := v.(*Sign); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -842,7 +1561,7 @@ func file_prototype_types_proto_init() {
 			}
 		}
 		file_prototype_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxInput); i {
+			switch v := v.(*Transaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -854,7 +1573,7 @@ func file_prototype_types_proto_init() {
 			}
 		}
 		file_prototype_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxOutput); i {
+			switch v := v.(*Receipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -866,7 +1585,7 @@ func file_prototype_types_proto_init() {
 			}
 		}
 		file_prototype_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Metadata); i {
+			switch v := v.(*OutputRef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -878,7 +1597,7 @@ func file_prototype_types_proto_init() {
 			}
 		}
 		file_prototype_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockRequest); i {
+			switch v := v.(*TxInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -890,6 +1609,42 @@ func file_prototype_types_proto_init() {
 			}
 		}
 		file_prototype_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Seed); i {
 			case 0:
 				return &v.state
@@ -901,6 +1656,42 @@ func file_prototype_types_proto_init() {
 				return nil
 			}
 		}
+		file_prototype_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prototype_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -908,7 +1699,7 @@ func file_prototype_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_prototype_types_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
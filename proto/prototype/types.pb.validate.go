@@ -201,6 +201,136 @@ func (m *Block) validate(all bool) error {
 
 	}
 
+	// no validation rules for Seed
+
+	for idx, item := range m.GetReveals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BlockValidationError{
+						field:  fmt.Sprintf("Reveals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BlockValidationError{
+						field:  fmt.Sprintf("Reveals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BlockValidationError{
+					field:  fmt.Sprintf("Reveals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetApprovals()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlockValidationError{
+					field:  "Approvals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlockValidationError{
+					field:  "Approvals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApprovals()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlockValidationError{
+				field:  "Approvals",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRejections()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlockValidationError{
+					field:  "Rejections",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlockValidationError{
+					field:  "Rejections",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRejections()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlockValidationError{
+				field:  "Rejections",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BaseFee
+
+	for idx, item := range m.GetCheckpointVotes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BlockValidationError{
+						field:  fmt.Sprintf("CheckpointVotes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BlockValidationError{
+						field:  fmt.Sprintf("CheckpointVotes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BlockValidationError{
+					field:  fmt.Sprintf("CheckpointVotes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return BlockMultiError(errors)
 	}
@@ -278,6 +408,108 @@ var _ interface {
 	ErrorName() string
 } = BlockValidationError{}
 
+// Validate checks the field values on AggregatedVotes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AggregatedVotes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AggregatedVotes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AggregatedVotesMultiError, or nil if none found.
+func (m *AggregatedVotes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AggregatedVotes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Bits
+
+	if len(errors) > 0 {
+		return AggregatedVotesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregatedVotesMultiError is an error wrapping multiple validation errors
+// returned by AggregatedVotes.ValidateAll() if the designated constraints
+// aren't met.
+type AggregatedVotesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AggregatedVotesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AggregatedVotesMultiError) AllErrors() []error { return m }
+
+// AggregatedVotesValidationError is the validation error returned by
+// AggregatedVotes.Validate if the designated constraints aren't met.
+type AggregatedVotesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregatedVotesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregatedVotesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregatedVotesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregatedVotesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregatedVotesValidationError) ErrorName() string { return "AggregatedVotesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AggregatedVotesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregatedVotes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregatedVotesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregatedVotesValidationError{}
+
 // Validate checks the field values on Sign with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
 // encountered is returned, or nil if there are no violations.
@@ -549,6 +781,12 @@ func (m *Transaction) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for Version
+
+	// no validation rules for ValidAfter
+
+	// no validation rules for ValidUntil
+
 	if len(errors) > 0 {
 		return TransactionMultiError(errors)
 	}
@@ -632,103 +870,139 @@ var _Transaction_Type_InLookup = map[uint32]struct{}{
 	6: {},
 }
 
-// Validate checks the field values on TxInput with the rules defined in the
+// Validate checks the field values on Receipt with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TxInput) Validate() error {
+func (m *Receipt) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxInput with the rules defined in the
+// ValidateAll checks the field values on Receipt with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TxInputMultiError, or nil if none found.
-func (m *TxInput) ValidateAll() error {
+// a list of violation errors wrapped in ReceiptMultiError, or nil if none found.
+func (m *Receipt) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxInput) validate(all bool) error {
+func (m *Receipt) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetHash()) != 32 {
-		err := TxInputValidationError{
-			field:  "Hash",
-			reason: "value length must be 32 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Index
+	// no validation rules for TxHash
 
-	if len(m.GetAddress()) != 20 {
-		err := TxInputValidationError{
-			field:  "Address",
-			reason: "value length must be 20 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for BlockHash
 
-	if m.GetAmount() <= 0 {
-		err := TxInputValidationError{
-			field:  "Amount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for BlockNum
 
-	if len(m.GetNode()) > 0 {
+	// no validation rules for Index
 
-		if len(m.GetNode()) != 20 {
-			err := TxInputValidationError{
-				field:  "Node",
-				reason: "value length must be 20 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Status
 
-	}
+	// no validation rules for Fee
 
-	if len(errors) > 0 {
-		return TxInputMultiError(errors)
-	}
+	// no validation rules for BurnedFee
 
-	return nil
-}
+	// no validation rules for Tip
 
-// TxInputMultiError is an error wrapping multiple validation errors returned
-// by TxInput.ValidateAll() if the designated constraints aren't met.
-type TxInputMultiError []error
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m TxInputMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiptValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiptValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiptValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSpent() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiptValidationError{
+						field:  fmt.Sprintf("Spent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiptValidationError{
+						field:  fmt.Sprintf("Spent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiptValidationError{
+					field:  fmt.Sprintf("Spent[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiptMultiError is an error wrapping multiple validation errors returned
+// by Receipt.ValidateAll() if the designated constraints aren't met.
+type ReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiptMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxInputMultiError) AllErrors() []error { return m }
+func (m ReceiptMultiError) AllErrors() []error { return m }
 
-// TxInputValidationError is the validation error returned by TxInput.Validate
+// ReceiptValidationError is the validation error returned by Receipt.Validate
 // if the designated constraints aren't met.
-type TxInputValidationError struct {
+type ReceiptValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -736,22 +1010,22 @@ type TxInputValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxInputValidationError) Field() string { return e.field }
+func (e ReceiptValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxInputValidationError) Reason() string { return e.reason }
+func (e ReceiptValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxInputValidationError) Cause() error { return e.cause }
+func (e ReceiptValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxInputValidationError) Key() bool { return e.key }
+func (e ReceiptValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxInputValidationError) ErrorName() string { return "TxInputValidationError" }
+func (e ReceiptValidationError) ErrorName() string { return "ReceiptValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TxInputValidationError) Error() string {
+func (e ReceiptValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -763,14 +1037,14 @@ func (e TxInputValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxInput.%s: %s%s",
+		"invalid %sReceipt.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxInputValidationError{}
+var _ error = ReceiptValidationError{}
 
 var _ interface {
 	Field() string
@@ -778,32 +1052,147 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxInputValidationError{}
+} = ReceiptValidationError{}
 
-// Validate checks the field values on TxOutput with the rules defined in the
+// Validate checks the field values on OutputRef with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TxOutput) Validate() error {
+func (m *OutputRef) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxOutput with the rules defined in
+// ValidateAll checks the field values on OutputRef with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TxOutputMultiError, or nil
+// result is a list of violation errors wrapped in OutputRefMultiError, or nil
 // if none found.
-func (m *TxOutput) ValidateAll() error {
+func (m *OutputRef) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxOutput) validate(all bool) error {
+func (m *OutputRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hash
+
+	// no validation rules for Index
+
+	if len(errors) > 0 {
+		return OutputRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutputRefMultiError is an error wrapping multiple validation errors returned
+// by OutputRef.ValidateAll() if the designated constraints aren't met.
+type OutputRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutputRefMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutputRefMultiError) AllErrors() []error { return m }
+
+// OutputRefValidationError is the validation error returned by
+// OutputRef.Validate if the designated constraints aren't met.
+type OutputRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutputRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutputRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutputRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutputRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutputRefValidationError) ErrorName() string { return "OutputRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutputRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutputRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutputRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutputRefValidationError{}
+
+// Validate checks the field values on TxInput with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TxInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxInput with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TxInputMultiError, or nil if none found.
+func (m *TxInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxInput) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if len(m.GetHash()) != 32 {
+		err := TxInputValidationError{
+			field:  "Hash",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Index
+
 	if len(m.GetAddress()) != 20 {
-		err := TxOutputValidationError{
+		err := TxInputValidationError{
 			field:  "Address",
 			reason: "value length must be 20 bytes",
 		}
@@ -814,7 +1203,7 @@ func (m *TxOutput) validate(all bool) error {
 	}
 
 	if m.GetAmount() <= 0 {
-		err := TxOutputValidationError{
+		err := TxInputValidationError{
 			field:  "Amount",
 			reason: "value must be greater than 0",
 		}
@@ -824,34 +1213,524 @@ func (m *TxOutput) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetNode()) > 0 {
+	if len(m.GetNode()) > 0 {
+
+		if len(m.GetNode()) != 20 {
+			err := TxInputValidationError{
+				field:  "Node",
+				reason: "value length must be 20 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TxInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxInputMultiError is an error wrapping multiple validation errors returned
+// by TxInput.ValidateAll() if the designated constraints aren't met.
+type TxInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxInputMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxInputMultiError) AllErrors() []error { return m }
+
+// TxInputValidationError is the validation error returned by TxInput.Validate
+// if the designated constraints aren't met.
+type TxInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxInputValidationError) ErrorName() string { return "TxInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxInputValidationError{}
+
+// Validate checks the field values on TxOutput with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TxOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TxOutputMultiError, or nil
+// if none found.
+func (m *TxOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetAddress()) != 20 {
+		err := TxOutputValidationError{
+			field:  "Address",
+			reason: "value length must be 20 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := TxOutputValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNode()) > 0 {
+
+		if len(m.GetNode()) != 20 {
+			err := TxOutputValidationError{
+				field:  "Node",
+				reason: "value length must be 20 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TxOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxOutputMultiError is an error wrapping multiple validation errors returned
+// by TxOutput.ValidateAll() if the designated constraints aren't met.
+type TxOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxOutputMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxOutputMultiError) AllErrors() []error { return m }
+
+// TxOutputValidationError is the validation error returned by
+// TxOutput.Validate if the designated constraints aren't met.
+type TxOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxOutputValidationError) ErrorName() string { return "TxOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxOutputValidationError{}
+
+// Validate checks the field values on Metadata with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Metadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Metadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetadataMultiError, or nil
+// if none found.
+func (m *Metadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Metadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HeadSlot
+
+	// no validation rules for HeadBlockNum
+
+	if len(m.GetHeadBlockHash()) != 32 {
+		err := MetadataValidationError{
+			field:  "HeadBlockHash",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetadataMultiError is an error wrapping multiple validation errors returned
+// by Metadata.ValidateAll() if the designated constraints aren't met.
+type MetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetadataMultiError) AllErrors() []error { return m }
+
+// MetadataValidationError is the validation error returned by
+// Metadata.Validate if the designated constraints aren't met.
+type MetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetadataValidationError) ErrorName() string { return "MetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetadataValidationError{}
+
+// Validate checks the field values on BlockRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockRequestMultiError, or
+// nil if none found.
+func (m *BlockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartSlot
+
+	// no validation rules for Count
+
+	// no validation rules for Step
+
+	if len(errors) > 0 {
+		return BlockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockRequest.ValidateAll() if the designated constraints aren't met.
+type BlockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockRequestMultiError) AllErrors() []error { return m }
+
+// BlockRequestValidationError is the validation error returned by
+// BlockRequest.Validate if the designated constraints aren't met.
+type BlockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockRequestValidationError) ErrorName() string { return "BlockRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockRequestValidationError{}
+
+// Validate checks the field values on Seed with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Seed) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Seed with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SeedMultiError, or nil if none found.
+func (m *Seed) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Seed) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
-		if len(m.GetNode()) != 20 {
-			err := TxOutputValidationError{
-				field:  "Node",
-				reason: "value length must be 20 bytes",
+	if all {
+		switch v := interface{}(m.GetProposer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeedValidationError{
+					field:  "Proposer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeedValidationError{
+					field:  "Proposer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProposer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeedValidationError{
+				field:  "Proposer",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
+	// no validation rules for Slot
+
+	// no validation rules for Epoch
+
+	// no validation rules for Commitment
+
+	// no validation rules for Reveal
+
 	if len(errors) > 0 {
-		return TxOutputMultiError(errors)
+		return SeedMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxOutputMultiError is an error wrapping multiple validation errors returned
-// by TxOutput.ValidateAll() if the designated constraints aren't met.
-type TxOutputMultiError []error
+// SeedMultiError is an error wrapping multiple validation errors returned by
+// Seed.ValidateAll() if the designated constraints aren't met.
+type SeedMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxOutputMultiError) Error() string {
+func (m SeedMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -860,11 +1739,11 @@ func (m TxOutputMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxOutputMultiError) AllErrors() []error { return m }
+func (m SeedMultiError) AllErrors() []error { return m }
 
-// TxOutputValidationError is the validation error returned by
-// TxOutput.Validate if the designated constraints aren't met.
-type TxOutputValidationError struct {
+// SeedValidationError is the validation error returned by Seed.Validate if the
+// designated constraints aren't met.
+type SeedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -872,22 +1751,22 @@ type TxOutputValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxOutputValidationError) Field() string { return e.field }
+func (e SeedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxOutputValidationError) Reason() string { return e.reason }
+func (e SeedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxOutputValidationError) Cause() error { return e.cause }
+func (e SeedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxOutputValidationError) Key() bool { return e.key }
+func (e SeedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxOutputValidationError) ErrorName() string { return "TxOutputValidationError" }
+func (e SeedValidationError) ErrorName() string { return "SeedValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TxOutputValidationError) Error() string {
+func (e SeedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -899,14 +1778,14 @@ func (e TxOutputValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxOutput.%s: %s%s",
+		"invalid %sSeed.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxOutputValidationError{}
+var _ error = SeedValidationError{}
 
 var _ interface {
 	Field() string
@@ -914,58 +1793,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxOutputValidationError{}
+} = SeedValidationError{}
 
-// Validate checks the field values on Metadata with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on CheckpointVote with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Metadata) Validate() error {
+func (m *CheckpointVote) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Metadata with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MetadataMultiError, or nil
-// if none found.
-func (m *Metadata) ValidateAll() error {
+// ValidateAll checks the field values on CheckpointVote with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckpointVoteMultiError,
+// or nil if none found.
+func (m *CheckpointVote) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Metadata) validate(all bool) error {
+func (m *CheckpointVote) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HeadSlot
+	// no validation rules for SourceEpoch
 
-	// no validation rules for HeadBlockNum
+	// no validation rules for SourceHash
 
-	if len(m.GetHeadBlockHash()) != 32 {
-		err := MetadataValidationError{
-			field:  "HeadBlockHash",
-			reason: "value length must be 32 bytes",
+	// no validation rules for TargetEpoch
+
+	// no validation rules for TargetHash
+
+	if all {
+		switch v := interface{}(m.GetVoter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckpointVoteValidationError{
+					field:  "Voter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckpointVoteValidationError{
+					field:  "Voter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetVoter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckpointVoteValidationError{
+				field:  "Voter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return MetadataMultiError(errors)
+		return CheckpointVoteMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetadataMultiError is an error wrapping multiple validation errors returned
-// by Metadata.ValidateAll() if the designated constraints aren't met.
-type MetadataMultiError []error
+// CheckpointVoteMultiError is an error wrapping multiple validation errors
+// returned by CheckpointVote.ValidateAll() if the designated constraints
+// aren't met.
+type CheckpointVoteMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetadataMultiError) Error() string {
+func (m CheckpointVoteMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -974,11 +1876,11 @@ func (m MetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetadataMultiError) AllErrors() []error { return m }
+func (m CheckpointVoteMultiError) AllErrors() []error { return m }
 
-// MetadataValidationError is the validation error returned by
-// Metadata.Validate if the designated constraints aren't met.
-type MetadataValidationError struct {
+// CheckpointVoteValidationError is the validation error returned by
+// CheckpointVote.Validate if the designated constraints aren't met.
+type CheckpointVoteValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -986,22 +1888,22 @@ type MetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetadataValidationError) Field() string { return e.field }
+func (e CheckpointVoteValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetadataValidationError) Reason() string { return e.reason }
+func (e CheckpointVoteValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetadataValidationError) Cause() error { return e.cause }
+func (e CheckpointVoteValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetadataValidationError) Key() bool { return e.key }
+func (e CheckpointVoteValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetadataValidationError) ErrorName() string { return "MetadataValidationError" }
+func (e CheckpointVoteValidationError) ErrorName() string { return "CheckpointVoteValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetadataValidationError) Error() string {
+func (e CheckpointVoteValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1013,14 +1915,14 @@ func (e MetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetadata.%s: %s%s",
+		"invalid %sCheckpointVote.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetadataValidationError{}
+var _ error = CheckpointVoteValidationError{}
 
 var _ interface {
 	Field() string
@@ -1028,49 +1930,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetadataValidationError{}
+} = CheckpointVoteValidationError{}
 
-// Validate checks the field values on BlockRequest with the rules defined in
+// Validate checks the field values on SignedHeader with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *BlockRequest) Validate() error {
+func (m *SignedHeader) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BlockRequest with the rules defined
+// ValidateAll checks the field values on SignedHeader with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BlockRequestMultiError, or
+// result is a list of violation errors wrapped in SignedHeaderMultiError, or
 // nil if none found.
-func (m *BlockRequest) ValidateAll() error {
+func (m *SignedHeader) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BlockRequest) validate(all bool) error {
+func (m *SignedHeader) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for StartSlot
+	// no validation rules for Num
 
-	// no validation rules for Count
+	// no validation rules for Slot
 
-	// no validation rules for Step
+	// no validation rules for Version
+
+	// no validation rules for Parent
+
+	// no validation rules for Txroot
+
+	// no validation rules for Seed
+
+	// no validation rules for Vote
+
+	if all {
+		switch v := interface{}(m.GetSignature()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SignedHeaderValidationError{
+					field:  "Signature",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SignedHeaderValidationError{
+					field:  "Signature",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSignature()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SignedHeaderValidationError{
+				field:  "Signature",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CheckpointRoot
 
 	if len(errors) > 0 {
-		return BlockRequestMultiError(errors)
+		return SignedHeaderMultiError(errors)
 	}
 
 	return nil
 }
 
-// BlockRequestMultiError is an error wrapping multiple validation errors
-// returned by BlockRequest.ValidateAll() if the designated constraints aren't met.
-type BlockRequestMultiError []error
+// SignedHeaderMultiError is an error wrapping multiple validation errors
+// returned by SignedHeader.ValidateAll() if the designated constraints aren't met.
+type SignedHeaderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BlockRequestMultiError) Error() string {
+func (m SignedHeaderMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1079,11 +2020,11 @@ func (m BlockRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BlockRequestMultiError) AllErrors() []error { return m }
+func (m SignedHeaderMultiError) AllErrors() []error { return m }
 
-// BlockRequestValidationError is the validation error returned by
-// BlockRequest.Validate if the designated constraints aren't met.
-type BlockRequestValidationError struct {
+// SignedHeaderValidationError is the validation error returned by
+// SignedHeader.Validate if the designated constraints aren't met.
+type SignedHeaderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1091,22 +2032,22 @@ type BlockRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BlockRequestValidationError) Field() string { return e.field }
+func (e SignedHeaderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BlockRequestValidationError) Reason() string { return e.reason }
+func (e SignedHeaderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BlockRequestValidationError) Cause() error { return e.cause }
+func (e SignedHeaderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BlockRequestValidationError) Key() bool { return e.key }
+func (e SignedHeaderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BlockRequestValidationError) ErrorName() string { return "BlockRequestValidationError" }
+func (e SignedHeaderValidationError) ErrorName() string { return "SignedHeaderValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BlockRequestValidationError) Error() string {
+func (e SignedHeaderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1118,14 +2059,14 @@ func (e BlockRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBlockRequest.%s: %s%s",
+		"invalid %sSignedHeader.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BlockRequestValidationError{}
+var _ error = SignedHeaderValidationError{}
 
 var _ interface {
 	Field() string
@@ -1133,54 +2074,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BlockRequestValidationError{}
+} = SignedHeaderValidationError{}
 
-// Validate checks the field values on Seed with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Seed) Validate() error {
+// Validate checks the field values on SlashingEvidence with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SlashingEvidence) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Seed with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in SeedMultiError, or nil if none found.
-func (m *Seed) ValidateAll() error {
+// ValidateAll checks the field values on SlashingEvidence with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SlashingEvidenceMultiError, or nil if none found.
+func (m *SlashingEvidence) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Seed) validate(all bool) error {
+func (m *SlashingEvidence) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Seed
+	// no validation rules for Type
 
 	if all {
-		switch v := interface{}(m.GetProposer()).(type) {
+		switch v := interface{}(m.GetFirst()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SeedValidationError{
-					field:  "Proposer",
+				errors = append(errors, SlashingEvidenceValidationError{
+					field:  "First",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SeedValidationError{
-					field:  "Proposer",
+				errors = append(errors, SlashingEvidenceValidationError{
+					field:  "First",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProposer()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFirst()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SeedValidationError{
-				field:  "Proposer",
+			return SlashingEvidenceValidationError{
+				field:  "First",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecond()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SlashingEvidenceValidationError{
+					field:  "Second",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SlashingEvidenceValidationError{
+					field:  "Second",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecond()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SlashingEvidenceValidationError{
+				field:  "Second",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1188,18 +2159,19 @@ func (m *Seed) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SeedMultiError(errors)
+		return SlashingEvidenceMultiError(errors)
 	}
 
 	return nil
 }
 
-// SeedMultiError is an error wrapping multiple validation errors returned by
-// Seed.ValidateAll() if the designated constraints aren't met.
-type SeedMultiError []error
+// SlashingEvidenceMultiError is an error wrapping multiple validation errors
+// returned by SlashingEvidence.ValidateAll() if the designated constraints
+// aren't met.
+type SlashingEvidenceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SeedMultiError) Error() string {
+func (m SlashingEvidenceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1208,11 +2180,11 @@ func (m SeedMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SeedMultiError) AllErrors() []error { return m }
+func (m SlashingEvidenceMultiError) AllErrors() []error { return m }
 
-// SeedValidationError is the validation error returned by Seed.Validate if the
-// designated constraints aren't met.
-type SeedValidationError struct {
+// SlashingEvidenceValidationError is the validation error returned by
+// SlashingEvidence.Validate if the designated constraints aren't met.
+type SlashingEvidenceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1220,22 +2192,22 @@ type SeedValidationError struct {
 }
 
 // Field function returns field value.
-func (e SeedValidationError) Field() string { return e.field }
+func (e SlashingEvidenceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SeedValidationError) Reason() string { return e.reason }
+func (e SlashingEvidenceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SeedValidationError) Cause() error { return e.cause }
+func (e SlashingEvidenceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SeedValidationError) Key() bool { return e.key }
+func (e SlashingEvidenceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SeedValidationError) ErrorName() string { return "SeedValidationError" }
+func (e SlashingEvidenceValidationError) ErrorName() string { return "SlashingEvidenceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SeedValidationError) Error() string {
+func (e SlashingEvidenceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1247,14 +2219,14 @@ func (e SeedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSeed.%s: %s%s",
+		"invalid %sSlashingEvidence.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SeedValidationError{}
+var _ error = SlashingEvidenceValidationError{}
 
 var _ interface {
 	Field() string
@@ -1262,4 +2234,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SeedValidationError{}
+} = SlashingEvidenceValidationError{}
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 19be7fadf9336fcbcb8a86e1308f8a8744abf6f164f54d4569c3877207604b29
+// Hash: bfebc365860edf6d66460b2ca71e83299076cdeb5d8264d0f2fecd22c66f6223
 // Version: 0.1.3-dev
 package prototype
 
@@ -15,7 +15,7 @@ func (b *Block) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the Block object to a target array
 func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(220)
+	offset := int(248)
 
 	// Field (0) 'Num'
 	dst = ssz.MarshalUint64(dst, b.Num)
@@ -77,6 +77,35 @@ func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		offset += b.Transactions[ii].SizeSSZ()
 	}
 
+	// Offset (11) 'Seed'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Seed)
+
+	// Offset (12) 'Reveals'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Reveals) * 165
+
+	// Offset (13) 'Approvals'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Approvals == nil {
+		b.Approvals = new(AggregatedVotes)
+	}
+	offset += b.Approvals.SizeSSZ()
+
+	// Offset (14) 'Rejections'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Rejections == nil {
+		b.Rejections = new(AggregatedVotes)
+	}
+	offset += b.Rejections.SizeSSZ()
+
+	// Field (15) 'BaseFee'
+	dst = ssz.MarshalUint64(dst, b.BaseFee)
+
+	// Offset (16) 'CheckpointVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.CheckpointVotes) * 165
+
 	// Field (8) 'Approvers'
 	if size := len(b.Approvers); size > 128 {
 		err = ssz.ErrListTooBigFn("Block.Approvers", size, 128)
@@ -117,6 +146,45 @@ func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
+	// Field (11) 'Seed'
+	if size := len(b.Seed); size > 32 {
+		err = ssz.ErrBytesLengthFn("Block.Seed", size, 32)
+		return
+	}
+	dst = append(dst, b.Seed...)
+
+	// Field (12) 'Reveals'
+	if size := len(b.Reveals); size > 128 {
+		err = ssz.ErrListTooBigFn("Block.Reveals", size, 128)
+		return
+	}
+	for ii := 0; ii < len(b.Reveals); ii++ {
+		if dst, err = b.Reveals[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (13) 'Approvals'
+	if dst, err = b.Approvals.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (14) 'Rejections'
+	if dst, err = b.Rejections.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (16) 'CheckpointVotes'
+	if size := len(b.CheckpointVotes); size > 128 {
+		err = ssz.ErrListTooBigFn("Block.CheckpointVotes", size, 128)
+		return
+	}
+	for ii := 0; ii < len(b.CheckpointVotes); ii++ {
+		if dst, err = b.CheckpointVotes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
@@ -124,12 +192,12 @@ func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (b *Block) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 220 {
+	if size < 248 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o8, o9, o10 uint64
+	var o8, o9, o10, o11, o12, o13, o14, o16 uint64
 
 	// Field (0) 'Num'
 	b.Num = ssz.UnmarshallUint64(buf[0:8])
@@ -177,7 +245,7 @@ func (b *Block) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o8 < 220 {
+	if o8 < 248 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -191,6 +259,34 @@ func (b *Block) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (11) 'Seed'
+	if o11 = ssz.ReadOffset(buf[220:224]); o11 > size || o10 > o11 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (12) 'Reveals'
+	if o12 = ssz.ReadOffset(buf[224:228]); o12 > size || o11 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (13) 'Approvals'
+	if o13 = ssz.ReadOffset(buf[228:232]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (14) 'Rejections'
+	if o14 = ssz.ReadOffset(buf[232:236]); o14 > size || o13 > o14 {
+		return ssz.ErrOffset
+	}
+
+	// Field (15) 'BaseFee'
+	b.BaseFee = ssz.UnmarshallUint64(buf[236:244])
+
+	// Offset (16) 'CheckpointVotes'
+	if o16 = ssz.ReadOffset(buf[244:248]); o16 > size || o14 > o16 {
+		return ssz.ErrOffset
+	}
+
 	// Field (8) 'Approvers'
 	{
 		buf = tail[o8:o9]
@@ -229,7 +325,7 @@ func (b *Block) UnmarshalSSZ(buf []byte) error {
 
 	// Field (10) 'Transactions'
 	{
-		buf = tail[o10:]
+		buf = tail[o10:o11]
 		num, err := ssz.DecodeDynamicLength(buf, 1500)
 		if err != nil {
 			return err
@@ -248,12 +344,82 @@ func (b *Block) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 	}
+
+	// Field (11) 'Seed'
+	{
+		buf = tail[o11:o12]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.Seed) == 0 {
+			b.Seed = make([]byte, 0, len(buf))
+		}
+		b.Seed = append(b.Seed, buf...)
+	}
+
+	// Field (12) 'Reveals'
+	{
+		buf = tail[o12:o13]
+		num, err := ssz.DivideInt2(len(buf), 165, 128)
+		if err != nil {
+			return err
+		}
+		b.Reveals = make([]*Seed, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Reveals[ii] == nil {
+				b.Reveals[ii] = new(Seed)
+			}
+			if err = b.Reveals[ii].UnmarshalSSZ(buf[ii*165 : (ii+1)*165]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (13) 'Approvals'
+	{
+		buf = tail[o13:o14]
+		if b.Approvals == nil {
+			b.Approvals = new(AggregatedVotes)
+		}
+		if err = b.Approvals.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (14) 'Rejections'
+	{
+		buf = tail[o14:o16]
+		if b.Rejections == nil {
+			b.Rejections = new(AggregatedVotes)
+		}
+		if err = b.Rejections.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (16) 'CheckpointVotes'
+	{
+		buf = tail[o16:]
+		num, err := ssz.DivideInt2(len(buf), 165, 128)
+		if err != nil {
+			return err
+		}
+		b.CheckpointVotes = make([]*CheckpointVote, num)
+		for ii := 0; ii < num; ii++ {
+			if b.CheckpointVotes[ii] == nil {
+				b.CheckpointVotes[ii] = new(CheckpointVote)
+			}
+			if err = b.CheckpointVotes[ii].UnmarshalSSZ(buf[ii*165 : (ii+1)*165]); err != nil {
+				return err
+			}
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the Block object
 func (b *Block) SizeSSZ() (size int) {
-	size = 220
+	size = 248
 
 	// Field (8) 'Approvers'
 	size += len(b.Approvers) * 85
@@ -267,6 +433,27 @@ func (b *Block) SizeSSZ() (size int) {
 		size += b.Transactions[ii].SizeSSZ()
 	}
 
+	// Field (11) 'Seed'
+	size += len(b.Seed)
+
+	// Field (12) 'Reveals'
+	size += len(b.Reveals) * 165
+
+	// Field (13) 'Approvals'
+	if b.Approvals == nil {
+		b.Approvals = new(AggregatedVotes)
+	}
+	size += b.Approvals.SizeSSZ()
+
+	// Field (14) 'Rejections'
+	if b.Rejections == nil {
+		b.Rejections = new(AggregatedVotes)
+	}
+	size += b.Rejections.SizeSSZ()
+
+	// Field (16) 'CheckpointVotes'
+	size += len(b.CheckpointVotes) * 165
+
 	return
 }
 
@@ -372,6 +559,63 @@ func (b *Block) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 1500)
 	}
 
+	// Field (11) 'Seed'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.Seed))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.Seed)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (12) 'Reveals'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Reveals))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Reveals {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 128)
+	}
+
+	// Field (13) 'Approvals'
+	if err = b.Approvals.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (14) 'Rejections'
+	if err = b.Rejections.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (15) 'BaseFee'
+	hh.PutUint64(b.BaseFee)
+
+	// Field (16) 'CheckpointVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.CheckpointVotes))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.CheckpointVotes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 128)
+	}
+
 	hh.Merkleize(indx)
 	return
 }
@@ -381,6 +625,163 @@ func (b *Block) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// MarshalSSZ ssz marshals the AggregatedVotes object
+func (a *AggregatedVotes) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AggregatedVotes object to a target array
+func (a *AggregatedVotes) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Bits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(a.Bits)
+
+	// Offset (1) 'Signatures'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(a.Signatures) * 65
+
+	// Field (0) 'Bits'
+	if size := len(a.Bits); size > 16 {
+		err = ssz.ErrBytesLengthFn("AggregatedVotes.Bits", size, 16)
+		return
+	}
+	dst = append(dst, a.Bits...)
+
+	// Field (1) 'Signatures'
+	if size := len(a.Signatures); size > 128 {
+		err = ssz.ErrListTooBigFn("AggregatedVotes.Signatures", size, 128)
+		return
+	}
+	for ii := 0; ii < len(a.Signatures); ii++ {
+		if size := len(a.Signatures[ii]); size != 65 {
+			err = ssz.ErrBytesLengthFn("AggregatedVotes.Signatures[ii]", size, 65)
+			return
+		}
+		dst = append(dst, a.Signatures[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AggregatedVotes object
+func (a *AggregatedVotes) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Bits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signatures'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Bits'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 16 {
+			return ssz.ErrBytesLength
+		}
+		if cap(a.Bits) == 0 {
+			a.Bits = make([]byte, 0, len(buf))
+		}
+		a.Bits = append(a.Bits, buf...)
+	}
+
+	// Field (1) 'Signatures'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 65, 128)
+		if err != nil {
+			return err
+		}
+		a.Signatures = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(a.Signatures[ii]) == 0 {
+				a.Signatures[ii] = make([]byte, 0, len(buf[ii*65:(ii+1)*65]))
+			}
+			a.Signatures[ii] = append(a.Signatures[ii], buf[ii*65:(ii+1)*65]...)
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AggregatedVotes object
+func (a *AggregatedVotes) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Bits'
+	size += len(a.Bits)
+
+	// Field (1) 'Signatures'
+	size += len(a.Signatures) * 65
+
+	return
+}
+
+// HashTreeRoot ssz hashes the AggregatedVotes object
+func (a *AggregatedVotes) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AggregatedVotes object with a hasher
+func (a *AggregatedVotes) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Bits'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(a.Bits))
+		if byteLen > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(a.Bits)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (16+31)/32)
+	}
+
+	// Field (1) 'Signatures'
+	{
+		if size := len(a.Signatures); size > 128 {
+			err = ssz.ErrListTooBigFn("AggregatedVotes.Signatures", size, 128)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range a.Signatures {
+			if len(i) != 65 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		numItems := uint64(len(a.Signatures))
+		hh.MerkleizeWithMixin(subIndx, numItems, 128)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the AggregatedVotes object
+func (a *AggregatedVotes) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(a)
+}
+
 // MarshalSSZ ssz marshals the Sign object
 func (s *Sign) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -476,7 +877,7 @@ func (t *Transaction) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the Transaction object to a target array
 func (t *Transaction) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(141)
+	offset := int(161)
 
 	// Field (0) 'Num'
 	dst = ssz.MarshalUint64(dst, t.Num)
@@ -525,6 +926,15 @@ func (t *Transaction) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	// Field (9) 'Status'
 	dst = ssz.MarshalUint32(dst, t.Status)
 
+	// Field (10) 'Version'
+	dst = ssz.MarshalUint32(dst, t.Version)
+
+	// Field (11) 'ValidAfter'
+	dst = ssz.MarshalUint64(dst, t.ValidAfter)
+
+	// Field (12) 'ValidUntil'
+	dst = ssz.MarshalUint64(dst, t.ValidUntil)
+
 	// Field (5) 'Data'
 	if size := len(t.Data); size > 1000000 {
 		err = ssz.ErrBytesLengthFn("Transaction.Data", size, 1000000)
@@ -575,7 +985,7 @@ func (t *Transaction) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (t *Transaction) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 141 {
+	if size < 161 {
 		return ssz.ErrSize
 	}
 
@@ -605,7 +1015,7 @@ func (t *Transaction) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o5 < 141 {
+	if o5 < 161 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -628,6 +1038,15 @@ func (t *Transaction) UnmarshalSSZ(buf []byte) error {
 	// Field (9) 'Status'
 	t.Status = ssz.UnmarshallUint32(buf[137:141])
 
+	// Field (10) 'Version'
+	t.Version = ssz.UnmarshallUint32(buf[141:145])
+
+	// Field (11) 'ValidAfter'
+	t.ValidAfter = ssz.UnmarshallUint64(buf[145:153])
+
+	// Field (12) 'ValidUntil'
+	t.ValidUntil = ssz.UnmarshallUint64(buf[153:161])
+
 	// Field (5) 'Data'
 	{
 		buf = tail[o5:o6]
@@ -688,7 +1107,7 @@ func (t *Transaction) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the Transaction object
 func (t *Transaction) SizeSSZ() (size int) {
-	size = 141
+	size = 161
 
 	// Field (5) 'Data'
 	size += len(t.Data)
@@ -790,6 +1209,15 @@ func (t *Transaction) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (9) 'Status'
 	hh.PutUint32(t.Status)
 
+	// Field (10) 'Version'
+	hh.PutUint32(t.Version)
+
+	// Field (11) 'ValidAfter'
+	hh.PutUint64(t.ValidAfter)
+
+	// Field (12) 'ValidUntil'
+	hh.PutUint64(t.ValidUntil)
+
 	hh.Merkleize(indx)
 	return
 }
@@ -799,41 +1227,423 @@ func (t *Transaction) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(t)
 }
 
-// MarshalSSZ ssz marshals the TxInput object
-func (t *TxInput) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(t)
+// MarshalSSZ ssz marshals the Receipt object
+func (r *Receipt) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
 }
 
-// MarshalSSZTo ssz marshals the TxInput object to a target array
-func (t *TxInput) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the Receipt object to a target array
+func (r *Receipt) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(68)
+	offset := int(116)
 
-	// Field (0) 'Hash'
-	if size := len(t.Hash); size != 32 {
-		err = ssz.ErrBytesLengthFn("TxInput.Hash", size, 32)
+	// Field (0) 'TxHash'
+	if size := len(r.TxHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Receipt.TxHash", size, 32)
 		return
 	}
-	dst = append(dst, t.Hash...)
-
-	// Field (1) 'Index'
-	dst = ssz.MarshalUint32(dst, t.Index)
+	dst = append(dst, r.TxHash...)
 
-	// Field (2) 'Address'
-	if size := len(t.Address); size != 20 {
-		err = ssz.ErrBytesLengthFn("TxInput.Address", size, 20)
+	// Field (1) 'BlockHash'
+	if size := len(r.BlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Receipt.BlockHash", size, 32)
 		return
 	}
-	dst = append(dst, t.Address...)
+	dst = append(dst, r.BlockHash...)
 
-	// Field (3) 'Amount'
-	dst = ssz.MarshalUint64(dst, t.Amount)
+	// Field (2) 'BlockNum'
+	dst = ssz.MarshalUint64(dst, r.BlockNum)
 
-	// Offset (4) 'Node'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(t.Node)
+	// Field (3) 'Index'
+	dst = ssz.MarshalUint32(dst, r.Index)
 
-	// Field (4) 'Node'
+	// Field (4) 'Status'
+	dst = ssz.MarshalUint32(dst, r.Status)
+
+	// Field (5) 'Fee'
+	dst = ssz.MarshalUint64(dst, r.Fee)
+
+	// Field (6) 'BurnedFee'
+	dst = ssz.MarshalUint64(dst, r.BurnedFee)
+
+	// Field (7) 'Tip'
+	dst = ssz.MarshalUint64(dst, r.Tip)
+
+	// Offset (8) 'Created'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.Created) * 36
+
+	// Offset (9) 'Spent'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.Spent) * 36
+
+	// Offset (10) 'Reason'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.Reason)
+
+	// Field (8) 'Created'
+	if size := len(r.Created); size > 2000 {
+		err = ssz.ErrListTooBigFn("Receipt.Created", size, 2000)
+		return
+	}
+	for ii := 0; ii < len(r.Created); ii++ {
+		if dst, err = r.Created[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'Spent'
+	if size := len(r.Spent); size > 2000 {
+		err = ssz.ErrListTooBigFn("Receipt.Spent", size, 2000)
+		return
+	}
+	for ii := 0; ii < len(r.Spent); ii++ {
+		if dst, err = r.Spent[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (10) 'Reason'
+	if size := len(r.Reason); size > 256 {
+		err = ssz.ErrBytesLengthFn("Receipt.Reason", size, 256)
+		return
+	}
+	dst = append(dst, r.Reason...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Receipt object
+func (r *Receipt) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 116 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o8, o9, o10 uint64
+
+	// Field (0) 'TxHash'
+	if cap(r.TxHash) == 0 {
+		r.TxHash = make([]byte, 0, len(buf[0:32]))
+	}
+	r.TxHash = append(r.TxHash, buf[0:32]...)
+
+	// Field (1) 'BlockHash'
+	if cap(r.BlockHash) == 0 {
+		r.BlockHash = make([]byte, 0, len(buf[32:64]))
+	}
+	r.BlockHash = append(r.BlockHash, buf[32:64]...)
+
+	// Field (2) 'BlockNum'
+	r.BlockNum = ssz.UnmarshallUint64(buf[64:72])
+
+	// Field (3) 'Index'
+	r.Index = ssz.UnmarshallUint32(buf[72:76])
+
+	// Field (4) 'Status'
+	r.Status = ssz.UnmarshallUint32(buf[76:80])
+
+	// Field (5) 'Fee'
+	r.Fee = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (6) 'BurnedFee'
+	r.BurnedFee = ssz.UnmarshallUint64(buf[88:96])
+
+	// Field (7) 'Tip'
+	r.Tip = ssz.UnmarshallUint64(buf[96:104])
+
+	// Offset (8) 'Created'
+	if o8 = ssz.ReadOffset(buf[104:108]); o8 > size {
+		return ssz.ErrOffset
+	}
+
+	if o8 < 116 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (9) 'Spent'
+	if o9 = ssz.ReadOffset(buf[108:112]); o9 > size || o8 > o9 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (10) 'Reason'
+	if o10 = ssz.ReadOffset(buf[112:116]); o10 > size || o9 > o10 {
+		return ssz.ErrOffset
+	}
+
+	// Field (8) 'Created'
+	{
+		buf = tail[o8:o9]
+		num, err := ssz.DivideInt2(len(buf), 36, 2000)
+		if err != nil {
+			return err
+		}
+		r.Created = make([]*OutputRef, num)
+		for ii := 0; ii < num; ii++ {
+			if r.Created[ii] == nil {
+				r.Created[ii] = new(OutputRef)
+			}
+			if err = r.Created[ii].UnmarshalSSZ(buf[ii*36 : (ii+1)*36]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (9) 'Spent'
+	{
+		buf = tail[o9:o10]
+		num, err := ssz.DivideInt2(len(buf), 36, 2000)
+		if err != nil {
+			return err
+		}
+		r.Spent = make([]*OutputRef, num)
+		for ii := 0; ii < num; ii++ {
+			if r.Spent[ii] == nil {
+				r.Spent[ii] = new(OutputRef)
+			}
+			if err = r.Spent[ii].UnmarshalSSZ(buf[ii*36 : (ii+1)*36]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (10) 'Reason'
+	{
+		buf = tail[o10:]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.Reason) == 0 {
+			r.Reason = make([]byte, 0, len(buf))
+		}
+		r.Reason = append(r.Reason, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Receipt object
+func (r *Receipt) SizeSSZ() (size int) {
+	size = 116
+
+	// Field (8) 'Created'
+	size += len(r.Created) * 36
+
+	// Field (9) 'Spent'
+	size += len(r.Spent) * 36
+
+	// Field (10) 'Reason'
+	size += len(r.Reason)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Receipt object
+func (r *Receipt) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the Receipt object with a hasher
+func (r *Receipt) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'TxHash'
+	if size := len(r.TxHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Receipt.TxHash", size, 32)
+		return
+	}
+	hh.PutBytes(r.TxHash)
+
+	// Field (1) 'BlockHash'
+	if size := len(r.BlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Receipt.BlockHash", size, 32)
+		return
+	}
+	hh.PutBytes(r.BlockHash)
+
+	// Field (2) 'BlockNum'
+	hh.PutUint64(r.BlockNum)
+
+	// Field (3) 'Index'
+	hh.PutUint32(r.Index)
+
+	// Field (4) 'Status'
+	hh.PutUint32(r.Status)
+
+	// Field (5) 'Fee'
+	hh.PutUint64(r.Fee)
+
+	// Field (6) 'BurnedFee'
+	hh.PutUint64(r.BurnedFee)
+
+	// Field (7) 'Tip'
+	hh.PutUint64(r.Tip)
+
+	// Field (8) 'Created'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.Created))
+		if num > 2000 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.Created {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2000)
+	}
+
+	// Field (9) 'Spent'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.Spent))
+		if num > 2000 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.Spent {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2000)
+	}
+
+	// Field (10) 'Reason'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.Reason))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.Reason)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Receipt object
+func (r *Receipt) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the OutputRef object
+func (o *OutputRef) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+// MarshalSSZTo ssz marshals the OutputRef object to a target array
+func (o *OutputRef) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Hash'
+	if size := len(o.Hash); size != 32 {
+		err = ssz.ErrBytesLengthFn("OutputRef.Hash", size, 32)
+		return
+	}
+	dst = append(dst, o.Hash...)
+
+	// Field (1) 'Index'
+	dst = ssz.MarshalUint32(dst, o.Index)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the OutputRef object
+func (o *OutputRef) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 36 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Hash'
+	if cap(o.Hash) == 0 {
+		o.Hash = make([]byte, 0, len(buf[0:32]))
+	}
+	o.Hash = append(o.Hash, buf[0:32]...)
+
+	// Field (1) 'Index'
+	o.Index = ssz.UnmarshallUint32(buf[32:36])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the OutputRef object
+func (o *OutputRef) SizeSSZ() (size int) {
+	size = 36
+	return
+}
+
+// HashTreeRoot ssz hashes the OutputRef object
+func (o *OutputRef) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootWith ssz hashes the OutputRef object with a hasher
+func (o *OutputRef) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Hash'
+	if size := len(o.Hash); size != 32 {
+		err = ssz.ErrBytesLengthFn("OutputRef.Hash", size, 32)
+		return
+	}
+	hh.PutBytes(o.Hash)
+
+	// Field (1) 'Index'
+	hh.PutUint32(o.Index)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the OutputRef object
+func (o *OutputRef) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(o)
+}
+
+// MarshalSSZ ssz marshals the TxInput object
+func (t *TxInput) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the TxInput object to a target array
+func (t *TxInput) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(68)
+
+	// Field (0) 'Hash'
+	if size := len(t.Hash); size != 32 {
+		err = ssz.ErrBytesLengthFn("TxInput.Hash", size, 32)
+		return
+	}
+	dst = append(dst, t.Hash...)
+
+	// Field (1) 'Index'
+	dst = ssz.MarshalUint32(dst, t.Index)
+
+	// Field (2) 'Address'
+	if size := len(t.Address); size != 20 {
+		err = ssz.ErrBytesLengthFn("TxInput.Address", size, 20)
+		return
+	}
+	dst = append(dst, t.Address...)
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, t.Amount)
+
+	// Offset (4) 'Node'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.Node)
+
+	// Field (4) 'Node'
 	if size := len(t.Node); size > 20 {
 		err = ssz.ErrBytesLengthFn("TxInput.Node", size, 20)
 		return
@@ -1250,10 +2060,7 @@ func (s *Seed) MarshalSSZ() ([]byte, error) {
 func (s *Seed) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'Seed'
-	dst = ssz.MarshalUint32(dst, s.Seed)
-
-	// Field (1) 'Proposer'
+	// Field (0) 'Proposer'
 	if s.Proposer == nil {
 		s.Proposer = new(Sign)
 	}
@@ -1261,6 +2068,26 @@ func (s *Seed) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, s.Slot)
+
+	// Field (2) 'Epoch'
+	dst = ssz.MarshalUint64(dst, s.Epoch)
+
+	// Field (3) 'Commitment'
+	if size := len(s.Commitment); size != 32 {
+		err = ssz.ErrBytesLengthFn("Seed.Commitment", size, 32)
+		return
+	}
+	dst = append(dst, s.Commitment...)
+
+	// Field (4) 'Reveal'
+	if size := len(s.Reveal); size != 32 {
+		err = ssz.ErrBytesLengthFn("Seed.Reveal", size, 32)
+		return
+	}
+	dst = append(dst, s.Reveal...)
+
 	return
 }
 
@@ -1268,27 +2095,42 @@ func (s *Seed) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (s *Seed) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 89 {
+	if size != 165 {
 		return ssz.ErrSize
 	}
 
-	// Field (0) 'Seed'
-	s.Seed = ssz.UnmarshallUint32(buf[0:4])
-
-	// Field (1) 'Proposer'
+	// Field (0) 'Proposer'
 	if s.Proposer == nil {
 		s.Proposer = new(Sign)
 	}
-	if err = s.Proposer.UnmarshalSSZ(buf[4:89]); err != nil {
+	if err = s.Proposer.UnmarshalSSZ(buf[0:85]); err != nil {
 		return err
 	}
 
+	// Field (1) 'Slot'
+	s.Slot = ssz.UnmarshallUint64(buf[85:93])
+
+	// Field (2) 'Epoch'
+	s.Epoch = ssz.UnmarshallUint64(buf[93:101])
+
+	// Field (3) 'Commitment'
+	if cap(s.Commitment) == 0 {
+		s.Commitment = make([]byte, 0, len(buf[101:133]))
+	}
+	s.Commitment = append(s.Commitment, buf[101:133]...)
+
+	// Field (4) 'Reveal'
+	if cap(s.Reveal) == 0 {
+		s.Reveal = make([]byte, 0, len(buf[133:165]))
+	}
+	s.Reveal = append(s.Reveal, buf[133:165]...)
+
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the Seed object
 func (s *Seed) SizeSSZ() (size int) {
-	size = 89
+	size = 165
 	return
 }
 
@@ -1301,10 +2143,7 @@ func (s *Seed) HashTreeRoot() ([32]byte, error) {
 func (s *Seed) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Seed'
-	hh.PutUint32(s.Seed)
-
-	// Field (1) 'Proposer'
+	// Field (0) 'Proposer'
 	if s.Proposer == nil {
 		s.Proposer = new(Sign)
 	}
@@ -1312,11 +2151,554 @@ func (s *Seed) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		return
 	}
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field (1) 'Slot'
+	hh.PutUint64(s.Slot)
+
+	// Field (2) 'Epoch'
+	hh.PutUint64(s.Epoch)
+
+	// Field (3) 'Commitment'
+	if size := len(s.Commitment); size != 32 {
+		err = ssz.ErrBytesLengthFn("Seed.Commitment", size, 32)
+		return
+	}
+	hh.PutBytes(s.Commitment)
+
+	// Field (4) 'Reveal'
+	if size := len(s.Reveal); size != 32 {
+		err = ssz.ErrBytesLengthFn("Seed.Reveal", size, 32)
+		return
+	}
+	hh.PutBytes(s.Reveal)
+
+	hh.Merkleize(indx)
+	return
+}
 
 // GetTree ssz hashes the Seed object
 func (s *Seed) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
+
+// MarshalSSZ ssz marshals the CheckpointVote object
+func (c *CheckpointVote) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CheckpointVote object to a target array
+func (c *CheckpointVote) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SourceEpoch'
+	dst = ssz.MarshalUint64(dst, c.SourceEpoch)
+
+	// Field (1) 'SourceHash'
+	if size := len(c.SourceHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("CheckpointVote.SourceHash", size, 32)
+		return
+	}
+	dst = append(dst, c.SourceHash...)
+
+	// Field (2) 'TargetEpoch'
+	dst = ssz.MarshalUint64(dst, c.TargetEpoch)
+
+	// Field (3) 'TargetHash'
+	if size := len(c.TargetHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("CheckpointVote.TargetHash", size, 32)
+		return
+	}
+	dst = append(dst, c.TargetHash...)
+
+	// Field (4) 'Voter'
+	if c.Voter == nil {
+		c.Voter = new(Sign)
+	}
+	if dst, err = c.Voter.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CheckpointVote object
+func (c *CheckpointVote) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 165 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'SourceEpoch'
+	c.SourceEpoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'SourceHash'
+	if cap(c.SourceHash) == 0 {
+		c.SourceHash = make([]byte, 0, len(buf[8:40]))
+	}
+	c.SourceHash = append(c.SourceHash, buf[8:40]...)
+
+	// Field (2) 'TargetEpoch'
+	c.TargetEpoch = ssz.UnmarshallUint64(buf[40:48])
+
+	// Field (3) 'TargetHash'
+	if cap(c.TargetHash) == 0 {
+		c.TargetHash = make([]byte, 0, len(buf[48:80]))
+	}
+	c.TargetHash = append(c.TargetHash, buf[48:80]...)
+
+	// Field (4) 'Voter'
+	if c.Voter == nil {
+		c.Voter = new(Sign)
+	}
+	if err = c.Voter.UnmarshalSSZ(buf[80:165]); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CheckpointVote object
+func (c *CheckpointVote) SizeSSZ() (size int) {
+	size = 165
+	return
+}
+
+// HashTreeRoot ssz hashes the CheckpointVote object
+func (c *CheckpointVote) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CheckpointVote object with a hasher
+func (c *CheckpointVote) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SourceEpoch'
+	hh.PutUint64(c.SourceEpoch)
+
+	// Field (1) 'SourceHash'
+	if size := len(c.SourceHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("CheckpointVote.SourceHash", size, 32)
+		return
+	}
+	hh.PutBytes(c.SourceHash)
+
+	// Field (2) 'TargetEpoch'
+	hh.PutUint64(c.TargetEpoch)
+
+	// Field (3) 'TargetHash'
+	if size := len(c.TargetHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("CheckpointVote.TargetHash", size, 32)
+		return
+	}
+	hh.PutBytes(c.TargetHash)
+
+	// Field (4) 'Voter'
+	if c.Voter == nil {
+		c.Voter = new(Sign)
+	}
+	if err = c.Voter.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the CheckpointVote object
+func (c *CheckpointVote) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// MarshalSSZ ssz marshals the SignedHeader object
+func (s *SignedHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedHeader object to a target array
+func (s *SignedHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(180)
+
+	// Field (0) 'Num'
+	dst = ssz.MarshalUint64(dst, s.Num)
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, s.Slot)
+
+	// Field (2) 'Version'
+	if size := len(s.Version); size != 3 {
+		err = ssz.ErrBytesLengthFn("SignedHeader.Version", size, 3)
+		return
+	}
+	dst = append(dst, s.Version...)
+
+	// Field (3) 'Parent'
+	if size := len(s.Parent); size != 32 {
+		err = ssz.ErrBytesLengthFn("SignedHeader.Parent", size, 32)
+		return
+	}
+	dst = append(dst, s.Parent...)
+
+	// Field (4) 'Txroot'
+	if size := len(s.Txroot); size != 32 {
+		err = ssz.ErrBytesLengthFn("SignedHeader.Txroot", size, 32)
+		return
+	}
+	dst = append(dst, s.Txroot...)
+
+	// Offset (5) 'Seed'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Seed)
+
+	// Field (6) 'Vote'
+	dst = ssz.MarshalUint32(dst, s.Vote)
+
+	// Field (7) 'Signature'
+	if s.Signature == nil {
+		s.Signature = new(Sign)
+	}
+	if dst, err = s.Signature.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (8) 'CheckpointRoot'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.CheckpointRoot)
+
+	// Field (5) 'Seed'
+	if size := len(s.Seed); size > 32 {
+		err = ssz.ErrBytesLengthFn("SignedHeader.Seed", size, 32)
+		return
+	}
+	dst = append(dst, s.Seed...)
+
+	// Field (8) 'CheckpointRoot'
+	if size := len(s.CheckpointRoot); size > 32 {
+		err = ssz.ErrBytesLengthFn("SignedHeader.CheckpointRoot", size, 32)
+		return
+	}
+	dst = append(dst, s.CheckpointRoot...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedHeader object
+func (s *SignedHeader) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 180 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o5, o8 uint64
+
+	// Field (0) 'Num'
+	s.Num = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Slot'
+	s.Slot = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Version'
+	if cap(s.Version) == 0 {
+		s.Version = make([]byte, 0, len(buf[16:19]))
+	}
+	s.Version = append(s.Version, buf[16:19]...)
+
+	// Field (3) 'Parent'
+	if cap(s.Parent) == 0 {
+		s.Parent = make([]byte, 0, len(buf[19:51]))
+	}
+	s.Parent = append(s.Parent, buf[19:51]...)
+
+	// Field (4) 'Txroot'
+	if cap(s.Txroot) == 0 {
+		s.Txroot = make([]byte, 0, len(buf[51:83]))
+	}
+	s.Txroot = append(s.Txroot, buf[51:83]...)
+
+	// Offset (5) 'Seed'
+	if o5 = ssz.ReadOffset(buf[83:87]); o5 > size {
+		return ssz.ErrOffset
+	}
+
+	if o5 < 180 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (6) 'Vote'
+	s.Vote = ssz.UnmarshallUint32(buf[87:91])
+
+	// Field (7) 'Signature'
+	if s.Signature == nil {
+		s.Signature = new(Sign)
+	}
+	if err = s.Signature.UnmarshalSSZ(buf[91:176]); err != nil {
+		return err
+	}
+
+	// Offset (8) 'CheckpointRoot'
+	if o8 = ssz.ReadOffset(buf[176:180]); o8 > size || o5 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (5) 'Seed'
+	{
+		buf = tail[o5:o8]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Seed) == 0 {
+			s.Seed = make([]byte, 0, len(buf))
+		}
+		s.Seed = append(s.Seed, buf...)
+	}
+
+	// Field (8) 'CheckpointRoot'
+	{
+		buf = tail[o8:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.CheckpointRoot) == 0 {
+			s.CheckpointRoot = make([]byte, 0, len(buf))
+		}
+		s.CheckpointRoot = append(s.CheckpointRoot, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedHeader object
+func (s *SignedHeader) SizeSSZ() (size int) {
+	size = 180
+
+	// Field (5) 'Seed'
+	size += len(s.Seed)
+
+	// Field (8) 'CheckpointRoot'
+	size += len(s.CheckpointRoot)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedHeader object
+func (s *SignedHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedHeader object with a hasher
+func (s *SignedHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Num'
+	hh.PutUint64(s.Num)
+
+	// Field (1) 'Slot'
+	hh.PutUint64(s.Slot)
+
+	// Field (2) 'Version'
+	if size := len(s.Version); size != 3 {
+		err = ssz.ErrBytesLengthFn("SignedHeader.Version", size, 3)
+		return
+	}
+	hh.PutBytes(s.Version)
+
+	// Field (3) 'Parent'
+	if size := len(s.Parent); size != 32 {
+		err = ssz.ErrBytesLengthFn("SignedHeader.Parent", size, 32)
+		return
+	}
+	hh.PutBytes(s.Parent)
+
+	// Field (4) 'Txroot'
+	if size := len(s.Txroot); size != 32 {
+		err = ssz.ErrBytesLengthFn("SignedHeader.Txroot", size, 32)
+		return
+	}
+	hh.PutBytes(s.Txroot)
+
+	// Field (5) 'Seed'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Seed))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Seed)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (6) 'Vote'
+	hh.PutUint32(s.Vote)
+
+	// Field (7) 'Signature'
+	if s.Signature == nil {
+		s.Signature = new(Sign)
+	}
+	if err = s.Signature.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (8) 'CheckpointRoot'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.CheckpointRoot))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.CheckpointRoot)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedHeader object
+func (s *SignedHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the SlashingEvidence object
+func (s *SlashingEvidence) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SlashingEvidence object to a target array
+func (s *SlashingEvidence) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'Type'
+	dst = ssz.MarshalUint32(dst, s.Type)
+
+	// Offset (1) 'First'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.First == nil {
+		s.First = new(SignedHeader)
+	}
+	offset += s.First.SizeSSZ()
+
+	// Offset (2) 'Second'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Second == nil {
+		s.Second = new(SignedHeader)
+	}
+	offset += s.Second.SizeSSZ()
+
+	// Field (1) 'First'
+	if dst, err = s.First.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Second'
+	if dst, err = s.Second.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SlashingEvidence object
+func (s *SlashingEvidence) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2 uint64
+
+	// Field (0) 'Type'
+	s.Type = ssz.UnmarshallUint32(buf[0:4])
+
+	// Offset (1) 'First'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 12 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (2) 'Second'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (1) 'First'
+	{
+		buf = tail[o1:o2]
+		if s.First == nil {
+			s.First = new(SignedHeader)
+		}
+		if err = s.First.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Second'
+	{
+		buf = tail[o2:]
+		if s.Second == nil {
+			s.Second = new(SignedHeader)
+		}
+		if err = s.Second.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SlashingEvidence object
+func (s *SlashingEvidence) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'First'
+	if s.First == nil {
+		s.First = new(SignedHeader)
+	}
+	size += s.First.SizeSSZ()
+
+	// Field (2) 'Second'
+	if s.Second == nil {
+		s.Second = new(SignedHeader)
+	}
+	size += s.Second.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SlashingEvidence object
+func (s *SlashingEvidence) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SlashingEvidence object with a hasher
+func (s *SlashingEvidence) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Type'
+	hh.PutUint32(s.Type)
+
+	// Field (1) 'First'
+	if err = s.First.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Second'
+	if err = s.Second.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SlashingEvidence object
+func (s *SlashingEvidence) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
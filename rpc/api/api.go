@@ -1,6 +1,7 @@
 package api
 
 import (
+	"github.com/raidoNetwork/RDO_v2/events"
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/shared/types"
 )
@@ -17,6 +18,13 @@ type ChainAPI interface {
 	// GetStakeDeposits returns address stake deposits.
 	GetStakeDeposits(string, string) ([]*types.UTxO, error)
 
+	// GetOutput returns output with given hash and index including spent one.
+	GetOutput(string, uint32) (*types.UTxO, error)
+
+	// GetOutputsHistory returns address outputs including spent ones
+	// with ID greater than the cursor ordered by ID.
+	GetOutputsHistory(address string, cursor uint64, limit uint32) ([]*types.UTxO, error)
+
 	// GetTransactionsCountHex returns number of transactions
 	// sent by given address.
 	GetTransactionsCountHex(string) (uint64, error)
@@ -37,6 +45,13 @@ type ChainAPI interface {
 	// GetTransaction return transaction with given hash.
 	GetTransaction(string) (*prototype.Transaction, error)
 
+	// GetTransactionReceipt returns receipt of the transaction with given hash.
+	GetTransactionReceipt(string) (*prototype.Receipt, error)
+
+	// GetAddressTransactions returns transactions sent or received by the address
+	// starting after the cursor and cursor of the next page.
+	GetAddressTransactions(address string, cursor string, limit uint32, desc bool) ([]*types.AddressTx, string, error)
+
 	/* Miscellaneous data */
 
 	// GetSystemBalance returns the total balance in the system (market cap)
@@ -52,14 +67,25 @@ type ChainAPI interface {
 
 	// GetBlocksStartCount returns a number of blocks starting at some number
 	GetBlocksStartCount(start int64, limit uint32) ([]*prototype.Block, error)
+
+	// GetSigningDomain returns network chain id and Genesis hash bound into the signatures.
+	GetSigningDomain() *types.SigningDomain
+
+	/* Finality data */
+
+	// GetFinalityStatus returns the last justified and finalized checkpoints.
+	GetFinalityStatus() (*types.Checkpoint, *types.Checkpoint, error)
 }
 
 type AttestationAPI interface {
 	// SendRawTx send transaction to the TxPool.
 	SendRawTx(transaction *prototype.Transaction) error
 
-	// GetFee return minimal fee needed for adding transaction to the block.
-	GetFee() uint64
+	// SendRawTxBatch inserts transactions to the TxPool and returns insertion error of every transaction.
+	SendRawTxBatch(transactions []*prototype.Transaction) []error
+
+	// GetFee returns base fee of the next block, tip and fee price suggestions.
+	GetFee() (*types.FeeSuggestion, error)
 
 	// GetServiceStatus return service status or error if service is offline.
 	GetServiceStatus() (string, error)
@@ -67,6 +93,15 @@ type AttestationAPI interface {
 	// GetPendingTransactions returns list of pending transactions.
 	GetPendingTransactions() ([]*prototype.Transaction, error)
 
+	// GetPendingByAddress returns pending and queued transactions of the given address.
+	GetPendingByAddress(address string) ([]*prototype.Transaction, error)
+
+	// GetPendingTransaction returns pool transaction with given hash and its pool status.
+	GetPendingTransaction(hash string) (*prototype.Transaction, types.PoolStatus, error)
+
+	// SubscribePendingTransactions subscribes given channel to the pool transactions status changes.
+	SubscribePendingTransactions(ch chan<- *types.PoolEvent) events.Subscription
+
 	// StakersLimitReached returns an error if
 	// the limit of stakers for a particular validator is exceeded
 	StakersLimitReached(tx *types.Transaction) error
@@ -80,6 +115,9 @@ type AttestationAPI interface {
 
 	// ListStakeValidators returns a list of validators that can be staken on
 	ListStakeValidators() []string
+
+	// GetCommittee returns validators committee of the given slot
+	GetCommittee(slot uint64) ([]string, error)
 }
 
 type GeneratorAPI interface {
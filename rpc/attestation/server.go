@@ -3,10 +3,12 @@ package attestation
 import (
 	"context"
 	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/blockchain/consensus"
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/rpc/api"
 	"github.com/raidoNetwork/RDO_v2/rpc/cast"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
@@ -17,6 +19,9 @@ var log = logrus.WithField("prefix", "RPC AttestationServer")
 
 var ErrWrongTxType = errors.New("Wrong transaction type.")
 
+// subscriptionBufferSize is the number of pool events waiting to be sent to the stream
+const subscriptionBufferSize = 100
+
 type Server struct {
 	Server  *grpc.Server
 	Backend api.AttestationAPI
@@ -57,13 +62,25 @@ func (s *Server) sendTx(request *prototype.SendTxRequest, txType uint32, method
 
 	err = s.Backend.SendRawTx(tx)
 	if err != nil {
-		resp.Error = err.Error()
-		return resp, err
+		return rejectTx(resp, err)
 	}
 
 	return resp, nil
 }
 
+// rejectTx fills response with the pool rejection reason and returns gRPC error with the response in the status details
+func rejectTx(resp *prototype.ErrorResponse, err error) (*prototype.ErrorResponse, error) {
+	resp.Error = status.Convert(err).Message()
+	resp.Reason = string(consensus.RejectReasonOf(err))
+
+	st, detailsErr := status.New(17, resp.Error).WithDetails(resp)
+	if detailsErr != nil {
+		return resp, status.Error(17, resp.Error)
+	}
+
+	return resp, st.Err()
+}
+
 func (s *Server) SendLegacyTx(ctx context.Context, request *prototype.SendTxRequest) (*prototype.ErrorResponse, error) {
 	return s.sendTx(request, common.NormalTxType, "SendLegacyTx")
 }
@@ -90,19 +107,84 @@ func (s *Server) SendRawTx(ctx context.Context, request *prototype.RawTxRequest)
 	}
 
 	err = s.Backend.SendRawTx(tx)
+	if err != nil {
+		return rejectTx(resp, err)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) SendRawTxBatch(ctx context.Context, request *prototype.RawTxBatchRequest) (*prototype.TxBatchResponse, error) {
+	resp := new(prototype.TxBatchResponse)
+
+	err := request.Validate()
 	if err != nil {
 		resp.Error = err.Error()
-		return resp, err
+		return resp, status.Error(17, err.Error())
+	}
+
+	log.Infof("AttestationAPI.SendRawTxBatch with %d transactions", len(request.Data))
+
+	resp.Results = make([]*prototype.TxBatchResult, len(request.Data))
+	txs := make([]*prototype.Transaction, 0, len(request.Data))
+	for i, data := range request.Data {
+		tx, err := UnmarshalTx(data)
+		if err != nil {
+			resp.Results[i] = batchResult(nil, err)
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	errs := s.Backend.SendRawTxBatch(txs)
+
+	// fill results of the decoded transactions
+	j := 0
+	for i := range resp.Results {
+		if resp.Results[i] != nil {
+			continue
+		}
+
+		resp.Results[i] = batchResult(txs[j], errs[j])
+		j++
 	}
 
 	return resp, nil
 }
 
-func (s *Server) GetFee(ctx context.Context, empty *emptypb.Empty) (*prototype.NumberResponse, error) {
+// batchResult returns result of the batch transaction with the pool rejection reason
+func batchResult(tx *prototype.Transaction, err error) *prototype.TxBatchResult {
+	res := &prototype.TxBatchResult{
+		Status: "accepted",
+	}
+
+	if tx != nil {
+		res.Hash = common.BytesToHash(tx.Hash).Hex()
+	}
+
+	if err != nil {
+		res.Status = "rejected"
+		res.Error = status.Convert(err).Message()
+		res.Reason = string(consensus.RejectReasonOf(err))
+	}
+
+	return res
+}
+
+func (s *Server) GetFee(ctx context.Context, empty *emptypb.Empty) (*prototype.FeeResponse, error) {
 	log.Info("AttestationAPI.GetFee")
 
-	res := new(prototype.NumberResponse)
-	res.Result = s.Backend.GetFee()
+	res := new(prototype.FeeResponse)
+	fee, err := s.Backend.GetFee()
+	if err != nil {
+		res.Error = err.Error()
+		return res, err
+	}
+
+	res.Result = fee.FeePrice
+	res.BaseFee = fee.BaseFee
+	res.Tip = fee.Tip
 
 	return res, nil
 }
@@ -124,3 +206,116 @@ func (s *Server) GetPendingTransactions(ctx context.Context, empty *emptypb.Empt
 
 	return response, nil
 }
+
+func (s *Server) GetPendingByAddress(ctx context.Context, request *prototype.AddressRequest) (*prototype.TransactionsResponse, error) {
+	response := new(prototype.TransactionsResponse)
+
+	err := request.Validate()
+	if err != nil {
+		log.Errorf("AttestationAPI.GetPendingByAddress error: %s", err)
+		response.Error = err.Error()
+		return response, err
+	}
+
+	log.Infof("AttestationAPI.GetPendingByAddress(%s)", request.GetAddress())
+
+	txBatch, err := s.Backend.GetPendingByAddress(request.GetAddress())
+	if err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+
+	response.Tx = make([]*prototype.TxValue, len(txBatch))
+	for i, tx := range txBatch {
+		response.Tx[i] = cast.TxValue(tx)
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetPendingTransaction(ctx context.Context, request *prototype.HashRequest) (*prototype.PendingTransactionResponse, error) {
+	response := new(prototype.PendingTransactionResponse)
+
+	err := request.Validate()
+	if err != nil {
+		log.Errorf("AttestationAPI.GetPendingTransaction error: %s", err)
+		response.Error = err.Error()
+		return response, err
+	}
+
+	log.Infof("AttestationAPI.GetPendingTransaction(%s)", request.GetHash())
+
+	tx, poolStatus, err := s.Backend.GetPendingTransaction(request.GetHash())
+	if err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+
+	response.Tx = cast.TxValue(tx)
+	response.Status = string(poolStatus)
+
+	return response, nil
+}
+
+// SubscribePendingTransactions streams pool status changes until the client disconnects.
+// Events are skipped if the client reads them slower than the pool produces.
+func (s *Server) SubscribePendingTransactions(request *prototype.PendingSubscribeRequest, stream prototype.Attestation_SubscribePendingTransactionsServer) error {
+	err := request.Validate()
+	if err != nil {
+		log.Errorf("AttestationAPI.SubscribePendingTransactions error: %s", err)
+		return err
+	}
+
+	log.Infof("AttestationAPI.SubscribePendingTransactions(%s)", request.GetAddress())
+
+	var address string
+	if request.GetAddress() != "" {
+		address = common.HexToAddress(request.GetAddress()).Hex()
+	}
+
+	// feed channel is drained by the relay, so slow stream doesn't block the feed for other subscribers
+	feedEvent := make(chan *types.PoolEvent)
+	poolEvent := make(chan *types.PoolEvent, subscriptionBufferSize)
+	sub := s.Backend.SubscribePendingTransactions(feedEvent)
+	defer sub.Unsubscribe()
+
+	go relayPoolEvents(stream.Context(), address, feedEvent, poolEvent)
+
+	for {
+		select {
+		case event := <-poolEvent:
+			err := stream.Send(&prototype.PendingTransactionResponse{
+				Tx:     cast.TxValue(event.Tx.GetTx()),
+				Status: string(event.Status),
+			})
+			if err != nil {
+				return err
+			}
+		case err := <-sub.Err():
+			return err
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// relayPoolEvents moves pool events of the given sender from the feed channel to the stream buffer without blocking.
+// Events are skipped when the buffer is full. Empty address means all senders.
+func relayPoolEvents(ctx context.Context, address string, in <-chan *types.PoolEvent, out chan<- *types.PoolEvent) {
+	for {
+		select {
+		case event := <-in:
+			if address != "" && event.Tx.From().Hex() != address {
+				continue
+			}
+
+			select {
+			case out <- event:
+			default:
+				log.Debugf("Skip pool event %s %s: subscriber buffer is full", event.Tx.Hash().Hex(), event.Status)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
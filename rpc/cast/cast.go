@@ -4,6 +4,7 @@ import (
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
 	"github.com/raidoNetwork/RDO_v2/shared/types"
+	vtypes "github.com/raidoNetwork/RDO_v2/validator/types"
 	"github.com/sirupsen/logrus"
 )
 
@@ -21,6 +22,10 @@ func ProtoUTxO(uo *types.UTxO) *prototype.UTxO {
 	uopb.Amount = uo.Amount
 	uopb.Timestamp = uo.Timestamp
 	uopb.Txtype = uo.TxType
+	uopb.Id = uo.ID
+	uopb.SpentBlock = uo.SpentBlock
+	uopb.SpentTxHash = uo.SpentTxHash.Hex()
+	uopb.SpentIndex = uo.SpentIndex
 
 	return uopb
 }
@@ -34,22 +39,28 @@ func BlockValue(block *prototype.Block) *prototype.BlockValue {
 	bv.Parent = HashString(block.Parent)
 	bv.Timestamp = block.Timestamp
 	bv.Proposer = ConvSign(block.Proposer)
+	bv.BaseFee = block.BaseFee
 
-	size := len(block.Approvers)
-	bv.Approvers = make([]string, size)
+	if types.HasAggregatedVotes(block) {
+		bv.Approvers = voters(block, block.Approvals, vtypes.Approve)
+		bv.Slashers = voters(block, block.Rejections, vtypes.Reject)
+	} else {
+		size := len(block.Approvers)
+		bv.Approvers = make([]string, size)
 
-	for i := 0; i < size; i++ {
-		bv.Approvers[i] = ConvSign(block.Approvers[i])
-	}
+		for i := 0; i < size; i++ {
+			bv.Approvers[i] = ConvSign(block.Approvers[i])
+		}
 
-	size = len(block.Slashers)
-	bv.Slashers = make([]string, size)
+		size = len(block.Slashers)
+		bv.Slashers = make([]string, size)
 
-	for i := 0; i < size; i++ {
-		bv.Slashers[i] = ConvSign(block.Slashers[i])
+		for i := 0; i < size; i++ {
+			bv.Slashers[i] = ConvSign(block.Slashers[i])
+		}
 	}
 
-	size = len(block.Transactions)
+	size := len(block.Transactions)
 	bv.Transactions = make([]*prototype.TxValue, size)
 
 	for i := 0; i < size; i++ {
@@ -59,6 +70,61 @@ func BlockValue(block *prototype.Block) *prototype.BlockValue {
 	return bv
 }
 
+func CheckpointValue(cp *types.Checkpoint) *prototype.CheckpointValue {
+	return &prototype.CheckpointValue{
+		Epoch:      cp.Epoch,
+		Num:        cp.Num,
+		Hash:       HashString(cp.Hash),
+		VotedStake: cp.VotedStake,
+		TotalStake: cp.TotalStake,
+	}
+}
+
+func ReceiptValue(receipt *prototype.Receipt) *prototype.ReceiptValue {
+	rv := &prototype.ReceiptValue{
+		TxHash:    HashString(receipt.TxHash),
+		BlockHash: HashString(receipt.BlockHash),
+		BlockNum:  receipt.BlockNum,
+		Index:     receipt.Index,
+		Status:    types.ExecutionStatus(receipt.Status).String(),
+		Fee:       receipt.Fee,
+		BurnedFee: receipt.BurnedFee,
+		Tip:       receipt.Tip,
+		Created:   make([]*prototype.OutputRefValue, len(receipt.Created)),
+		Spent:     make([]*prototype.OutputRefValue, len(receipt.Spent)),
+		Reason:    string(receipt.Reason),
+	}
+
+	for i, ref := range receipt.Created {
+		rv.Created[i] = OutputRefValue(ref)
+	}
+
+	for i, ref := range receipt.Spent {
+		rv.Spent[i] = OutputRefValue(ref)
+	}
+
+	return rv
+}
+
+func OutputRefValue(ref *prototype.OutputRef) *prototype.OutputRefValue {
+	return &prototype.OutputRefValue{
+		Hash:  HashString(ref.Hash),
+		Index: ref.Index,
+	}
+}
+
+// voters returns addresses of validators signed aggregated votes of the block
+func voters(block *prototype.Block, votes *prototype.AggregatedVotes, attestType vtypes.AttestationType) []string {
+	addresses := vtypes.RecoverVoters(block, votes, attestType)
+
+	res := make([]string, len(addresses))
+	for i, addr := range addresses {
+		res[i] = addr.Hex()
+	}
+
+	return res
+}
+
 func ConvSign(s *prototype.Sign) string {
 	return AddressString(s.Address)
 }
@@ -80,6 +146,9 @@ func TxValue(tx *prototype.Transaction) *prototype.TxValue {
 	tv.Hash = HashString(tx.Hash)
 	tv.Fee = tx.Fee
 	tv.Data = tx.Data
+	tv.Version = tx.Version
+	tv.ValidAfter = tx.ValidAfter
+	tv.ValidUntil = tx.ValidUntil
 
 	size := len(tx.Inputs)
 	tv.Inputs = make([]*prototype.TxInputValue, size)
@@ -171,6 +240,9 @@ func TxFromTxValue(txv *prototype.SignedTxValue) *prototype.Transaction {
 	tx.Fee = txv.Data.Fee
 	tx.Timestamp = txv.Data.Timestamp
 	tx.Data = txv.Data.Data
+	tx.Version = txv.Data.Version
+	tx.ValidAfter = txv.Data.ValidAfter
+	tx.ValidUntil = txv.Data.ValidUntil
 	tx.Hash = common.HexToHash(txv.Data.Hash).Bytes()
 
 	tx.Inputs = make([]*prototype.TxInput, len(txv.Data.Inputs))
@@ -8,6 +8,9 @@ import (
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/rpc/api"
 	"github.com/raidoNetwork/RDO_v2/rpc/cast"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/shared/types"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
@@ -16,6 +19,9 @@ import (
 
 var log = logrus.WithField("prefix", "RPC ChainServer")
 
+// defaultHistoryLimit is the outputs and transactions history page size used when limit is not given
+const defaultHistoryLimit = 100
+
 type Server struct {
 	Server      *grpc.Server
 	Backend     api.ChainAPI
@@ -187,6 +193,128 @@ func (s *Server) GetTransaction(ctx context.Context, req *prototype.HashRequest)
 	return res, nil
 }
 
+func (s *Server) GetTransactionReceipt(ctx context.Context, req *prototype.HashRequest) (*prototype.ReceiptResponse, error) {
+	res := new(prototype.ReceiptResponse)
+
+	err := req.Validate()
+	if err != nil {
+		log.Errorf("ChainAPI.GetTransactionReceipt error: %s", err)
+		res.Error = err.Error()
+		return res, err
+	}
+
+	log.Infof("ChainAPI.GetTransactionReceipt(%s)", req.GetHash())
+
+	receipt, err := s.Backend.GetTransactionReceipt(req.GetHash())
+	if err != nil {
+		res.Error = err.Error()
+		return res, err
+	}
+
+	res.Receipt = cast.ReceiptValue(receipt)
+
+	return res, nil
+}
+
+// GetAddressTransactions returns transactions sent or received by the address page by page.
+func (s *Server) GetAddressTransactions(ctx context.Context, req *prototype.AddressTransactionsRequest) (*prototype.AddressTransactionsResponse, error) {
+	res := new(prototype.AddressTransactionsResponse)
+
+	err := req.Validate()
+	if err != nil {
+		log.Errorf("ChainAPI.GetAddressTransactions error: %s", err)
+		res.Error = err.Error()
+		return res, err
+	}
+
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+
+	log.Infof("ChainAPI.GetAddressTransactions(%s, %s, %d, %s)", req.GetAddress(), req.GetCursor(), limit, req.GetDirection())
+
+	arr, next, err := s.Backend.GetAddressTransactions(req.GetAddress(), req.GetCursor(), limit, req.GetDirection() == "desc")
+	if err != nil {
+		res.Error = err.Error()
+		return res, err
+	}
+
+	res.Data = make([]*prototype.AddressTransaction, len(arr))
+	for i, atx := range arr {
+		res.Data[i] = &prototype.AddressTransaction{
+			BlockNum: atx.BlockNum,
+			Index:    atx.Index,
+			Tx:       cast.TxValue(atx.Tx),
+		}
+	}
+
+	res.NextCursor = next
+
+	return res, nil
+}
+
+// GetOutput returns output with given hash and index including spent one.
+func (s *Server) GetOutput(ctx context.Context, req *prototype.OutputRequest) (*prototype.OutputResponse, error) {
+	res := new(prototype.OutputResponse)
+
+	err := req.Validate()
+	if err != nil {
+		log.Errorf("ChainAPI.GetOutput error: %s", err)
+		res.Error = err.Error()
+		return res, err
+	}
+
+	log.Infof("ChainAPI.GetOutput(%s, %d)", req.GetHash(), req.GetIndex())
+
+	uo, err := s.Backend.GetOutput(req.GetHash(), req.GetIndex())
+	if err != nil {
+		res.Error = err.Error()
+		return res, err
+	}
+
+	res.Output = cast.ProtoUTxO(uo)
+
+	return res, nil
+}
+
+// GetOutputsHistory returns address outputs including spent ones page by page.
+func (s *Server) GetOutputsHistory(ctx context.Context, req *prototype.OutputsHistoryRequest) (*prototype.OutputsHistoryResponse, error) {
+	res := new(prototype.OutputsHistoryResponse)
+
+	err := req.Validate()
+	if err != nil {
+		log.Errorf("ChainAPI.GetOutputsHistory error: %s", err)
+		res.Error = err.Error()
+		return res, err
+	}
+
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+
+	log.Infof("ChainAPI.GetOutputsHistory(%s, %d, %d)", req.GetAddress(), req.GetCursor(), limit)
+
+	arr, err := s.Backend.GetOutputsHistory(req.GetAddress(), req.GetCursor(), limit)
+	if err != nil {
+		res.Error = err.Error()
+		return res, err
+	}
+
+	res.Data = make([]*prototype.UTxO, len(arr))
+	for i, uo := range arr {
+		res.Data[i] = cast.ProtoUTxO(uo)
+	}
+
+	// full page means that there can be more outputs
+	if len(arr) == int(limit) {
+		res.NextCursor = arr[len(arr)-1].ID
+	}
+
+	return res, nil
+}
+
 func (s *Server) GetStakeDeposits(ctx context.Context, request *prototype.AddressRequest) (*prototype.UTxOResponse, error) {
 	err := request.Validate()
 	if err != nil {
@@ -290,3 +418,57 @@ func (s *Server) GetMarketCap(ctx context.Context, nothing *emptypb.Empty) (*pro
 	response.Cap = marketCap
 	return response, nil
 }
+
+// GetCommittee returns validators committee of the given slot.
+func (s *Server) GetCommittee(ctx context.Context, request *prototype.SlotRequest) (*prototype.CommitteeResponse, error) {
+	response := new(prototype.CommitteeResponse)
+
+	slot := request.GetSlot()
+	log.Infof("ChainAPI.GetCommittee(%d)", slot)
+
+	committee, err := s.Attestation.GetCommittee(slot)
+	if err != nil {
+		response.Error = err.Error()
+		return response, status.Error(3, err.Error())
+	}
+
+	response.Slot = slot
+	response.Epoch = types.EpochOf(slot)
+	response.Validators = committee
+
+	return response, nil
+}
+
+// GetFinalityStatus returns the last justified and finalized checkpoints.
+func (s *Server) GetFinalityStatus(ctx context.Context, nothing *emptypb.Empty) (*prototype.FinalityStatusResponse, error) {
+	response := new(prototype.FinalityStatusResponse)
+
+	justified, finalized, err := s.Backend.GetFinalityStatus()
+	if err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+
+	head, err := s.Backend.GetLatestBlock()
+	if err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+
+	response.HeadEpoch = types.EpochOf(head.Slot)
+	response.Justified = cast.CheckpointValue(justified)
+	response.Finalized = cast.CheckpointValue(finalized)
+
+	return response, nil
+}
+
+// GetChainInfo returns network chain id and Genesis hash used in the signing domain.
+func (s *Server) GetChainInfo(ctx context.Context, nothing *emptypb.Empty) (*prototype.ChainInfoResponse, error) {
+	domain := s.Backend.GetSigningDomain()
+
+	return &prototype.ChainInfoResponse{
+		ChainId:          domain.ChainID,
+		GenesisHash:      common.Encode(domain.GenesisHash),
+		LegacyTxDeadline: params.RaidoConfig().LegacyTxDeadline,
+	}, nil
+}
@@ -29,7 +29,23 @@ var (
 	// SQLConfigPath setups path to the MySQL config file.
 	SQLConfigPath = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:  "sql-cfg",
-		Usage: "Config file path with MySQL user and password",
+		Usage: "Config file path with MySQL or PostgreSQL user and password",
+	})
+	// UTxOBackend selects the unspent outputs storage.
+	UTxOBackend = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:  "utxo-backend",
+		Usage: "Unspent outputs storage backend: mysql, postgres, sqlite or bolt",
+		Value: "mysql",
+	})
+	// SpentOutputsRetention setups number of blocks spent outputs are kept for the history.
+	SpentOutputsRetention = altsrc.NewIntFlag(&cli.IntFlag{
+		Name:  "spent-outputs-retention",
+		Usage: "Number of the latest blocks which spent outputs are kept for the outputs history, 0 keeps all of them",
+	})
+	// MigrateDryRun prints pending outputs database migrations.
+	MigrateDryRun = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:  "migrate-dry-run",
+		Usage: "Print pending outputs database schema migrations without applying them and exit",
 	})
 
 	// DataDirFlag defines a path on disk.
@@ -2,6 +2,7 @@ package common
 
 import (
 	"encoding/hex"
+	"errors"
 )
 
 func FromHex(s string) []byte {
@@ -36,6 +37,15 @@ func Encode(b []byte) string {
 	return string(enc)
 }
 
+// Decode decodes hex string with 0x prefix.
+func Decode(str string) ([]byte, error) {
+	if !has0xPrefix(str) {
+		return nil, errors.New("hex string without 0x prefix")
+	}
+
+	return hex.DecodeString(str[2:])
+}
+
 // isHex validates whether each byte is valid hexadecimal string.
 func isHex(str string) bool {
 	if len(str)%2 != 0 {
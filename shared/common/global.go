@@ -17,6 +17,7 @@ const (
 	UnstakeTxType           = 6
 	CollapseTxType          = 7
 	ValidatorsUnstakeTxType = 8
+	SlashTxType             = 9
 )
 
 const (
@@ -59,6 +60,8 @@ func IsSystemTx(tx *prototype.Transaction) bool {
 		fallthrough
 	case ValidatorsUnstakeTxType:
 		fallthrough
+	case SlashTxType:
+		fallthrough
 	case CollapseTxType:
 		return true
 	default:
@@ -66,7 +69,20 @@ func IsSystemTx(tx *prototype.Transaction) bool {
 	}
 }
 
+// IsBurnOutput check output is removed from the total supply:
+// block fee and burned stake of the slashed validator.
+func IsBurnOutput(tx *prototype.Transaction, out *prototype.TxOutput) bool {
+	switch tx.Type {
+	case FeeTxType:
+		return true
+	case SlashTxType:
+		return len(out.Node) == 0 && BytesToAddress(out.Address).Hex() == BlackHoleAddress
+	default:
+		return false
+	}
+}
+
 // HasInputs check transactions should have inputs
 func HasInputs(tx *prototype.Transaction) bool {
-	return IsLegacyTx(tx) || tx.Type == CollapseTxType || tx.Type == ValidatorsUnstakeTxType
+	return IsLegacyTx(tx) || tx.Type == CollapseTxType || tx.Type == ValidatorsUnstakeTxType || tx.Type == SlashTxType
 }
@@ -1,5 +1,8 @@
 package params
 
+// MaxCommitteeSize is the maximum number of committee members, it is limited by the block votes bitfield size.
+const MaxCommitteeSize = 128
+
 // RDOBlockChainConfig contains constant configs for node to participate in raido blockchain.
 type RDOBlockChainConfig struct {
 	SlotTime int64 `yaml:"SLOT_TIME"` // SlotTime setups block generator timeout.
@@ -17,12 +20,20 @@ type RDOBlockChainConfig struct {
 	ChosenValidatorRewardPercent uint64  `yaml:"CHOSEN_VALIDATOR_REWARD_PERCENT"` // ChosenValidatorRewardPercent defines percent of validator with electors reward
 	StakeSlotUnit                uint64  `yaml:"STAKE_SLOT_UNIT"`                 // StakeSlotUnit defines the amount of RDO needed to fill one stake slot.
 	ElectorsCoefficient          float32 `yaml:"ELECTORS_COEFFICIENT"`            // ElectorsPremium defines the slot coefficient for electors
+	SlashingPenalty              uint64  `yaml:"SLASHING_PENALTY"`                // SlashingPenalty defines the number of validator stake slots burned for a proven equivocation.
 
 	GenesisPath     string `yaml:"GENESIS_PATH"`     // GenesisPath defines path to the Genesis JSON file.
 	ResponseTimeout int64  `yaml:"RESPONSE_TIMEOUT"` // ResponseTimeout defines timeout for p2p response
 	SlotsPerEpoch   uint64 `yaml:"SLOTS_PER_EPOCH"`  // SlotPerEpoch defines slots' number for one epoch
 
-	CommitteeSize int `yaml:"COMMITTEE_SIZE"`
+	CommitteeSize int `yaml:"COMMITTEE_SIZE"` // CommitteeSize defines number of validators voting for blocks in the epoch, it can't exceed MaxCommitteeSize
+
+	ValidatorChurnLimit int `yaml:"VALIDATOR_CHURN_LIMIT"` // ValidatorChurnLimit defines the maximum number of validators activated or exited per epoch
+
+	ProposerTimeout uint64 `yaml:"PROPOSER_TIMEOUT"` // ProposerTimeout defines percent of the slot time given to proposer before the next ranked validator may propose
+
+	PoolSize    int    `yaml:"POOL_SIZE"`     // PoolSize defines the total size limit of the pool transactions in bytes
+	TxPriceBump uint64 `yaml:"TX_PRICE_BUMP"` // TxPriceBump defines minimal fee price increase in percent needed to replace pool transaction with the same nonce
 
 	NTPPool string `yaml:"NTP_POOL"` // NTP pool to check the clock drift
 
@@ -31,4 +42,13 @@ type RDOBlockChainConfig struct {
 	NTPThreshold int `yaml:"NTP_THRESHOLD"` // Specifies what clock drift is considered within boundaries (in ms)
 
 	MaxNumberOfStakers int `yaml:"MAX_STAKERS"` // Maximum number of stakers per validator
+
+	ChainID            uint64 `yaml:"CHAIN_ID"`             // ChainID defines network identifier bound into every signature
+	LegacyTxDeadline   uint64 `yaml:"LEGACY_TX_DEADLINE"`   // LegacyTxDeadline defines block number until which transactions signed without network domain are accepted
+	FeeMarketBlock     uint64 `yaml:"FEE_MARKET_BLOCK"`     // FeeMarketBlock defines block number since which base fee is burned and tips are paid to the proposer
+	TxOrderBlock       uint64 `yaml:"TX_ORDER_BLOCK"`       // TxOrderBlock defines block number since which block transactions should follow the canonical order
+	SenderNonceBlock   uint64 `yaml:"SENDER_NONCE_BLOCK"`   // SenderNonceBlock defines block number since which sender nonce grows with every transaction and block can include consecutive sender nonces
+	SeedBlock          uint64 `yaml:"SEED_BLOCK"`           // SeedBlock defines block number since which block seed mix, reveals and proposer are validated
+	SigningDomainBlock uint64 `yaml:"SIGNING_DOMAIN_BLOCK"` // SigningDomainBlock defines block number since which block and seed signatures are bound to the network domain
+	CommitteeBlock     uint64 `yaml:"COMMITTEE_BLOCK"`      // CommitteeBlock defines block number since which only the epoch committee votes for blocks
 }
@@ -35,6 +35,11 @@ func LoadChainConfigFile(chainConfigFileName string) {
 			log.WithError(err).Error("There were some issues parsing the config from a yaml file")
 		}
 	}
+	if conf.CommitteeSize <= 0 || conf.CommitteeSize > MaxCommitteeSize {
+		log.Warnf("Committee size %d is out of range, it is set to %d.", conf.CommitteeSize, MaxCommitteeSize)
+		conf.CommitteeSize = MaxCommitteeSize
+	}
+
 	log.Debugf("Config file values: %+v", conf)
 	OverrideRDOConfig(conf)
 }
@@ -14,14 +14,28 @@ var mainnetRDOConfig = &RDOBlockChainConfig{
 	SlotTime:            7, // 7 seconds
 	StakeSlotUnit:       5000,
 	ElectorsCoefficient: 1.1, // 10% premium for a slot staked by electors
+	SlashingPenalty:     1,   // 1 stake slot
 	MinimalFee:          0,   // 0 roi
 	RoiPerRdo:           1e8,
 	GenesisPath:         "",
 	BlockSize:           300 * 1024, // 300 kB
 	ResponseTimeout:     15,
 	SlotsPerEpoch:       200,
+	CommitteeSize:       MaxCommitteeSize,
+	ValidatorChurnLimit: 4,
+	ProposerTimeout:     50,              // 50% of the slot
+	PoolSize:            3 * 1024 * 1024, // 3 MB
+	TxPriceBump:         10,              // 10% of the replaced tx fee price
 	NTPPool:             "pool.ntp.org",
 	NTPChecks:           3,
 	NTPThreshold:        1200,
 	MaxNumberOfStakers:  1000,
+	ChainID:             1,
+	LegacyTxDeadline:    2000000, // about 160 days of 7 second slots for the wallets migration
+	FeeMarketBlock:      2000000,
+	TxOrderBlock:        2000000,
+	SenderNonceBlock:    2000000,
+	SeedBlock:           2000000,
+	SigningDomainBlock:  2000000,
+	CommitteeBlock:      2000000,
 }
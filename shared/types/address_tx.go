@@ -0,0 +1,10 @@
+package types
+
+import "github.com/raidoNetwork/RDO_v2/proto/prototype"
+
+// AddressTx is the transaction sent or received by the address with its position in the chain.
+type AddressTx struct {
+	BlockNum uint64
+	Index    uint32
+	Tx       *prototype.Transaction
+}
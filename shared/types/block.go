@@ -25,6 +25,8 @@ type BlockHeader struct {
 	TxRoot	[]byte
 	Hash []byte
 	Slot uint64
+	Seed []byte
+	CheckpointRoot []byte
 }
 
 type BlockSheet struct {
@@ -40,16 +42,20 @@ func NewHeader(block *prototype.Block) *BlockHeader {
 		Version: block.Version,
 		TxRoot: block.Txroot,
 		Slot: block.Slot,
+		Seed: block.Seed,
+		CheckpointRoot: CheckpointVotesRoot(block.CheckpointVotes),
 	}
 }
 
-func NewBlock(blockNum, slot uint64, parent []byte, txBatch []*prototype.Transaction, validator *keystore.ValidatorAccount) *prototype.Block {
+func NewBlock(blockNum, slot uint64, parent []byte, seed []byte, reveals []*prototype.Seed, votes []*prototype.CheckpointVote, txBatch []*prototype.Transaction, validator *keystore.ValidatorAccount) *prototype.Block {
 	header := BlockHeader{
 		Num:     blockNum,
 		Parent:  parent,
 		Version: []byte{1, 0, 0},
 		TxRoot:  hash.GenTxRoot(txBatch),
 		Slot: slot,
+		Seed: seed,
+		CheckpointRoot: CheckpointVotesRoot(votes),
 	}
 
 	// sign block
@@ -58,7 +64,7 @@ func NewBlock(blockNum, slot uint64, parent []byte, txBatch []*prototype.Transac
 	}
 
 	tstamp := uint64(time.Now().UnixNano())
-	header.Hash = hash.BlockHash(header.Num, header.Slot, header.Version, header.Parent, header.TxRoot, tstamp, blockSheet.Proposer.Address)
+	header.Hash = hash.BlockHash(header.Num, header.Slot, header.Version, header.Parent, header.TxRoot, header.Seed, header.CheckpointRoot, tstamp, blockSheet.Proposer.Address)
 
 	block := &prototype.Block{
 		Num:          header.Num,
@@ -70,6 +76,9 @@ func NewBlock(blockNum, slot uint64, parent []byte, txBatch []*prototype.Transac
 		Timestamp:    tstamp,
 		Proposer:     blockSheet.Proposer,
 		Transactions: txBatch,
+		Seed:         header.Seed,
+		Reveals:      reveals,
+		CheckpointVotes: votes,
 		Approvers: make([]*prototype.Sign, 0),
 		Slashers:  make([]*prototype.Sign, 0),
 	}
@@ -93,5 +102,7 @@ func GetBlockHeader(block *prototype.Block) *BlockHeader {
 		Version: []byte{1, 0, 0},
 		TxRoot:  block.Txroot,
 		Slot: block.Slot,
+		Seed: block.Seed,
+		CheckpointRoot: CheckpointVotesRoot(block.CheckpointVotes),
 	}
 }
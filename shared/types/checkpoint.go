@@ -0,0 +1,120 @@
+package types
+
+import (
+	"bytes"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/keystore"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/crypto"
+	"github.com/raidoNetwork/RDO_v2/shared/crypto/secp256k1"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+)
+
+// Checkpoint is the first block of the epoch voted by the validators.
+// Checkpoint is justified with votes linking it to the justified source checkpoint.
+type Checkpoint struct {
+	Epoch uint64
+	Num   uint64
+	Hash  []byte
+
+	// VotedStake is the stake of validators voted for the checkpoint
+	VotedStake uint64
+
+	// TotalStake is the stake of all validators when epoch was processed
+	TotalStake uint64
+
+	// ProcessedNum is the number of the block that justified or finalized the checkpoint
+	ProcessedNum uint64
+}
+
+// GenesisCheckpoint returns checkpoint of the Genesis block which is finalized by default
+func GenesisCheckpoint(genesis *prototype.Block) *Checkpoint {
+	return &Checkpoint{
+		Epoch: 0,
+		Num:   genesis.Num,
+		Hash:  genesis.Hash,
+	}
+}
+
+// EpochOf returns epoch of the given slot
+func EpochOf(slot uint64) uint64 {
+	return slot / params.RaidoConfig().SlotsPerEpoch
+}
+
+// NewCheckpointVote creates vote of the validator for the link from the source checkpoint to the target one
+func NewCheckpointVote(key *keystore.ValidatorAccount, source, target *Checkpoint) (*prototype.CheckpointVote, error) {
+	vote := &prototype.CheckpointVote{
+		SourceEpoch: source.Epoch,
+		SourceHash:  source.Hash,
+		TargetEpoch: target.Epoch,
+		TargetHash:  target.Hash,
+	}
+
+	sign, err := secp256k1.Sign(checkpointVoteRoot(vote), crypto.FromECDSA(key.Key()))
+	if err != nil {
+		return nil, err
+	}
+
+	vote.Voter = &prototype.Sign{
+		Address:   key.Addr().Bytes(),
+		Signature: sign,
+	}
+
+	return vote, nil
+}
+
+// VerifyCheckpointVote checks signature of the given vote
+func VerifyCheckpointVote(vote *prototype.CheckpointVote) error {
+	if vote.Voter == nil || len(vote.Voter.Signature) != crypto.SignatureLength {
+		return errors.New("Wrong signature size.")
+	}
+
+	pubKey, err := crypto.SigToPub(checkpointVoteRoot(vote), vote.Voter.Signature)
+	if err != nil {
+		return err
+	}
+
+	addr := crypto.PubkeyToAddress(*pubKey)
+	if !bytes.Equal(addr.Bytes(), vote.Voter.Address) {
+		return errors.New("Wrong signature given!!!")
+	}
+
+	return nil
+}
+
+// VoteLinks returns true if vote links the given source and target checkpoints
+func VoteLinks(vote *prototype.CheckpointVote, source, target *Checkpoint) bool {
+	return vote.SourceEpoch == source.Epoch && bytes.Equal(vote.SourceHash, source.Hash) &&
+		vote.TargetEpoch == target.Epoch && bytes.Equal(vote.TargetHash, target.Hash)
+}
+
+// CheckpointVotesRoot returns root of the given block votes signed with the block header. Root is empty if there are no votes.
+func CheckpointVotesRoot(votes []*prototype.CheckpointVote) []byte {
+	if len(votes) == 0 {
+		return nil
+	}
+
+	buf := make([]byte, 0, len(votes)*165)
+	for _, vote := range votes {
+		buf = marshalCheckpointLink(buf, vote)
+		if vote.Voter != nil {
+			buf = append(buf, vote.Voter.Address...)
+			buf = append(buf, vote.Voter.Signature...)
+		}
+	}
+
+	return crypto.Keccak256(buf)
+}
+
+func checkpointVoteRoot(vote *prototype.CheckpointVote) []byte {
+	return GetSigningDomain().Root(DomainCheckpoint, 0, vote.TargetEpoch, marshalCheckpointLink(make([]byte, 0, 80), vote))
+}
+
+func marshalCheckpointLink(buf []byte, vote *prototype.CheckpointVote) []byte {
+	buf = ssz.MarshalUint64(buf, vote.SourceEpoch)
+	buf = append(buf, vote.SourceHash...)
+	buf = ssz.MarshalUint64(buf, vote.TargetEpoch)
+	return append(buf, vote.TargetHash...)
+}
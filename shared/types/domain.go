@@ -0,0 +1,75 @@
+package types
+
+import (
+	"sync"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/raidoNetwork/RDO_v2/shared/crypto"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+)
+
+// DomainType defines the kind of signed message
+type DomainType uint32
+
+const (
+	DomainProposal    DomainType = iota + 1 // DomainProposal is used for block proposer signature
+	DomainAttestation                       // DomainAttestation is used for block approve and reject votes
+	DomainSeed                              // DomainSeed is used for validators seeds
+	DomainTx                                // DomainTx is used for transactions
+	DomainCheckpoint                        // DomainCheckpoint is used for checkpoint votes
+)
+
+// SigningDomain binds signatures to the network, so signed messages
+// can't be replayed in another network that shares the code.
+type SigningDomain struct {
+	ChainID     uint64
+	GenesisHash []byte
+}
+
+var (
+	signingDomain = &SigningDomain{GenesisHash: make([]byte, 32)}
+	domainLock    sync.RWMutex
+)
+
+// InitSigningDomain setups network data for all signatures. It should be called when Genesis is known.
+func InitSigningDomain(chainID uint64, genesisHash []byte) {
+	domainLock.Lock()
+	defer domainLock.Unlock()
+
+	signingDomain = &SigningDomain{
+		ChainID:     chainID,
+		GenesisHash: genesisHash,
+	}
+}
+
+// SigningDomainEnabled returns true if block and seed signatures of the block with given num are bound to the network domain
+func SigningDomainEnabled(num uint64) bool {
+	return num >= params.RaidoConfig().SigningDomainBlock
+}
+
+// GetSigningDomain returns the network signing domain
+func GetSigningDomain() *SigningDomain {
+	domainLock.RLock()
+	defer domainLock.RUnlock()
+
+	return signingDomain
+}
+
+// Root returns digest of the message with given kind signed in the given slot and epoch.
+// root = genSalt(Keccak256(kind + chainID + genesisHash + slot + epoch + Keccak256(message)))
+func (d *SigningDomain) Root(kind DomainType, slot, epoch uint64, msg []byte) []byte {
+	buf := make([]byte, 0, 60+len(d.GenesisHash))
+	buf = ssz.MarshalUint32(buf, uint32(kind))
+	buf = ssz.MarshalUint64(buf, d.ChainID)
+	buf = append(buf, d.GenesisHash...)
+	buf = ssz.MarshalUint64(buf, slot)
+	buf = ssz.MarshalUint64(buf, epoch)
+	buf = append(buf, crypto.Keccak256(msg)...)
+
+	return genSalt(crypto.Keccak256(buf))
+}
+
+// SlotRoot returns digest of the message with given kind signed in the given slot
+func (d *SigningDomain) SlotRoot(kind DomainType, slot uint64, msg []byte) []byte {
+	return d.Root(kind, slot, slot/params.RaidoConfig().SlotsPerEpoch, msg)
+}
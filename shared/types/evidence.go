@@ -0,0 +1,98 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+)
+
+// Slashing evidence types
+const (
+	DoubleProposal uint32 = iota + 1 // DoubleProposal validator signed two different blocks for the same slot
+	DoubleVote                       // DoubleVote validator approved and rejected the same block
+)
+
+// EvidenceEpochs is the number of epochs during which evidence can be included in the block
+const EvidenceEpochs = 4
+
+// NewSignedHeader returns signed header of the given block with proposer or attester signature
+func NewSignedHeader(block *prototype.Block, vote uint32, sign *prototype.Sign) *prototype.SignedHeader {
+	return &prototype.SignedHeader{
+		Num:            block.Num,
+		Slot:           block.Slot,
+		Version:        block.Version,
+		Parent:         block.Parent,
+		Txroot:         block.Txroot,
+		Seed:           block.Seed,
+		Vote:           vote,
+		Signature:      sign,
+		CheckpointRoot: CheckpointVotesRoot(block.CheckpointVotes),
+	}
+}
+
+// SignedBlockHeader returns block header covered by the signature of the given signed header
+func SignedBlockHeader(sh *prototype.SignedHeader) *BlockHeader {
+	return &BlockHeader{
+		Num:            sh.Num,
+		Slot:           sh.Slot,
+		Version:        sh.Version,
+		Parent:         sh.Parent,
+		TxRoot:         sh.Txroot,
+		Seed:           sh.Seed,
+		CheckpointRoot: sh.CheckpointRoot,
+	}
+}
+
+// SameSignedHeader returns true if both headers have the same signing data
+func SameSignedHeader(a, b *prototype.SignedHeader) bool {
+	return a.Num == b.Num &&
+		a.Slot == b.Slot &&
+		bytes.Equal(a.Version, b.Version) &&
+		bytes.Equal(a.Parent, b.Parent) &&
+		bytes.Equal(a.Txroot, b.Txroot) &&
+		bytes.Equal(a.Seed, b.Seed) &&
+		bytes.Equal(a.CheckpointRoot, b.CheckpointRoot)
+}
+
+// EvidenceOffender returns address of the validator that signed conflicting messages
+func EvidenceOffender(evidence *prototype.SlashingEvidence) common.Address {
+	return common.BytesToAddress(evidence.First.Signature.Address)
+}
+
+// EvidenceKey returns unique key of the offence. Validator can be slashed only once per slot.
+func EvidenceKey(evidence *prototype.SlashingEvidence) string {
+	return fmt.Sprintf("%s_%d", EvidenceOffender(evidence).Hex(), evidence.First.Slot)
+}
+
+// IsEvidenceExpired returns true if evidence of the given slot can't be included in the block with given slot anymore
+func IsEvidenceExpired(evidenceSlot, slot uint64) bool {
+	return evidenceSlot+EvidenceEpochs*params.RaidoConfig().SlotsPerEpoch < slot
+}
+
+// SlashAmount returns amount of the validator stake deposit burned for the offence
+func SlashAmount(deposit uint64) uint64 {
+	cfg := params.RaidoConfig()
+	penalty := cfg.SlashingPenalty * cfg.StakeSlotUnit * cfg.RoiPerRdo
+	if penalty > deposit {
+		return deposit
+	}
+
+	return penalty
+}
+
+// SlashOutputs returns outputs of the slash transaction for the offender with given stake deposit.
+// The rest of the deposit stays staked, penalty is burned.
+func SlashOutputs(offender common.Address, deposit uint64) []*prototype.TxOutput {
+	penalty := SlashAmount(deposit)
+	outputs := make([]*prototype.TxOutput, 0, 2)
+
+	if deposit > penalty {
+		outputs = append(outputs, NewOutput(offender.Bytes(), deposit-penalty, common.HexToAddress(common.BlackHoleAddress).Bytes()))
+	}
+
+	outputs = append(outputs, NewOutput(common.HexToAddress(common.BlackHoleAddress).Bytes(), penalty, nil))
+	return outputs
+}
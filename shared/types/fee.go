@@ -0,0 +1,121 @@
+package types
+
+import (
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+)
+
+const (
+	// blockSizeElasticity is the ratio of the block size limit to the block size target
+	blockSizeElasticity = 2
+
+	// baseFeeChangeDenominator bounds base fee change between blocks to 1/8
+	baseFeeChangeDenominator = 8
+)
+
+// FeeSuggestion is the fee price per byte suggested for the next block
+type FeeSuggestion struct {
+	BaseFee  uint64 // BaseFee is the base fee per byte of the next block
+	Tip      uint64 // Tip is the suggested fee price above the base fee paid to the proposer
+	FeePrice uint64 // FeePrice is the suggested transaction fee price
+}
+
+// FeeMarketEnabled returns true if block with given num burns base fee and pays tips to the proposer
+func FeeMarketEnabled(num uint64) bool {
+	return num >= params.RaidoConfig().FeeMarketBlock
+}
+
+// BlockTxSize returns size of the block transactions in bytes without the fee transaction
+func BlockTxSize(block *prototype.Block) int {
+	var total int
+	for _, tx := range block.GetTransactions() {
+		if tx.Type != common.FeeTxType {
+			total += tx.SizeSSZ()
+		}
+	}
+
+	return total
+}
+
+// NextBaseFee returns base fee per byte of the block following the given parent. Base fee rises when the parent
+// is larger than the half of the BlockSize and falls when it is smaller, but not lower than the MinimalFee.
+func NextBaseFee(parent *prototype.Block) uint64 {
+	cfg := params.RaidoConfig()
+	if !FeeMarketEnabled(parent.Num + 1) {
+		return 0
+	}
+
+	baseFee := parent.BaseFee
+	if baseFee < cfg.MinimalFee {
+		baseFee = cfg.MinimalFee
+	}
+
+	target := uint64(cfg.BlockSize / blockSizeElasticity)
+	if target == 0 {
+		return baseFee
+	}
+
+	size := uint64(BlockTxSize(parent))
+	switch {
+	case size > target:
+		delta := baseFee * (size - target) / target / baseFeeChangeDenominator
+		if delta == 0 {
+			delta = 1
+		}
+
+		baseFee += delta
+	case size < target:
+		delta := baseFee * (target - size) / target / baseFeeChangeDenominator
+		if delta == 0 {
+			delta = 1
+		}
+
+		if baseFee < cfg.MinimalFee+delta {
+			baseFee = cfg.MinimalFee
+		} else {
+			baseFee -= delta
+		}
+	}
+
+	return baseFee
+}
+
+// SplitFee returns the burned base fee part and the proposer tip of the transaction fee
+func SplitFee(tx *prototype.Transaction, baseFee uint64) (uint64, uint64) {
+	fee := tx.GetRealFee()
+
+	burn := baseFee * uint64(tx.SizeSSZ())
+	if burn > fee {
+		burn = fee
+	}
+
+	return burn, fee - burn
+}
+
+// FeeOutputs returns outputs of the fee transaction of the block with given transactions.
+// Base fee is burned and tips are paid to the proposer. Before the fee market all fees are burned.
+func FeeOutputs(txs []*prototype.Transaction, num, baseFee uint64, proposer []byte) []*prototype.TxOutput {
+	var burn, tip uint64
+	for _, tx := range txs {
+		txBurn, txTip := SplitFee(tx, baseFee)
+		burn += txBurn
+		tip += txTip
+	}
+
+	if !FeeMarketEnabled(num) {
+		burn += tip
+		tip = 0
+	}
+
+	outputs := make([]*prototype.TxOutput, 0, 2)
+	if burn > 0 {
+		outputs = append(outputs, NewOutput(common.HexToAddress(common.BlackHoleAddress), burn, nil))
+	}
+
+	if tip > 0 {
+		outputs = append(outputs, NewOutput(proposer, tip, nil))
+	}
+
+	return outputs
+}
@@ -0,0 +1,17 @@
+package types
+
+// PoolStatus is the status of the transaction in the transaction pool
+type PoolStatus string
+
+const (
+	PoolQueued  PoolStatus = "queued"  // PoolQueued transaction waits for the previous sender nonces
+	PoolPending PoolStatus = "pending" // PoolPending transaction can be forged in the next block
+	PoolForged  PoolStatus = "forged"  // PoolForged transaction is included in the block
+	PoolDropped PoolStatus = "dropped" // PoolDropped transaction is removed from the pool without inclusion
+)
+
+// PoolEvent notifies about the transaction pool status change
+type PoolEvent struct {
+	Tx     *Transaction
+	Status PoolStatus
+}
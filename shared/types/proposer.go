@@ -0,0 +1,78 @@
+package types
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+)
+
+// Slot is divided into the equal proposer windows. Leader proposes in the first window,
+// and if there is no proposal the next ranked validator may propose in the next one.
+
+// ProposerRanks returns count of validators allowed to propose in one slot
+func ProposerRanks() int {
+	timeout := params.RaidoConfig().ProposerTimeout
+	if timeout == 0 || timeout >= 100 {
+		return 1
+	}
+
+	return int((99 + timeout) / timeout)
+}
+
+// SlotStartTime returns timestamp of the slot start in nanoseconds.
+// Slots are counted from the Genesis time truncated to seconds like the slot ticker does.
+func SlotStartTime(genesisTime, slot uint64) uint64 {
+	second := uint64(time.Second)
+	slotTime := uint64(params.RaidoConfig().SlotTime) * second
+
+	return genesisTime/second*second + slot*slotTime
+}
+
+// ProposerWindow returns timestamps bounds [from, to) of the proposer with given rank in the slot
+func ProposerWindow(genesisTime, slot uint64, rank int) (uint64, uint64) {
+	start := SlotStartTime(genesisTime, slot)
+	slotTime := uint64(params.RaidoConfig().SlotTime) * uint64(time.Second)
+
+	if ProposerRanks() == 1 {
+		return start, start + slotTime
+	}
+
+	timeout := slotTime * params.RaidoConfig().ProposerTimeout / 100
+	from := start + uint64(rank)*timeout
+	to := from + timeout
+	if rank == ProposerRanks()-1 {
+		to = start + slotTime
+	}
+
+	return from, to
+}
+
+// ProposalDrift returns allowed difference in nanoseconds between the local arrival time of the block
+// and its timestamp or the proposer window bounds. It is equal to the NTP clock drift threshold.
+func ProposalDrift() uint64 {
+	return uint64(params.RaidoConfig().NTPThreshold) * uint64(time.Millisecond)
+}
+
+// CheckProposalArrival returns error if the block of the proposer with given rank arrived at the given local time
+// out of the proposer window or the block timestamp differs from the arrival time more than allowed drift.
+// Block timestamp is set by the proposer, so only the local time proves that proposal was made in time.
+func CheckProposalArrival(genesisTime uint64, block *prototype.Block, rank int, arrival uint64) error {
+	drift := ProposalDrift()
+
+	if block.Timestamp+drift < arrival || arrival+drift < block.Timestamp {
+		return errors.Errorf("Block timestamp %d differs from the arrival time %d more than %d ns", block.Timestamp, arrival, drift)
+	}
+
+	from, to := ProposerWindow(genesisTime, block.Slot, rank)
+	if arrival+drift < from {
+		return errors.Errorf("Block of the proposer with rank %d arrived before the window. Arrival: %d. Window: %d - %d.", rank, arrival, from, to)
+	}
+
+	if arrival >= to+drift {
+		return errors.Errorf("Block of the proposer with rank %d arrived after the window. Arrival: %d. Window: %d - %d.", rank, arrival, from, to)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+)
+
+// maxReceiptReasonSize is the size limit of the failed transaction reason in the receipt
+const maxReceiptReasonSize = 256
+
+// NewReceipt creates receipt of the block transaction with given index.
+// Transactions are included in the block only if they are executed, so transaction without failed status is successful.
+func NewReceipt(block *prototype.Block, index int) (*prototype.Receipt, error) {
+	if index < 0 || index >= len(block.Transactions) {
+		return nil, errors.Errorf("Wrong transaction index %d with block transaction count: %d", index, len(block.Transactions))
+	}
+
+	tx := block.Transactions[index]
+
+	receipt := &prototype.Receipt{
+		TxHash:    tx.Hash,
+		BlockHash: block.Hash,
+		BlockNum:  block.Num,
+		Index:     uint32(index),
+		Status:    uint32(TxSuccess),
+		Fee:       tx.GetRealFee(),
+		Created:   make([]*prototype.OutputRef, 0, len(tx.Outputs)),
+		Spent:     make([]*prototype.OutputRef, 0, len(tx.Inputs)),
+	}
+
+	receipt.BurnedFee, receipt.Tip = SplitFee(tx, block.BaseFee)
+	if !FeeMarketEnabled(block.Num) {
+		receipt.BurnedFee += receipt.Tip
+		receipt.Tip = 0
+	}
+
+	// failed transaction doesn't change outputs
+	if ExecutionStatus(tx.Status) == TxFailed {
+		receipt.Status = uint32(TxFailed)
+		return receipt, nil
+	}
+
+	for i := range tx.Outputs {
+		receipt.Created = append(receipt.Created, &prototype.OutputRef{
+			Hash:  tx.Hash,
+			Index: uint32(i),
+		})
+	}
+
+	for _, in := range tx.Inputs {
+		receipt.Spent = append(receipt.Spent, &prototype.OutputRef{
+			Hash:  in.Hash,
+			Index: in.Index,
+		})
+	}
+
+	return receipt, nil
+}
+
+// NewFailedReceipt creates receipt of the block transaction failed validation with its validation error.
+// Too long reason is truncated.
+func NewFailedReceipt(block *prototype.Block, tx *Transaction) (*prototype.Receipt, error) {
+	index := -1
+	for i, btx := range block.Transactions {
+		if bytes.Equal(tx.Hash(), btx.Hash) {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return nil, errors.Errorf("Transaction %s is not found in the block %d", tx.Hash().Hex(), block.Num)
+	}
+
+	receipt, err := NewReceipt(block, index)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := tx.FailReason()
+	if len(reason) > maxReceiptReasonSize {
+		reason = reason[:maxReceiptReasonSize]
+	}
+
+	receipt.Status = uint32(TxFailed)
+	receipt.Created = receipt.Created[:0]
+	receipt.Spent = receipt.Spent[:0]
+	receipt.Reason = []byte(reason)
+
+	return receipt, nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import "github.com/raidoNetwork/RDO_v2/proto/prototype"
+
+// Reorg describes switch of the canonical chain to the heavier branch
+type Reorg struct {
+	// Ancestor is the last common block of both branches
+	Ancestor *prototype.Block
+
+	// Reverted blocks of the previous canonical branch ordered by num
+	Reverted []*prototype.Block
+
+	// Applied blocks of the new canonical branch ordered by num
+	Applied []*prototype.Block
+}
+
+// OldHead returns head block before reorg
+func (r *Reorg) OldHead() *prototype.Block {
+	return r.Reverted[len(r.Reverted)-1]
+}
+
+// NewHead returns head block after reorg
+func (r *Reorg) NewHead() *prototype.Block {
+	return r.Applied[len(r.Applied)-1]
+}
@@ -1,25 +1,75 @@
 package types
 
 import (
+	"bytes"
 	"crypto/rand"
-	"math/big"
+	"encoding/binary"
+	"math"
+	"sort"
 
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/keystore"
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/crypto"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
 )
 
-// This package is used for generating random seeds so validators
-// can agree on a single random number for determining the proposer
-func NewSeed(key *keystore.ValidatorAccount) (*prototype.Seed, error) {
-	big, err := rand.Int(rand.Reader, big.NewInt(1<<32))
-	if err != nil {
+// SeedSecretSize is a size of the validator secret used in the commit-reveal round
+const SeedSecretSize = 32
+
+// committeeDomain separates committee sampling seed from the proposer seed of the same mix
+var committeeDomain = []byte("committee")
+
+var (
+	// EmptyReveal is used by validators that have no commitment in the parent block
+	EmptyReveal = make([]byte, SeedSecretSize)
+
+	ErrRevealMismatch   = errors.New("Seed reveal doesn't match the commitment")
+	ErrUnexpectedReveal = errors.New("Seed reveals secret without commitment in the parent block")
+)
+
+// Validators agree on a single random number for determining the proposer
+// with the commit-reveal scheme. Every seed reveals the secret committed
+// in the parent block and commits to the new one. Revealed secrets are
+// mixed into the block seed, so any node can replay proposer selection.
+// Block of the next slot must reveal all parent commitments, otherwise
+// the proposer could choose the mix by omitting reveals.
+
+// SeedEnabled returns true if block with given num carries validated seed mix and reveals
+func SeedEnabled(num uint64) bool {
+	return num >= params.RaidoConfig().SeedBlock
+}
+
+// NewSeedSecret generates random secret for the next commit-reveal round
+func NewSeedSecret() ([]byte, error) {
+	secret := make([]byte, SeedSecretSize)
+	if _, err := rand.Read(secret); err != nil {
 		return nil, err
 	}
-	intseed := uint32(big.Int64())
-	seed := &prototype.Seed{Seed: intseed, Proposer: &prototype.Sign{}}
+
+	return secret, nil
+}
+
+// SeedCommitment returns commitment to the given secret
+func SeedCommitment(secret []byte) []byte {
+	return crypto.Keccak256(secret)
+}
+
+// NewSeed creates signed seed for the given slot with reveal of the previous secret and commitment to the next one.
+// Seed is signed for the block with given num.
+func NewSeed(key *keystore.ValidatorAccount, num, slot, epoch uint64, reveal, commitment []byte) (*prototype.Seed, error) {
+	seed := &prototype.Seed{
+		Slot:       slot,
+		Epoch:      epoch,
+		Commitment: commitment,
+		Reveal:     reveal,
+		Proposer:   &prototype.Sign{},
+	}
 	signer := GetSeedSigner()
 
-	signature, err := signer.Sign(seed, key.Key())
+	signature, err := signer.Sign(seed, num, key.Key())
 	if err != nil {
 		return nil, err
 	}
@@ -31,6 +81,121 @@ func NewSeed(key *keystore.ValidatorAccount) (*prototype.Seed, error) {
 	return seed, nil
 }
 
+// SeedCommitments returns validators commitments published in the given block
+func SeedCommitments(block *prototype.Block) map[string][]byte {
+	commitments := make(map[string][]byte, len(block.Reveals))
+	for _, seed := range block.Reveals {
+		commitments[common.BytesToAddress(seed.Proposer.Address).Hex()] = seed.Commitment
+	}
+
+	return commitments
+}
+
+// CheckReveal verifies seed reveal with the commitment of the same validator from the parent block
+func CheckReveal(seed *prototype.Seed, commitments map[string][]byte) error {
+	commitment, exists := commitments[common.BytesToAddress(seed.Proposer.Address).Hex()]
+	if !exists {
+		if !bytes.Equal(seed.Reveal, EmptyReveal) {
+			return ErrUnexpectedReveal
+		}
+
+		return nil
+	}
+
+	if !bytes.Equal(SeedCommitment(seed.Reveal), commitment) {
+		return ErrRevealMismatch
+	}
+
+	return nil
+}
+
+// RevealCommitments returns parent commitments that should be revealed in the block of the given slot.
+// Commitments expire when the next slot is skipped, and commitments of the blocks before SeedBlock are ignored.
+func RevealCommitments(parent *prototype.Block, slot uint64) map[string][]byte {
+	if !SeedEnabled(parent.Num) || slot != parent.Slot+1 {
+		return map[string][]byte{}
+	}
+
+	return SeedCommitments(parent)
+}
+
+// MissedReveals returns sorted validators which commitments are not opened with the given reveals
+func MissedReveals(commitments map[string][]byte, reveals []*prototype.Seed) []string {
+	revealed := make(map[string]struct{}, len(reveals))
+	for _, seed := range reveals {
+		revealed[common.BytesToAddress(seed.Proposer.Address).Hex()] = struct{}{}
+	}
+
+	missed := make([]string, 0)
+	for addr := range commitments {
+		if _, exists := revealed[addr]; !exists {
+			missed = append(missed, addr)
+		}
+	}
+
+	sort.Strings(missed)
+
+	return missed
+}
+
+// ExpiredCommitments returns validators which parent commitments can't be revealed in the block of the given slot
+func ExpiredCommitments(parent *prototype.Block, slot uint64) []string {
+	if !SeedEnabled(parent.Num) || slot == parent.Slot+1 {
+		return nil
+	}
+
+	return MissedReveals(SeedCommitments(parent), nil)
+}
+
+// BlockSeed returns randomness mix of the given block. Genesis mix and mix of the blocks before SeedBlock are equal to the block hash.
+func BlockSeed(block *prototype.Block) []byte {
+	if len(block.Seed) == 0 || !SeedEnabled(block.Num) {
+		return block.Hash
+	}
+
+	return block.Seed
+}
+
+// SeedMix counts randomness mix of the block with given slot and reveals ordered by validator address.
+// Empty reveals of the validators without parent commitment are skipped, so they don't change the mix.
+// mix = Keccak256(parent mix + slot + reveal_1 + ... + reveal_n)
+func SeedMix(parentSeed []byte, slot uint64, reveals []*prototype.Seed) []byte {
+	buf := make([]byte, 0, len(parentSeed)+8+len(reveals)*SeedSecretSize)
+	buf = append(buf, parentSeed...)
+	buf = ssz.MarshalUint64(buf, slot)
+
+	for _, seed := range reveals {
+		if bytes.Equal(seed.Reveal, EmptyReveal) {
+			continue
+		}
+
+		buf = append(buf, seed.Reveal...)
+	}
+
+	return crypto.Keccak256(buf)
+}
+
+// ProposerSeed returns the seed of proposer selection for the slot following block with given mix
+func ProposerSeed(mix []byte, slot uint64) int64 {
+	buf := make([]byte, 0, len(mix)+8)
+	buf = append(buf, mix...)
+	buf = ssz.MarshalUint64(buf, slot)
+
+	h := crypto.Keccak256(buf)
+	return int64(binary.LittleEndian.Uint64(h[:8]) & math.MaxInt64)
+}
+
+// CommitteeSeed returns the seed of committee sampling for the epoch. Mix is taken from the last block before the epoch.
+func CommitteeSeed(mix []byte, epoch uint64) int64 {
+	buf := make([]byte, 0, len(mix)+len(committeeDomain)+8)
+	buf = append(buf, mix...)
+	buf = append(buf, committeeDomain...)
+	buf = ssz.MarshalUint64(buf, epoch)
+
+	h := crypto.Keccak256(buf)
+	return int64(binary.LittleEndian.Uint64(h[:8]) & math.MaxInt64)
+}
+
 var seedSigner SeedSigner
 
 func GetSeedSigner() SeedSigner {
@@ -3,7 +3,6 @@ package types
 import (
 	"bytes"
 	"crypto/ecdsa"
-	"encoding/binary"
 	"fmt"
 
 	ssz "github.com/ferranbt/fastssz"
@@ -25,6 +24,9 @@ type BlockSigner interface {
 
 	// VerifyMixed verify sign of given block with mixed value
 	VerifyMixed(*BlockHeader, []byte, *prototype.Sign) error
+
+	// SigningRoot returns digest signed for the given block with mixed value. Empty mix is used for the block proposal.
+	SigningRoot(*BlockHeader, []byte) []byte
 }
 
 type KeccakBlockSigner struct{}
@@ -63,6 +65,10 @@ func (kbs *KeccakBlockSigner) VerifyMixed(header *BlockHeader, mix []byte, sign
 	return kbs.verifyRaw(dgst, sign)
 }
 
+func (kbs *KeccakBlockSigner) SigningRoot(header *BlockHeader, mix []byte) []byte {
+	return kbs.getBlockDomain(header, mix)
+}
+
 func (kbs *KeccakBlockSigner) verifyRaw(dgst []byte, sign *prototype.Sign) error {
 	pubKey, err := crypto.SigToPub(dgst, sign.Signature)
 	if err != nil {
@@ -87,12 +93,21 @@ func (kbs *KeccakBlockSigner) getBlockDomain(header *BlockHeader, mix []byte) []
 	buf = append(buf, header.Parent...)
 	buf = append(buf, header.Version...)
 	buf = append(buf, header.TxRoot...)
+	buf = append(buf, header.Seed...)
+	buf = append(buf, header.CheckpointRoot...)
 
+	kind := DomainProposal
 	if len(mix) > 0 {
 		buf = append(buf, mix...)
+		kind = DomainAttestation
 	}
 
-	return crypto.Keccak256Hash(buf)
+	// legacy blocks are signed without network domain
+	if !SigningDomainEnabled(header.Num) {
+		return crypto.Keccak256Hash(buf).Bytes()
+	}
+
+	return GetSigningDomain().SlotRoot(kind, header.Slot, buf)
 }
 
 func MakeBlockSigner(signType string) BlockSigner {
@@ -161,7 +176,12 @@ func (s *KeccakTxSigner) Verify(tx *prototype.Transaction) error {
 }
 
 func (s *KeccakTxSigner) GetTxDomain(tx *prototype.Transaction) []byte {
-	return genSalt(crypto.Keccak256(tx.Hash))
+	// legacy transactions are signed without network domain
+	if tx.Version == LegacyTxVersion {
+		return genSalt(crypto.Keccak256(tx.Hash))
+	}
+
+	return GetSigningDomain().Root(DomainTx, 0, 0, tx.Hash)
 }
 
 func genSalt(hash []byte) []byte {
@@ -171,11 +191,11 @@ func genSalt(hash []byte) []byte {
 
 type KeccakSeedSigner struct{}
 
-func (s *KeccakSeedSigner) Sign(seed *prototype.Seed, key *ecdsa.PrivateKey) ([]byte, error) {
+func (s *KeccakSeedSigner) Sign(seed *prototype.Seed, num uint64, key *ecdsa.PrivateKey) ([]byte, error) {
 	if key == nil {
 		return nil, errors.New("Empty private key.")
 	}
-	dgst := s.GetSeedDomain(seed)
+	dgst := s.GetSeedDomain(seed, num)
 	kdst := crypto.FromECDSA(key)
 	sign, err := secp256k1.Sign(dgst, kdst)
 	if err != nil {
@@ -185,12 +205,12 @@ func (s *KeccakSeedSigner) Sign(seed *prototype.Seed, key *ecdsa.PrivateKey) ([]
 	return sign, nil
 }
 
-func (s *KeccakSeedSigner) Verify(seed *prototype.Seed) error {
+func (s *KeccakSeedSigner) Verify(seed *prototype.Seed, num uint64) error {
 	if len(seed.Proposer.Signature) != crypto.SignatureLength {
 		return errors.New("Wrong signature size.")
 	}
 
-	dgst := s.GetSeedDomain(seed)
+	dgst := s.GetSeedDomain(seed, num)
 	sign := seed.Proposer.Signature
 
 	pubKey, err := crypto.SigToPub(dgst, sign)
@@ -206,19 +226,30 @@ func (s *KeccakSeedSigner) Verify(seed *prototype.Seed) error {
 	return nil
 }
 
-func (s *KeccakSeedSigner) GetSeedDomain(seed *prototype.Seed) []byte {
-	bs := make([]byte, 4)
-	binary.LittleEndian.PutUint32(bs, seed.Seed)
-	salt := genSalt(crypto.Keccak256(bs))
-	return salt
+// GetSeedDomain returns digest of the seed revealed in the block with given num
+func (s *KeccakSeedSigner) GetSeedDomain(seed *prototype.Seed, num uint64) []byte {
+	// legacy seeds are signed without network domain
+	if !SigningDomainEnabled(num) {
+		bs := make([]byte, 0, 16+len(seed.Commitment)+len(seed.Reveal))
+		bs = ssz.MarshalUint64(bs, seed.Slot)
+		bs = ssz.MarshalUint64(bs, seed.Epoch)
+		bs = append(bs, seed.Commitment...)
+		bs = append(bs, seed.Reveal...)
+		return genSalt(crypto.Keccak256(bs))
+	}
+
+	bs := make([]byte, 0, len(seed.Commitment)+len(seed.Reveal))
+	bs = append(bs, seed.Commitment...)
+	bs = append(bs, seed.Reveal...)
+	return GetSigningDomain().Root(DomainSeed, seed.Slot, seed.Epoch, bs)
 }
 
 type SeedSigner interface {
-	// Sign given digest with given key.
-	Sign(*prototype.Seed, *ecdsa.PrivateKey) ([]byte, error)
+	// Sign seed revealed in the block with given num with given key.
+	Sign(*prototype.Seed, uint64, *ecdsa.PrivateKey) ([]byte, error)
 
-	// Verify sign of given input.
-	Verify(seed *prototype.Seed) error
+	// Verify sign of seed revealed in the block with given num.
+	Verify(seed *prototype.Seed, num uint64) error
 }
 
 func MakeSeedSigner(signType string) SeedSigner {
@@ -8,6 +8,7 @@ import (
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
 	"github.com/raidoNetwork/RDO_v2/shared/crypto"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
 	"github.com/raidoNetwork/RDO_v2/utils/hash"
 	"github.com/sirupsen/logrus"
 )
@@ -19,6 +20,22 @@ const (
 	TxFailed
 )
 
+func (s ExecutionStatus) String() string {
+	switch s {
+	case TxSuccess:
+		return "success"
+	case TxFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	LegacyTxVersion  uint32 = 0 // LegacyTxVersion transactions are signed without network domain
+	CurrentTxVersion uint32 = 1 // CurrentTxVersion transactions are signed with network domain
+)
+
 var log = logrus.WithField("prefix", "types")
 
 var txSigner TxSigner
@@ -34,13 +51,16 @@ func getTxSigner() TxSigner {
 }
 
 type TxOptions struct {
-	Inputs    []*prototype.TxInput
-	Outputs   []*prototype.TxOutput
-	Fee       uint64
-	Data      []byte
-	Num       uint64
-	Type      uint32
-	Timestamp uint64
+	Inputs     []*prototype.TxInput
+	Outputs    []*prototype.TxOutput
+	Fee        uint64
+	Data       []byte
+	Num        uint64
+	Type       uint32
+	Timestamp  uint64
+	Legacy     bool   // Legacy creates transaction without version in the hash
+	ValidAfter uint64 // ValidAfter is the first block number transaction can be included in
+	ValidUntil uint64 // ValidUntil is the last block number transaction can be included in
 }
 
 // NewPbTransaction creates new transaction with given options
@@ -53,6 +73,13 @@ func NewPbTransaction(opts TxOptions, key *ecdsa.PrivateKey) (*prototype.Transac
 	tx.Data = opts.Data
 	tx.Inputs = opts.Inputs
 	tx.Outputs = opts.Outputs
+	tx.Version = CurrentTxVersion
+	tx.ValidAfter = opts.ValidAfter
+	tx.ValidUntil = opts.ValidUntil
+
+	if opts.Legacy {
+		tx.Version = LegacyTxVersion
+	}
 
 	if opts.Timestamp > 0 {
 		tx.Timestamp = opts.Timestamp
@@ -159,6 +186,9 @@ type Transaction struct {
 	// tx state
 	dropped bool
 	forged  bool
+
+	// reason is the validation error of the failed transaction
+	reason string
 }
 
 func (tx *Transaction) GetTx() *prototype.Transaction {
@@ -206,6 +236,35 @@ func (tx *Transaction) Type() uint32 {
 	return tx.txType
 }
 
+func (tx *Transaction) Version() uint32 {
+	return tx.tx.Version
+}
+
+// ValidAfter returns the first block number transaction can be included in, 0 means no bound
+func (tx *Transaction) ValidAfter() uint64 {
+	return tx.tx.ValidAfter
+}
+
+// ValidUntil returns the last block number transaction can be included in, 0 means no bound
+func (tx *Transaction) ValidUntil() uint64 {
+	return tx.tx.ValidUntil
+}
+
+// ValidAt returns true if transaction can be included in the block with given number
+func (tx *Transaction) ValidAt(num uint64) bool {
+	return num >= tx.tx.ValidAfter && !tx.Expired(num)
+}
+
+// Expired returns true if transaction can't be included in the block with given number or any later block
+func (tx *Transaction) Expired(num uint64) bool {
+	return tx.tx.ValidUntil > 0 && num > tx.tx.ValidUntil
+}
+
+// SenderNoncesEnabled returns true if block with given num can include consecutive nonces of one sender
+func SenderNoncesEnabled(num uint64) bool {
+	return num >= params.RaidoConfig().SenderNonceBlock
+}
+
 func (tx *Transaction) Inputs() []*Input {
 	return tx.inputs
 }
@@ -270,6 +329,22 @@ func (tx *Transaction) SetStatus(status ExecutionStatus) {
 	tx.lock.Unlock()
 }
 
+// SetFailed marks transaction as failed with the given validation error
+func (tx *Transaction) SetFailed(err error) {
+	tx.lock.Lock()
+	tx.tx.Status = uint32(TxFailed)
+	tx.reason = err.Error()
+	tx.lock.Unlock()
+}
+
+// FailReason returns validation error of the failed transaction
+func (tx *Transaction) FailReason() string {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+
+	return tx.reason
+}
+
 func (tx *Transaction) Status() ExecutionStatus {
 	tx.lock.Lock()
 	defer tx.lock.Unlock()
@@ -18,6 +18,16 @@ type UTxO struct {
 	Timestamp uint64         `json:"timestamp"`
 	ID        uint64
 	TxType    uint32 `json:"txType"`
+
+	// spent output data, SpentBlock is zero for the unspent output
+	SpentBlock  uint64      `json:"spentBlock"`
+	SpentTxHash common.Hash `json:"spentTxHash"`
+	SpentIndex  uint32      `json:"spentIndex"`
+}
+
+// IsSpent returns true if output is spent by some transaction input
+func (uo *UTxO) IsSpent() bool {
+	return uo.SpentBlock > 0
 }
 
 func (uo *UTxO) ToOutput() *prototype.TxOutput {
@@ -51,11 +61,6 @@ func (uo *UTxO) ToString() string {
 		uo.Timestamp)
 }
 
-func (uo *UTxO) ToInsertQuery() string {
-	return fmt.Sprintf("(%d, \"%s\", %d, \"%s\", \"%s\", \"%s\", %d, %d, %d)",
-		uo.TxType, uo.Hash.Hex(), uo.Index, uo.From.Hex(), uo.To.Hex(), uo.Node.Hex(), uo.Amount, uo.Timestamp, uo.BlockNum)
-}
-
 func NewUTxO(hash, from, to, node []byte, index uint32, amount uint64, blockNum uint64, typev uint32, tstamp uint64) *UTxO {
 	if tstamp == 0 {
 		tstamp = uint64(time.Now().UnixNano())
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+)
+
+// ValidatorSet is the set of validators allowed to propose and vote for blocks in the epoch.
+// Set is updated at the epoch boundaries according to the staking state.
+type ValidatorSet struct {
+	Epoch uint64
+
+	// Active validators sorted by address
+	Active []string
+
+	// ActivationQueue holds staked validators waiting for activation in the order of arrival
+	ActivationQueue []string
+
+	// ExitQueue holds active validators without stake waiting for exit in the order of arrival
+	ExitQueue []string
+
+	// ProcessedNum is the number of the block that started the epoch
+	ProcessedNum uint64
+
+	// Weights are selection weights of the active validators taken from the stake when the set was built,
+	// so stake changes during the epoch don't affect proposers and committee sampling
+	Weights map[string]uint64
+}
+
+// GenesisValidatorSet returns validator set bootstrapped with the given validators list
+func GenesisValidatorSet(validators []string) *ValidatorSet {
+	active := make([]string, 0, len(validators))
+	for _, validator := range validators {
+		active = append(active, common.HexToAddress(validator).Hex())
+	}
+	sort.Strings(active)
+
+	return &ValidatorSet{
+		Epoch:           0,
+		Active:          active,
+		ActivationQueue: make([]string, 0),
+		ExitQueue:       make([]string, 0),
+		Weights:         map[string]uint64{},
+	}
+}
+
+// IsActive returns true if given validator is in the active set
+func (vs *ValidatorSet) IsActive(validator string) bool {
+	i := sort.SearchStrings(vs.Active, validator)
+	return i < len(vs.Active) && vs.Active[i] == validator
+}
+
+// Copy returns deep copy of the validator set
+func (vs *ValidatorSet) Copy() *ValidatorSet {
+	weights := make(map[string]uint64, len(vs.Weights))
+	for validator, weight := range vs.Weights {
+		weights[validator] = weight
+	}
+
+	return &ValidatorSet{
+		Epoch:           vs.Epoch,
+		Active:          append([]string{}, vs.Active...),
+		ActivationQueue: append([]string{}, vs.ActivationQueue...),
+		ExitQueue:       append([]string{}, vs.ExitQueue...),
+		ProcessedNum:    vs.ProcessedNum,
+		Weights:         weights,
+	}
+}
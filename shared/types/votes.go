@@ -0,0 +1,76 @@
+package types
+
+import (
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+)
+
+// AggregateVotes packs signatures of the committee members into the bitfield with signatures ordered by the committee.
+// Committee should be ordered by address.
+func AggregateVotes(committee []string, signatures map[string][]byte) *prototype.AggregatedVotes {
+	votes := &prototype.AggregatedVotes{
+		Bits:       make([]byte, (len(committee)+7)/8),
+		Signatures: make([][]byte, 0, len(signatures)),
+	}
+
+	for i, validator := range committee {
+		signature, exists := signatures[validator]
+		if !exists {
+			continue
+		}
+
+		votes.Bits[i/8] |= 1 << (i % 8)
+		votes.Signatures = append(votes.Signatures, signature)
+	}
+
+	return votes
+}
+
+// UnpackVotes returns signatures of the aggregated votes with addresses of the committee members
+func UnpackVotes(votes *prototype.AggregatedVotes, committee []string) ([]*prototype.Sign, error) {
+	if votes == nil || len(votes.Bits) == 0 {
+		return []*prototype.Sign{}, nil
+	}
+
+	if len(votes.Bits) != (len(committee)+7)/8 {
+		return nil, errors.Errorf("Votes bitfield size mismatch. Expected: %d. Given: %d.", (len(committee)+7)/8, len(votes.Bits))
+	}
+
+	signs := make([]*prototype.Sign, 0, len(votes.Signatures))
+	for i := 0; i < len(votes.Bits)*8; i++ {
+		if votes.Bits[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+
+		if i >= len(committee) {
+			return nil, errors.New("Votes bitfield has bits out of the committee")
+		}
+
+		if len(signs) == len(votes.Signatures) {
+			return nil, errors.New("Votes bitfield has more bits than signatures")
+		}
+
+		signs = append(signs, &prototype.Sign{
+			Address:   common.HexToAddress(committee[i]).Bytes(),
+			Signature: votes.Signatures[len(signs)],
+		})
+	}
+
+	if len(signs) != len(votes.Signatures) {
+		return nil, errors.New("Votes bitfield has less bits than signatures")
+	}
+
+	return signs, nil
+}
+
+// CommitteeEnabled returns true if block with given num is voted by the epoch committee with aggregated votes
+func CommitteeEnabled(num uint64) bool {
+	return num >= params.RaidoConfig().CommitteeBlock
+}
+
+// HasAggregatedVotes returns true if block votes are aggregated by the proposer
+func HasAggregatedVotes(block *prototype.Block) bool {
+	return (block.Approvals != nil && len(block.Approvals.Bits) > 0) || (block.Rejections != nil && len(block.Rejections.Bits) > 0)
+}
@@ -15,8 +15,8 @@ const (
 var log = logrus.WithField("prefix", "Hasher")
 
 // BlockHash count block hash
-// hash = Keccak256(num + slot + version + parentHash + txRoot + timestamp + proposer address)
-func BlockHash(num, slot uint64, version, parent, txroot []byte, tstamp uint64, proposer []byte) []byte {
+// hash = Keccak256(num + slot + version + parentHash + txRoot + seed + checkpointRoot + timestamp + proposer address)
+func BlockHash(num, slot uint64, version, parent, txroot, seed, checkpointRoot []byte, tstamp uint64, proposer []byte) []byte {
 	res := make([]byte, 0, 8)
 	res = ssz.MarshalUint64(res, num)
 	res = ssz.MarshalUint64(res, slot)
@@ -24,6 +24,8 @@ func BlockHash(num, slot uint64, version, parent, txroot []byte, tstamp uint64,
 	res = append(res, version...)
 	res = append(res, parent...)
 	res = append(res, txroot...)
+	res = append(res, seed...)
+	res = append(res, checkpointRoot...)
 
 	res = ssz.MarshalUint64(res, tstamp)
 
@@ -36,7 +38,8 @@ func BlockHash(num, slot uint64, version, parent, txroot []byte, tstamp uint64,
 }
 
 // TxHash count tx hash
-// hash = Keccak256(num + fee + outputs + inputs + data + type + timestamp)
+// hash = Keccak256(num + fee + outputs + inputs + data + type + timestamp + version + validAfter + validUntil)
+// Version is omitted for legacy transactions. Validity window is omitted for transactions without it.
 func TxHash(tx *prototype.Transaction) (common.Hash, error) {
 	var buf []byte
 
@@ -89,6 +92,15 @@ func TxHash(tx *prototype.Transaction) (common.Hash, error) {
 	//put timestamp
 	buf = ssz.MarshalUint64(buf, tx.Timestamp)
 
+	if tx.Version > 0 {
+		buf = ssz.MarshalUint32(buf, tx.Version)
+	}
+
+	if tx.ValidAfter > 0 || tx.ValidUntil > 0 {
+		buf = ssz.MarshalUint64(buf, tx.ValidAfter)
+		buf = ssz.MarshalUint64(buf, tx.ValidUntil)
+	}
+
 	hash = crypto.Keccak256Hash(buf)
 
 	return hash, nil
@@ -0,0 +1,38 @@
+package serialize
+
+import (
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+)
+
+func UnmarshalCheckpointVote(enc []byte) (*prototype.CheckpointVote, error) {
+	vote := &prototype.CheckpointVote{}
+
+	var err error
+	enc, err = snappy.Decode(nil, enc)
+	if err != nil {
+		return vote, err
+	}
+
+	err = vote.UnmarshalSSZ(enc)
+	if err != nil {
+		log.Errorf("Unmarshal checkpoint vote error: %s", err)
+		return vote, err
+	}
+
+	return vote, nil
+}
+
+func MarshalCheckpointVote(vote *prototype.CheckpointVote) ([]byte, error) {
+	if vote == nil {
+		return nil, errors.New("empty checkpoint vote given")
+	}
+
+	obj, err := vote.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+
+	return snappy.Encode(nil, obj), nil
+}
@@ -0,0 +1,38 @@
+package serialize
+
+import (
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+)
+
+func UnmarshalEvidence(enc []byte) (*prototype.SlashingEvidence, error) {
+	evidence := &prototype.SlashingEvidence{}
+
+	var err error
+	enc, err = snappy.Decode(nil, enc)
+	if err != nil {
+		return evidence, err
+	}
+
+	err = evidence.UnmarshalSSZ(enc)
+	if err != nil {
+		log.Errorf("Unmarshal evidence error: %s", err)
+		return evidence, err
+	}
+
+	return evidence, nil
+}
+
+func MarshalEvidence(evidence *prototype.SlashingEvidence) ([]byte, error) {
+	if evidence == nil {
+		return nil, errors.New("empty evidence given")
+	}
+
+	obj, err := evidence.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+
+	return snappy.Encode(nil, obj), nil
+}
@@ -18,13 +18,30 @@ func IsStandardTx(tx *types.Transaction) bool {
 // IsSystemTx check transaction is created by blockchain self
 func IsSystemTx(tx *types.Transaction) bool {
 	switch tx.Type() {
-	case common.FeeTxType, common.RewardTxType, common.CollapseTxType, common.ValidatorsUnstakeTxType:
+	case common.FeeTxType, common.RewardTxType, common.CollapseTxType, common.ValidatorsUnstakeTxType, common.SlashTxType:
 		return true
 	default:
 		return false
 	}
 }
 
+// IsCancelTx checks transaction sends all funds back to the sender.
+// Cancel transaction can replace pool transaction of any type with the same nonce.
+func IsCancelTx(tx *types.Transaction) bool {
+	if tx.Type() != common.NormalTxType || len(tx.Outputs()) == 0 {
+		return false
+	}
+
+	from := tx.From().Hex()
+	for _, out := range tx.Outputs() {
+		if out.Address().Hex() != from || len(out.Node()) == common.AddressLength {
+			return false
+		}
+	}
+
+	return true
+}
+
 func PbTxBatchToTyped(batch []*prototype.Transaction) []*types.Transaction {
 	res := make([]*types.Transaction, 0, len(batch))
 
@@ -0,0 +1,168 @@
+package validator
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	stypes "github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+// maxCheckpointVotes is the limit of checkpoint votes per block according to the block marshaller settings
+const maxCheckpointVotes = 128
+
+// voteCheckpoint votes once per epoch for the link from the last justified checkpoint to the checkpoint of the head epoch
+func (s *Service) voteCheckpoint() {
+	head, err := s.bc.GetHeadBlock()
+	if err != nil {
+		log.Errorf("[ValidatorService] Error reading head block: %s", err.Error())
+		return
+	}
+
+	epoch := stypes.EpochOf(head.Slot)
+
+	s.mu.Lock()
+	voted := epoch <= s.voteEpoch
+	s.mu.Unlock()
+
+	// there is no source before the Genesis epoch checkpoint
+	if voted || epoch == 0 || head.Num == 0 {
+		return
+	}
+
+	if !s.att.StakePool().IsActiveValidator(s.proposer.Addr().Hex(), epoch) {
+		return
+	}
+
+	target, err := s.att.Validator().EpochCheckpoint(head)
+	if err != nil {
+		log.Errorf("[ValidatorService] Error finding epoch %d checkpoint: %s", epoch, err)
+		return
+	}
+
+	source, _, err := s.bc.GetFinalityStatus()
+	if err != nil {
+		log.Errorf("[ValidatorService] Error reading finality status: %s", err)
+		return
+	}
+
+	vote, err := stypes.NewCheckpointVote(s.proposer, source, target)
+	if err != nil {
+		log.Errorf("[ValidatorService] Error signing checkpoint vote: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	s.voteEpoch = epoch
+	s.mu.Unlock()
+
+	log.Infof("Vote for epoch %d checkpoint #%d %s with source epoch %d", target.Epoch, target.Num, common.Encode(target.Hash), source.Epoch)
+
+	s.checkpointFeed.Send(vote)
+}
+
+// handleCheckpointVote saves valid vote for the checkpoint of the head epoch to include it in the next blocks
+func (s *Service) handleCheckpointVote(vote *prototype.CheckpointVote) {
+	head, err := s.bc.GetHeadBlock()
+	if err != nil {
+		log.Errorf("[ValidatorService] Error reading head block: %s", err.Error())
+		return
+	}
+
+	target, err := s.att.Validator().EpochCheckpoint(head)
+	if err != nil {
+		log.Errorf("[ValidatorService] Error finding epoch checkpoint: %s", err)
+		return
+	}
+
+	if err := s.att.Validator().ValidateCheckpointVote(vote, target); err != nil {
+		log.Debugf("Skip checkpoint vote: %s", err)
+		return
+	}
+
+	voter := common.BytesToAddress(vote.Voter.Address).Hex()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// remove votes of the previous epochs
+	for address, saved := range s.checkpointVotes {
+		if saved.TargetEpoch < vote.TargetEpoch {
+			delete(s.checkpointVotes, address)
+		}
+	}
+
+	if _, exists := s.checkpointVotes[voter]; !exists {
+		s.checkpointVotes[voter] = vote
+	}
+}
+
+// collectCheckpointVotes returns votes for the checkpoint of the head epoch that are not included in the head branch yet.
+// Votes can't be included in the first block of the epoch, because it is the checkpoint itself.
+func (s *Service) collectCheckpointVotes(head *prototype.Block, slotNum uint64) []*prototype.CheckpointVote {
+	if head.Num == 0 || stypes.EpochOf(head.Slot) != stypes.EpochOf(slotNum) {
+		return nil
+	}
+
+	target, err := s.att.Validator().EpochCheckpoint(head)
+	if err != nil {
+		log.Errorf("[ValidatorService] Error finding epoch checkpoint: %s", err)
+		return nil
+	}
+
+	included, err := s.includedVoters(head, target)
+	if err != nil {
+		log.Errorf("[ValidatorService] Error reading included checkpoint votes: %s", err)
+		return nil
+	}
+
+	s.mu.Lock()
+	votes := make([]*prototype.CheckpointVote, 0, len(s.checkpointVotes))
+	for voter, vote := range s.checkpointVotes {
+		if _, exists := included[voter]; exists {
+			continue
+		}
+
+		if vote.TargetEpoch == target.Epoch && bytes.Equal(vote.TargetHash, target.Hash) {
+			votes = append(votes, vote)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(votes, func(i, j int) bool {
+		return bytes.Compare(votes[i].Voter.Address, votes[j].Voter.Address) < 0
+	})
+
+	if len(votes) > maxCheckpointVotes {
+		votes = votes[:maxCheckpointVotes]
+	}
+
+	return votes
+}
+
+// includedVoters returns validators which votes are included in the head branch blocks after the given checkpoint
+func (s *Service) includedVoters(head *prototype.Block, checkpoint *stypes.Checkpoint) (map[string]struct{}, error) {
+	voters := map[string]struct{}{}
+
+	block := head
+	for block.Num > checkpoint.Num {
+		for _, vote := range block.CheckpointVotes {
+			voters[common.BytesToAddress(vote.Voter.Address).Hex()] = struct{}{}
+		}
+
+		prev, err := s.bc.GetBlockByHash(block.Parent)
+		if err != nil {
+			return nil, err
+		}
+
+		if prev == nil {
+			return nil, errors.Errorf("Not found parent of block #%d", block.Num)
+		}
+
+		block = prev
+	}
+
+	return voters, nil
+}
@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	stypes "github.com/raidoNetwork/RDO_v2/shared/types"
+	"github.com/raidoNetwork/RDO_v2/validator/types"
+)
+
+// checkDoubleProposal saves the first block header of the proposer for the slot
+// and reports slashing evidence if proposer signed another block for the same slot.
+func (s *Service) checkDoubleProposal(block *prototype.Block) {
+	header := stypes.NewSignedHeader(block, 0, block.Proposer)
+	if err := stypes.GetBlockSigner().Verify(stypes.SignedBlockHeader(header), block.Proposer); err != nil {
+		return
+	}
+
+	key := fmt.Sprintf("%s_%d", common.BytesToAddress(block.Proposer.Address).Hex(), block.Slot)
+
+	s.mu.Lock()
+	first, exists := s.proposals[key]
+	if !exists {
+		s.proposals[key] = header
+	}
+	s.mu.Unlock()
+
+	if !exists {
+		return
+	}
+
+	evidence, err := types.NewProposalEvidence(first, header)
+	if err != nil {
+		if err != types.ErrNoConflict {
+			log.Debugf("Skip double proposal evidence: %s", err)
+		}
+
+		return
+	}
+
+	log.Warnf("Malicious validator %s. Proposed two blocks for slot %d.", common.Encode(block.Proposer.Address), block.Slot)
+	s.reportEvidence(evidence)
+}
+
+// checkDoubleVote reports slashing evidence if attester approved and rejected the same block
+func (s *Service) checkDoubleVote(first, att *types.Attestation) {
+	if first.Type() == att.Type() {
+		return
+	}
+
+	evidence, err := types.NewVoteEvidence(first, att)
+	if err != nil {
+		log.Debugf("Skip double vote evidence: %s", err)
+		return
+	}
+
+	log.Warnf("Malicious validator %s. Approved and rejected block #%d.", att.Validator.Hex(), att.Header.Num)
+	s.reportEvidence(evidence)
+}
+
+// reportEvidence sends evidence to the network and back to the validator loop
+func (s *Service) reportEvidence(evidence *prototype.SlashingEvidence) {
+	// validator loop is subscribed to the feed too, so send it without blocking the loop
+	go s.evidenceFeed.Send(evidence)
+}
+
+// handleEvidenceEvent saves valid evidence for the block proposal
+func (s *Service) handleEvidenceEvent(evidence *prototype.SlashingEvidence) {
+	if err := s.att.Validator().ValidateEvidence(evidence, s.ticker.CurrentSlot()); err != nil {
+		log.Debugf("Skip slashing evidence: %s", err)
+		return
+	}
+
+	key := stypes.EvidenceKey(evidence)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.evidence[key]; exists {
+		return
+	}
+
+	s.evidence[key] = evidence
+	log.Warnf("Accepted slashing evidence %s", key)
+}
+
+// pendingEvidence returns evidence which can be included in the block with given slot ordered by the offence key.
+// Evidence that can't be included anymore is removed.
+func (s *Service) pendingEvidence(slotNum uint64) []*prototype.SlashingEvidence {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.evidence))
+	for key, evidence := range s.evidence {
+		if err := s.att.Validator().ValidateEvidence(evidence, slotNum); err != nil {
+			log.Debugf("Remove slashing evidence %s: %s", key, err)
+			delete(s.evidence, key)
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	res := make([]*prototype.SlashingEvidence, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, s.evidence[key])
+	}
+
+	return res
+}
+
+// pruneProposals removes proposal headers of the slots that are too old to be checked
+func (s *Service) pruneProposals(slotNum uint64) {
+	ttl := params.RaidoConfig().SlotsPerEpoch
+	for key, header := range s.proposals {
+		if header.Slot+ttl < slotNum {
+			delete(s.proposals, key)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package protection
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// InterchangeFormatVersion is the version of EIP-3076 interchange format
+const InterchangeFormatVersion = "5"
+
+// Interchange is the EIP-3076 style slashing protection data. Validators are identified by address
+// and attestations are bound to the slot because the network votes for the blocks of the slot.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []*InterchangeData  `json:"data"`
+}
+
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+type InterchangeData struct {
+	Pubkey             string          `json:"pubkey"`
+	SignedBlocks       []*SignedRecord `json:"signed_blocks"`
+	SignedAttestations []*SignedRecord `json:"signed_attestations"`
+}
+
+type SignedRecord struct {
+	Slot        string `json:"slot"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// Export writes slashing protection data of all validators in the interchange format
+func (s *Store) Export(w io.Writer) error {
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+		},
+		Data: make([]*InterchangeData, 0),
+	}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		genesisRoot := tx.Bucket(metadataBucket).Get(genesisRootKey)
+		if genesisRoot == nil {
+			return errors.New("Genesis root is not set. Start validator at least once before export.")
+		}
+		interchange.Metadata.GenesisValidatorsRoot = common.Encode(genesisRoot)
+
+		validators := map[string]*InterchangeData{}
+		getData := func(validator common.Address) *InterchangeData {
+			data, exists := validators[validator.Hex()]
+			if !exists {
+				data = &InterchangeData{
+					Pubkey:             validator.Hex(),
+					SignedBlocks:       make([]*SignedRecord, 0),
+					SignedAttestations: make([]*SignedRecord, 0),
+				}
+				validators[validator.Hex()] = data
+				interchange.Data = append(interchange.Data, data)
+			}
+
+			return data
+		}
+
+		err := forEachRecord(tx.Bucket(proposalsBucket), func(validator common.Address, record *signedRecord) {
+			data := getData(validator)
+			data.SignedBlocks = append(data.SignedBlocks, exportRecord(record))
+		})
+		if err != nil {
+			return err
+		}
+
+		return forEachRecord(tx.Bucket(attestationsBucket), func(validator common.Address, record *signedRecord) {
+			data := getData(validator)
+			data.SignedAttestations = append(data.SignedAttestations, exportRecord(record))
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(interchange)
+}
+
+// Import merges slashing protection data in the interchange format with the stored one.
+// Only the highest signed slot of each validator is kept.
+func (s *Store) Import(r io.Reader) error {
+	interchange := new(Interchange)
+	if err := json.NewDecoder(r).Decode(interchange); err != nil {
+		return errors.Wrap(err, "Error decoding interchange file")
+	}
+
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return errors.Errorf("Unsupported interchange format version %s", interchange.Metadata.InterchangeFormatVersion)
+	}
+
+	genesisRoot, err := common.Decode(interchange.Metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrap(err, "Bad genesis validators root")
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := checkGenesis(tx, genesisRoot); err != nil {
+			return err
+		}
+
+		for _, data := range interchange.Data {
+			if !common.IsHexAddress(data.Pubkey) {
+				return errors.Errorf("Bad validator address %s", data.Pubkey)
+			}
+			validator := common.HexToAddress(data.Pubkey)
+
+			if err := importRecords(tx.Bucket(proposalsBucket), validator, data.SignedBlocks); err != nil {
+				return err
+			}
+
+			if err := importRecords(tx.Bucket(attestationsBucket), validator, data.SignedAttestations); err != nil {
+				return err
+			}
+
+			log.Infof("Imported slashing protection data of %s", validator.Hex())
+		}
+
+		return nil
+	})
+}
+
+func importRecords(bkt *bolt.Bucket, validator common.Address, records []*SignedRecord) error {
+	highest, err := getRecord(bkt, validator)
+	if err != nil {
+		return err
+	}
+
+	updated := false
+	for _, rec := range records {
+		record, err := importRecord(rec)
+		if err != nil {
+			return errors.Wrapf(err, "Bad record of %s", validator.Hex())
+		}
+
+		switch {
+		case highest == nil || record.slot > highest.slot:
+			highest = record
+		case record.slot == highest.slot && !bytes.Equal(record.root, highest.root):
+			// signed root of the slot is ambiguous, so refuse any message for it
+			highest = &signedRecord{slot: record.slot}
+		default:
+			continue
+		}
+
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+
+	return putRecord(bkt, validator, highest)
+}
+
+func forEachRecord(bkt *bolt.Bucket, fn func(common.Address, *signedRecord)) error {
+	return bkt.ForEach(func(k, _ []byte) error {
+		validator := common.BytesToAddress(k)
+		record, err := getRecord(bkt, validator)
+		if err != nil {
+			return err
+		}
+
+		fn(validator, record)
+		return nil
+	})
+}
+
+func exportRecord(record *signedRecord) *SignedRecord {
+	rec := &SignedRecord{
+		Slot: strconv.FormatUint(record.slot, 10),
+	}
+
+	if len(record.root) > 0 {
+		rec.SigningRoot = common.Encode(record.root)
+	}
+
+	return rec
+}
+
+func importRecord(rec *SignedRecord) (*signedRecord, error) {
+	slot, err := strconv.ParseUint(rec.Slot, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &signedRecord{slot: slot}
+	if rec.SigningRoot != "" {
+		record.root, err = common.Decode(rec.SigningRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return record, nil
+}
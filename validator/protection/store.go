@@ -0,0 +1,199 @@
+package protection
+
+import (
+	"bytes"
+	"path/filepath"
+	"time"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	"github.com/raidoNetwork/RDO_v2/utils/file"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+// DatabaseFileName is the name of the slashing protection database stored next to the validator key
+const DatabaseFileName = "slashing_protection.db"
+
+var log = logrus.WithField("prefix", "protection")
+
+var (
+	ErrSlashableProposal    = errors.New("Proposal conflicts with the previously signed block")
+	ErrSlashableAttestation = errors.New("Attestation conflicts with the previously signed attestation")
+	ErrGenesisMismatch      = errors.New("Slashing protection database belongs to another network")
+)
+
+var (
+	proposalsBucket    = []byte("proposals")
+	attestationsBucket = []byte("attestations")
+	metadataBucket     = []byte("metadata")
+
+	genesisRootKey = []byte("genesis-root")
+)
+
+// Store keeps the highest slot and signing root of the signed proposals and attestations of the validators,
+// so validator can't sign conflicting messages after restart or when the same key is used twice.
+type Store struct {
+	db           *bolt.DB
+	databasePath string
+}
+
+// signedRecord is the highest signed message of the validator. Empty root means that any message
+// for the slot is refused, because the signed root is unknown.
+type signedRecord struct {
+	slot uint64
+	root []byte
+}
+
+// NewStore opens slashing protection database in the given directory
+func NewStore(dirPath string) (*Store, error) {
+	hasDir, err := file.HasDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		if err := file.MkdirAll(dirPath); err != nil {
+			return nil, err
+		}
+	}
+
+	boltDB, err := bolt.Open(
+		DatafilePath(dirPath),
+		params.RaidoIoConfig().ReadWritePermissions,
+		&bolt.Options{
+			Timeout: 1 * time.Second,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain slashing protection database lock, validator key may be in use by another process")
+		}
+		return nil, err
+	}
+
+	if err := boltDB.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{proposalsBucket, attestationsBucket, metadataBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db:           boltDB,
+		databasePath: dirPath,
+	}, nil
+}
+
+// DatafilePath returns path of the slashing protection database file in the given directory
+func DatafilePath(dirPath string) string {
+	return filepath.Join(dirPath, DatabaseFileName)
+}
+
+// Close closes the underlying BoltDB database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// CheckGenesis saves genesis root of the network or compares it with the saved one
+func (s *Store) CheckGenesis(genesisRoot []byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return checkGenesis(tx, genesisRoot)
+	})
+}
+
+// CheckAndSaveProposal saves proposal of the validator for the given slot
+// or returns ErrSlashableProposal if it conflicts with already signed proposals.
+func (s *Store) CheckAndSaveProposal(validator common.Address, slot uint64, root []byte) error {
+	err := s.checkAndSave(proposalsBucket, validator, slot, root)
+	if err == errConflict {
+		return ErrSlashableProposal
+	}
+
+	return err
+}
+
+// CheckAndSaveAttestation saves attestation of the validator for the given slot
+// or returns ErrSlashableAttestation if it conflicts with already signed attestations.
+func (s *Store) CheckAndSaveAttestation(validator common.Address, slot uint64, root []byte) error {
+	err := s.checkAndSave(attestationsBucket, validator, slot, root)
+	if err == errConflict {
+		return ErrSlashableAttestation
+	}
+
+	return err
+}
+
+var errConflict = errors.New("conflict")
+
+func (s *Store) checkAndSave(bucket []byte, validator common.Address, slot uint64, root []byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(bucket)
+
+		record, err := getRecord(bkt, validator)
+		if err != nil {
+			return err
+		}
+
+		if record != nil {
+			// messages of the lower slots and different messages of the same slot are refused
+			if slot < record.slot || slot == record.slot && (len(record.root) == 0 || !bytes.Equal(record.root, root)) {
+				log.Errorf("Refuse to sign slot %d for %s. Highest signed slot is %d.", slot, validator.Hex(), record.slot)
+				return errConflict
+			}
+
+			if slot == record.slot {
+				return nil
+			}
+		}
+
+		return putRecord(bkt, validator, &signedRecord{slot: slot, root: root})
+	})
+}
+
+func checkGenesis(tx *bolt.Tx, genesisRoot []byte) error {
+	bkt := tx.Bucket(metadataBucket)
+
+	saved := bkt.Get(genesisRootKey)
+	if saved == nil {
+		return bkt.Put(genesisRootKey, genesisRoot)
+	}
+
+	if !bytes.Equal(saved, genesisRoot) {
+		return ErrGenesisMismatch
+	}
+
+	return nil
+}
+
+func getRecord(bkt *bolt.Bucket, validator common.Address) (*signedRecord, error) {
+	data := bkt.Get(validator.Bytes())
+	if data == nil {
+		return nil, nil
+	}
+
+	if len(data) < 8 {
+		return nil, errors.Errorf("Broken slashing protection record of %s", validator.Hex())
+	}
+
+	root := make([]byte, len(data)-8)
+	copy(root, data[8:])
+
+	return &signedRecord{
+		slot: ssz.UnmarshallUint64(data[:8]),
+		root: root,
+	}, nil
+}
+
+func putRecord(bkt *bolt.Bucket, validator common.Address, record *signedRecord) error {
+	buf := make([]byte, 0, 8+len(record.root))
+	buf = ssz.MarshalUint64(buf, record.slot)
+	buf = append(buf, record.root...)
+
+	return bkt.Put(validator.Bytes(), buf)
+}
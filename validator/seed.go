@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/params"
+	stypes "github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+// maxReveals is the limit of reveals per block according to the block marshaller settings
+const maxReveals = 128
+
+// seedSecret is the local secret of the validator committed in the seed
+type seedSecret struct {
+	value []byte
+	slot  uint64
+}
+
+// collectReveals returns seeds of the given slot that open commitments of the head block and seeds with the new commitments.
+// Seeds are ordered by the validator address. Block can't be proposed if any head commitment is not revealed.
+func (s *Service) collectReveals(head *prototype.Block, slotNum uint64) ([]*prototype.Seed, error) {
+	commitments := stypes.RevealCommitments(head, slotNum)
+
+	for _, validator := range stypes.ExpiredCommitments(head, slotNum) {
+		log.Warnf("Commitment of validator %s expired in slot %d", validator, slotNum)
+	}
+
+	s.mu.Lock()
+	required := make([]*prototype.Seed, 0, len(commitments))
+	optional := make([]*prototype.Seed, 0, len(s.seedMap))
+	for address, seed := range s.seedMap {
+		if seed.Slot != slotNum {
+			continue
+		}
+
+		if err := stypes.CheckReveal(seed, commitments); err != nil {
+			log.Warnf("Exclude seed of %s: %s", address, err)
+			continue
+		}
+
+		if _, exists := commitments[address]; exists {
+			required = append(required, seed)
+		} else {
+			optional = append(optional, seed)
+		}
+	}
+	s.mu.Unlock()
+
+	missed := stypes.MissedReveals(commitments, required)
+	if len(missed) > 0 {
+		return nil, errors.Errorf("Missed reveals of %s", strings.Join(missed, ", "))
+	}
+
+	sortSeeds(optional)
+
+	// head commitments are limited by the same size, so all of them fit the block
+	if len(required)+len(optional) > maxReveals {
+		optional = optional[:maxReveals-len(required)]
+	}
+
+	reveals := append(required, optional...)
+	sortSeeds(reveals)
+
+	return reveals, nil
+}
+
+// sortSeeds orders seeds by the validator address
+func sortSeeds(seeds []*prototype.Seed) {
+	sort.Slice(seeds, func(i, j int) bool {
+		return bytes.Compare(seeds[i].Proposer.Address, seeds[j].Proposer.Address) < 0
+	})
+}
+
+// revealSecret returns the secret for the validator commitment in the given block that should be revealed in the given slot
+func (s *Service) revealSecret(head *prototype.Block, slotNum uint64) []byte {
+	commitment, exists := stypes.RevealCommitments(head, slotNum)[s.proposer.Addr().Hex()]
+	if !exists {
+		return stypes.EmptyReveal
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secret, exists := s.secrets[common.Encode(commitment)]
+	if !exists {
+		log.Warnf("Secret for commitment %s not found. Seed will be excluded from the block.", common.Encode(commitment))
+		return stypes.EmptyReveal
+	}
+
+	return secret.value
+}
+
+// pruneSecrets removes secrets that can't be revealed anymore
+func (s *Service) pruneSecrets(slotNum uint64) {
+	ttl := params.RaidoConfig().SlotsPerEpoch
+	for commitment, secret := range s.secrets {
+		if secret.slot+ttl < slotNum {
+			delete(s.secrets, commitment)
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/raidoNetwork/RDO_v2/shared/common"
 	"github.com/raidoNetwork/RDO_v2/shared/params"
 	stypes "github.com/raidoNetwork/RDO_v2/shared/types"
+	"github.com/raidoNetwork/RDO_v2/validator/protection"
 	"github.com/raidoNetwork/RDO_v2/validator/types"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -38,7 +39,8 @@ const (
 	attestationsCount = 100
 	seedCount         = 100
 	proposeCount      = 5
-	seedPrime         = 4294967291 // prime number closest to 2**32-1
+	evidenceCount     = 10
+	checkpointCount   = 100
 )
 
 type Config struct {
@@ -49,6 +51,8 @@ type Config struct {
 	BlockFeed       *events.Feed
 	StateFeed       *events.Feed
 	SeedFeed        *events.Feed
+	EvidenceFeed    *events.Feed
+	CheckpointFeed  *events.Feed
 	Context         context.Context
 }
 
@@ -60,6 +64,7 @@ type Service struct {
 	bc         consensus.BlockFinalizer
 	att        consensus.AttestationPool
 	proposer   *keystore.ValidatorAccount
+	protection *protection.Store
 
 	miner  *forger.Forger // block miner
 	ticker *slot.SlotTicker
@@ -70,12 +75,16 @@ type Service struct {
 	proposeEvent     chan *prototype.Block
 	attestationEvent chan *types.Attestation
 	seedEvent        chan *prototype.Seed
+	evidenceEvent    chan *prototype.SlashingEvidence
+	checkpointEvent  chan *prototype.CheckpointVote
 
 	proposeFeed     *events.Feed
 	attestationFeed *events.Feed
 	seedFeed        *events.Feed
 	blockFeed       *events.Feed
 	stateFeed       *events.Feed
+	evidenceFeed    *events.Feed
+	checkpointFeed  *events.Feed
 
 	// consensus Engine
 	backend consensus.PoS
@@ -87,20 +96,47 @@ type Service struct {
 	receivedBlock    *prototype.Block
 	votingIsFinished bool
 	blockVoting      map[string]*voting
-	seedMap          map[string]*prototype.Seed
 	finishVoting     chan struct{}
 	waitSeed         chan struct{}
 
+	// backup proposer
+	proposalSlot uint64
+	backupTurn   chan uint64
+
 	// seed
-	seed int64
+	seedSlot uint64
+	seedMap  map[string]*prototype.Seed
+	secrets  map[string]*seedSecret
+
+	// slashing
+	proposals map[string]*prototype.SignedHeader
+	evidence  map[string]*prototype.SlashingEvidence
+
+	// finality
+	voteEpoch       uint64
+	checkpointVotes map[string]*prototype.CheckpointVote
 }
 
 func (s *Service) Start() {
 	s.subscribeEvents()
 	s.waitInitEvent()
 
+	// slashing protection data of another network can't guarantee anything
+	if err := s.protection.CheckGenesis(stypes.GetSigningDomain().GenesisHash); err != nil {
+		log.Errorf("Slashing protection error: %s", err)
+
+		s.mu.Lock()
+		s.statusErr = err
+		s.mu.Unlock()
+		return
+	}
+
 	log.Warnf("Start validator node %s", s.proposer.Addr().Hex())
 
+	if !s.backend.IsValidator(s.proposer.Addr()) {
+		log.Warnf("Validator %s is not in the validator set. Stake validator slots to join the activation queue.", s.proposer.Addr().Hex())
+	}
+
 	go s.loop()
 }
 
@@ -115,7 +151,7 @@ func (s *Service) Stop() error {
 	s.unsubscribe()
 	s.ticker.Stop()
 
-	return nil
+	return s.protection.Close()
 }
 
 func (s *Service) loop() {
@@ -142,17 +178,24 @@ func (s *Service) loop() {
 		case <-s.ticker.C():
 			// generate a seed, send it.
 			s.generateSeed()
+			s.voteCheckpoint()
 		case seed := <-s.seedEvent:
 			// Add to the map
 			s.handleSeedEvent(seed)
+		case evidence := <-s.evidenceEvent:
+			s.handleEvidenceEvent(evidence)
+		case vote := <-s.checkpointEvent:
+			s.handleCheckpointVote(vote)
 		case <-s.waitSeed:
-			s.mu.Lock()
-			s.seedMap = make(map[string]*prototype.Seed)
-			s.mu.Unlock()
-
 			s.forgeBlock()
+		case slotNum := <-s.backupTurn:
+			s.forgeBackupBlock(slotNum)
 		case block := <-s.proposeEvent:
 			s.mu.Lock()
+			if block.Slot > s.proposalSlot {
+				s.proposalSlot = block.Slot
+			}
+
 			if s.receivedBlock != nil && bytes.Equal(block.Hash, s.receivedBlock.Hash) {
 				s.mu.Unlock()
 				continue
@@ -179,6 +222,10 @@ func (s *Service) loop() {
 				continue
 			}
 
+			if err := s.aggregateVotes(); err != nil {
+				log.Errorf("Error aggregating votes for block #%d: %s", s.proposedBlock.Num, err)
+			}
+
 			s.blockFeed.Send(s.proposedBlock)
 
 			delete(s.blockVoting, common.Encode(s.proposedBlock.Hash))
@@ -194,11 +241,15 @@ func (s *Service) subscribeEvents() {
 	proposeSub := s.proposeFeed.Subscribe(s.proposeEvent)
 	attSub := s.attestationFeed.Subscribe(s.attestationEvent)
 	seedSub := s.seedFeed.Subscribe(s.seedEvent)
+	evidenceSub := s.evidenceFeed.Subscribe(s.evidenceEvent)
+	checkpointSub := s.checkpointFeed.Subscribe(s.checkpointEvent)
 
 	s.unsubscribe = func() {
 		proposeSub.Unsubscribe()
 		attSub.Unsubscribe()
 		seedSub.Unsubscribe()
+		evidenceSub.Unsubscribe()
+		checkpointSub.Unsubscribe()
 	}
 }
 
@@ -235,6 +286,7 @@ func (s *Service) waitInitEvent() {
 }
 
 func (s *Service) verifyBlock(block *prototype.Block) error {
+	arrival := uint64(time.Now().UnixNano())
 	blockProposer := common.BytesToAddress(block.Proposer.Address)
 
 	if blockProposer.Hex() == s.proposer.Addr().Hex() {
@@ -242,17 +294,42 @@ func (s *Service) verifyBlock(block *prototype.Block) error {
 		return nil
 	}
 
-	s.mu.Lock()
-	if !s.backend.IsLeader(blockProposer, s.seed) {
-		// todo mark validator as malicious
-		log.Warnf("Not ordered block proposition from %s", blockProposer.Hex())
-		s.mu.Unlock()
+	s.checkDoubleProposal(block)
+
+	head, err := s.bc.GetHeadBlock()
+	if err != nil {
+		return errors.Wrap(err, "Error reading head block")
+	}
+
+	// proposer is known only for the blocks built on top of the local head
+	if bytes.Equal(block.Parent, head.Hash) {
+		rank := s.backend.ProposerRank(blockProposer, stypes.ProposerSeed(stypes.BlockSeed(head), block.Slot), stypes.EpochOf(block.Slot))
+		if rank < 0 {
+			log.Warnf("Not ordered block proposition from %s", blockProposer.Hex())
+			return nil
+		}
+
+		// block timestamp is chosen by the proposer, so the window is checked with the local arrival time.
+		// The next ranked proposer may have already proposed the block after the window.
+		if err := stypes.CheckProposalArrival(s.bc.GetGenesis().Timestamp, block, rank, arrival); err != nil {
+			log.Warnf("Untimely block proposition from %s for slot %d: %s", blockProposer.Hex(), block.Slot, err)
+			return nil
+		}
+	}
+
+	inCommittee, err := s.inCommittee(block, s.proposer.Addr().Hex())
+	if err != nil {
+		log.Debugf("Skip attestation of block #%d: %s", block.Num, err)
+		return nil
+	}
+
+	if !inCommittee {
+		log.Debugf("Skip attestation of block #%d: validator is not in the slot %d committee", block.Num, block.Slot)
 		return nil
 	}
-	s.mu.Unlock()
 
 	attestationType := types.Approve
-	_, err := s.att.Validator().ValidateBlock(block, false)
+	_, err = s.att.Validator().ValidateBlock(block, false)
 	if err == attestation.ErrPreviousBlockNotExists {
 		return nil
 	} else if err != nil {
@@ -265,6 +342,16 @@ func (s *Service) verifyBlock(block *prototype.Block) error {
 		return errors.Wrap(err, "Attestation error")
 	}
 
+	root, err := types.AttestationRoot(block, attestationType)
+	if err != nil {
+		return errors.Wrap(err, "Attestation error")
+	}
+
+	if err := s.protection.CheckAndSaveAttestation(s.proposer.Addr(), block.Slot, root); err != nil {
+		log.Errorf("Skip attestation of block #%d %s: %s", block.Num, common.Encode(block.Hash), err)
+		return nil
+	}
+
 	if attestationType == types.Approve {
 		s.mu.Lock()
 		s.receivedBlock = block
@@ -280,25 +367,23 @@ func (s *Service) processAttestation(att *types.Attestation) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	proposer := common.BytesToAddress(att.Block.Proposer.Address)
-	if !s.backend.IsValidator(proposer) {
-		return
-	}
-
-	blockHash := common.Encode(att.Block.Hash)
+	blockHash := common.Encode(att.BlockHash)
 	if att.Validator.Hex() != s.proposer.Addr().Hex() {
+		if att.Header == nil {
+			return
+		}
+
 		log.Debugf(
 			"Receive attestation for block #%d %s",
-			att.Block.Num,
+			att.Header.Num,
 			blockHash,
 		)
 
 		if err := types.VerifyAttestationSign(att); err != nil {
-			// todo mark validator as malicious
 			log.Warnf(
 				"Malicious validator %s. Wrong sign on block %d %s.",
-				common.Encode(att.Signature.Address),
-				att.Block.Num,
+				att.Validator.Hex(),
+				att.Header.Num,
 				blockHash,
 			)
 			return
@@ -307,53 +392,211 @@ func (s *Service) processAttestation(att *types.Attestation) {
 
 	if _, exists := s.blockVoting[blockHash]; !exists {
 		s.blockVoting[blockHash] = &voting{
-			started:  time.Now(),
-			approved: map[string]struct{}{},
-			rejected: map[string]struct{}{},
+			started: time.Now(),
+			votes:   map[string]*types.Attestation{},
 		}
 	}
 
 	node := att.Validator.Hex()
 
-	_, exists1 := s.blockVoting[blockHash].approved[node]
-	_, exists2 := s.blockVoting[blockHash].rejected[node]
+	if first, exists := s.blockVoting[blockHash].votes[node]; exists {
+		s.checkDoubleVote(first, att)
+		return
+	}
+
+	s.blockVoting[blockHash].votes[node] = att
+}
+
+// aggregateVotes packs committee votes collected for the proposed block into the block bitfields.
+// Before the committee activation all collected votes are listed in the block.
+// Need to acquire lock outside.
+func (s *Service) aggregateVotes() error {
+	block := s.proposedBlock
+
+	if !stypes.CommitteeEnabled(block.Num) {
+		s.listVotes()
+		return nil
+	}
+
+	parent, err := s.bc.GetBlockByHash(block.Parent)
+	if err != nil {
+		return err
+	}
+
+	if parent == nil {
+		return attestation.ErrPreviousBlockNotExists
+	}
+
+	// block is valid with committee votes only
+	committee, err := s.att.Validator().Committee(parent, block.Slot)
+	if err != nil {
+		return err
+	}
+
+	approvals := map[string][]byte{}
+	rejections := map[string][]byte{}
+
+	if voting, exists := s.blockVoting[common.Encode(block.Hash)]; exists {
+		header := stypes.NewSignedHeader(block, 0, nil)
+		for _, member := range committee {
+			att, exists := voting.votes[member]
+			if !exists {
+				continue
+			}
+
+			// vote should be given for the header of the proposed block
+			if !stypes.SameSignedHeader(header, att.Header) {
+				log.Debugf("Skip vote of %s for block #%d: header mismatch", member, block.Num)
+				continue
+			}
+
+			if att.Type() == types.Approve {
+				approvals[member] = att.Signature().Signature
+			} else {
+				rejections[member] = att.Signature().Signature
+			}
+		}
+	}
+
+	block.Approvals = stypes.AggregateVotes(committee, approvals)
+	block.Rejections = stypes.AggregateVotes(committee, rejections)
+
+	log.Debugf("Aggregate votes for block #%d: %d approvals, %d rejections", block.Num, len(approvals), len(rejections))
+
+	return nil
+}
 
-	if exists1 || exists2 {
+// listVotes appends all votes collected for the proposed block to the block approvers and slashers.
+// Need to acquire lock outside.
+func (s *Service) listVotes() {
+	block := s.proposedBlock
+
+	voting, exists := s.blockVoting[common.Encode(block.Hash)]
+	if !exists {
 		return
 	}
 
-	isLocalProposer := proposer.Hex() == s.proposer.Addr().Hex()
-	canUpdateProposedBlock := isLocalProposer && !s.votingIsFinished
-	if att.Type == types.Approve {
-		s.blockVoting[blockHash].approved[node] = struct{}{}
+	header := stypes.NewSignedHeader(block, 0, nil)
+	for _, att := range voting.votes {
+		if !stypes.SameSignedHeader(header, att.Header) {
+			continue
+		}
 
-		if canUpdateProposedBlock {
-			s.proposedBlock.Approvers = append(s.proposedBlock.Approvers, att.Signature)
+		if att.Type() == types.Approve {
+			block.Approvers = append(block.Approvers, att.Signature())
+		} else {
+			block.Slashers = append(block.Slashers, att.Signature())
 		}
-	} else {
-		s.blockVoting[blockHash].rejected[node] = struct{}{}
+	}
 
-		if canUpdateProposedBlock {
-			s.proposedBlock.Slashers = append(s.proposedBlock.Slashers, att.Signature)
+	log.Debugf("List votes for block #%d: %d approvers, %d slashers", block.Num, len(block.Approvers), len(block.Slashers))
+}
+
+// inCommittee returns true if validator is in the committee of the given block slot.
+// Before the committee activation every validator votes.
+func (s *Service) inCommittee(block *prototype.Block, validator string) (bool, error) {
+	if !stypes.CommitteeEnabled(block.Num) {
+		return true, nil
+	}
+
+	parent, err := s.bc.GetBlockByHash(block.Parent)
+	if err != nil {
+		return false, err
+	}
+
+	if parent == nil {
+		return false, attestation.ErrPreviousBlockNotExists
+	}
+
+	committee, err := s.att.Validator().Committee(parent, block.Slot)
+	if err != nil {
+		return false, err
+	}
+
+	for _, member := range committee {
+		if member == validator {
+			return true, nil
 		}
 	}
+
+	return false, nil
 }
 
 func (s *Service) forgeBlock() {
+	head, err := s.bc.GetHeadBlock()
+	if err != nil {
+		log.Errorf("[ValidatorService] Error reading head block: %s", err.Error())
+		return
+	}
 
 	s.mu.Lock()
+	slotNum := s.seedSlot
+	s.mu.Unlock()
+
 	// Check node is leader now
-	log.Debugf("%s is leader: %t", s.proposer.Addr().Hex(), s.backend.IsLeader(s.proposer.Addr(), s.seed))
-	if !s.backend.IsLeader(s.proposer.Addr(), s.seed) {
-		s.mu.Unlock()
+	rank := s.backend.ProposerRank(s.proposer.Addr(), stypes.ProposerSeed(stypes.BlockSeed(head), slotNum), stypes.EpochOf(slotNum))
+	log.Debugf("%s is leader: %t", s.proposer.Addr().Hex(), rank == 0)
+	if rank < 0 {
 		return
 	}
+
+	// backup proposer waits for the leader timeout
+	if rank > 0 {
+		from, _ := stypes.ProposerWindow(s.bc.GetGenesis().Timestamp, slotNum, rank)
+		go func() {
+			time.Sleep(time.Until(time.Unix(0, int64(from))))
+			s.backupTurn <- slotNum
+		}()
+		return
+	}
+
+	s.proposeBlock(head, slotNum)
+}
+
+// forgeBackupBlock proposes block if there is no proposal for the slot after the timeout of the higher ranked proposers
+func (s *Service) forgeBackupBlock(slotNum uint64) {
+	s.mu.Lock()
+	skip := s.seedSlot != slotNum || s.proposalSlot >= slotNum
 	s.mu.Unlock()
 
+	if skip {
+		return
+	}
+
+	head, err := s.bc.GetHeadBlock()
+	if err != nil {
+		log.Errorf("[ValidatorService] Error reading head block: %s", err.Error())
+		return
+	}
+
+	if head.Slot >= slotNum {
+		return
+	}
+
+	rank := s.backend.ProposerRank(s.proposer.Addr(), stypes.ProposerSeed(stypes.BlockSeed(head), slotNum), stypes.EpochOf(slotNum))
+	if rank <= 0 {
+		return
+	}
+
+	log.Warnf("No proposal for slot %d. Propose block as backup proposer with rank %d.", slotNum, rank)
+
+	s.proposeBlock(head, slotNum)
+}
+
+// proposeBlock forges block of the given slot and sends it to the committee
+func (s *Service) proposeBlock(head *prototype.Block, slotNum uint64) {
 	start := time.Now()
 
+	reveals, err := s.collectReveals(head, slotNum)
+	if err != nil {
+		log.Warnf("[ValidatorService] Skip proposal of slot %d: %s", slotNum, err)
+		return
+	}
+
+	votes := s.collectCheckpointVotes(head, slotNum)
+
 	// generate block with block miner
-	block, err := s.miner.ForgeBlock()
+	block, err := s.miner.ForgeBlock(slotNum, reveals, votes, s.pendingEvidence(slotNum))
 	if err != nil {
 		log.Errorf("[ValidatorService] Error forging block: %s", err.Error())
 
@@ -363,6 +606,12 @@ func (s *Service) forgeBlock() {
 		return
 	}
 
+	root := stypes.GetBlockSigner().SigningRoot(stypes.GetBlockHeader(block), nil)
+	if err := s.protection.CheckAndSaveProposal(s.proposer.Addr(), slotNum, root); err != nil {
+		log.Errorf("[ValidatorService] Refuse to propose block #%d: %s", block.Num, err)
+		return
+	}
+
 	// setup block for updates
 	s.proposedBlock = block
 
@@ -381,9 +630,37 @@ func (s *Service) forgeBlock() {
 	log.Debugf("Block #%d forged in %d ms", block.Num, time.Since(start).Milliseconds())
 }
 
-// Generate the seed and send it to all peers
+// Generate the seed with reveal of the previous secret and commitment to the new one and send it to all peers
 func (s *Service) generateSeed() {
-	seed, err := stypes.NewSeed(s.proposer)
+	slotNum := s.ticker.CurrentSlot()
+	epoch := slotNum / params.RaidoConfig().SlotsPerEpoch
+
+	// only active validators take part in the epoch
+	if !s.att.StakePool().IsActiveValidator(s.proposer.Addr().Hex(), epoch) {
+		return
+	}
+
+	head, err := s.bc.GetHeadBlock()
+	if err != nil {
+		log.Errorf("[ValidatorService] Error reading head block: %s", err.Error())
+		return
+	}
+
+	reveal := s.revealSecret(head, slotNum)
+
+	secret, err := stypes.NewSeedSecret()
+	if err != nil {
+		log.Errorf("[ValidatorService] Error generating seed secret: %s", err.Error())
+
+		s.mu.Lock()
+		s.statusErr = err
+		s.mu.Unlock()
+
+		return
+	}
+
+	commitment := stypes.SeedCommitment(secret)
+	seed, err := stypes.NewSeed(s.proposer, head.Num+1, slotNum, epoch, reveal, commitment)
 	if err != nil {
 		log.Errorf("[ValidatorService] Error generating seed: %s", err.Error())
 
@@ -394,7 +671,24 @@ func (s *Service) generateSeed() {
 		return
 	}
 
-	log.Debugf("Successfully generated seed: %d", seed.Seed)
+	s.mu.Lock()
+	s.seedSlot = slotNum
+	s.secrets[common.Encode(commitment)] = &seedSecret{
+		value: secret,
+		slot:  slotNum,
+	}
+	s.pruneSecrets(slotNum)
+	s.pruneProposals(slotNum)
+
+	// remove seeds of the previous slots
+	for address, se := range s.seedMap {
+		if se.Slot < slotNum {
+			delete(s.seedMap, address)
+		}
+	}
+	s.mu.Unlock()
+
+	log.Debugf("Successfully generated seed for slot %d with commitment %s", slotNum, common.Encode(commitment))
 
 	// Push the generated seed to events
 	s.seedFeed.Send(seed)
@@ -405,42 +699,56 @@ func (s *Service) generateSeed() {
 	}()
 }
 
-// reseive the seed & calculate the resulting seed
+// receive the seed and save it for the block reveals
 func (s *Service) handleSeedEvent(seed *prototype.Seed) {
-	address := common.BytesToAddress(seed.Proposer.Address).Hex()
-	if se, exists := s.seedMap[address]; exists && seed.Seed == se.Seed {
+	head, err := s.bc.GetHeadBlock()
+	if err != nil {
+		log.Errorf("[ValidatorService] Error reading head block: %s", err.Error())
 		return
 	}
 
-	// Checking for signature
-	if err := stypes.GetSeedSigner().Verify(seed); err != nil {
+	// Checking for signature and slot binding, seed is revealed in the next block
+	if err := s.att.Validator().ValidateSeed(seed, head.Num+1, seed.Slot); err != nil {
+		log.Debugf("Skip seed: %s", err)
 		return
 	}
-	log.Debugf("Accepted incoming seed %d from %s", seed.Seed, common.BytesToAddress(seed.Proposer.Address).Hex())
+
+	address := common.BytesToAddress(seed.Proposer.Address).Hex()
 
 	s.mu.Lock()
-	s.seedMap[address] = seed
+	defer s.mu.Unlock()
 
-	var result uint64 = 1
-	for _, seed := range s.seedMap {
-		se := uint64(seed.Seed)
-		result = (result * se) % seedPrime
+	if seed.Slot < s.seedSlot {
+		return
 	}
 
-	s.seed = int64(result)
-	s.mu.Unlock()
+	// only the first seed of validator per slot is accepted
+	if se, exists := s.seedMap[address]; exists && se.Slot >= seed.Slot {
+		return
+	}
+
+	s.seedMap[address] = seed
+	log.Debugf("Accepted incoming seed for slot %d from %s", seed.Slot, address)
 }
 
-func New(cliCtx *cli.Context, cfg *Config) (*Service, error) {
-	var path string
+// KeyPath returns path to the validator key file
+func KeyPath(cliCtx *cli.Context) string {
 	validatorPath := cliCtx.String(vflags.ValidatorKey.Name)
 	if validatorPath != "" {
-		path = validatorPath
-	} else {
-		dataDir := cliCtx.String(cmd.DataDirFlag.Name)
-		path = filepath.Join(dataDir, "validator", "validator.key")
+		return validatorPath
 	}
 
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	return filepath.Join(dataDir, "validator", "validator.key")
+}
+
+// OpenProtection opens slashing protection database stored next to the validator key
+func OpenProtection(cliCtx *cli.Context) (*protection.Store, error) {
+	return protection.NewStore(filepath.Dir(KeyPath(cliCtx)))
+}
+
+func New(cliCtx *cli.Context, cfg *Config) (*Service, error) {
+	path := KeyPath(cliCtx)
 	proposer, err := keystore.NewValidatorAccountFromFile(path)
 	if err != nil {
 		return nil, err
@@ -456,9 +764,6 @@ func New(cliCtx *cli.Context, cfg *Config) (*Service, error) {
 		AttestationPool: &cfg.AttestationPool,
 	}
 	engine := pos.New(posConfig)
-	if !engine.IsValidator(proposer.Addr()) {
-		return nil, errors.New("Attach validator key to start validator flow")
-	}
 
 	netCfg := params.RaidoConfig()
 	forgerCfg := &forger.Config{
@@ -471,6 +776,11 @@ func New(cliCtx *cli.Context, cfg *Config) (*Service, error) {
 	// new block miner
 	blockForger := forger.New(cfg.BlockFinalizer, cfg.AttestationPool, forgerCfg)
 
+	protectionDB, err := OpenProtection(cliCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error opening slashing protection database")
+	}
+
 	ctx, finish := context.WithCancel(cfg.Context)
 	srv := &Service{
 		cliCtx:     cliCtx,
@@ -478,6 +788,7 @@ func New(cliCtx *cli.Context, cfg *Config) (*Service, error) {
 		cancelFunc: finish,
 		miner:      blockForger,
 		proposer:   proposer,
+		protection: protectionDB,
 
 		bc:  cfg.BlockFinalizer,
 		att: cfg.AttestationPool,
@@ -488,10 +799,13 @@ func New(cliCtx *cli.Context, cfg *Config) (*Service, error) {
 		proposeEvent:     make(chan *prototype.Block, proposeCount),
 		attestationEvent: make(chan *types.Attestation, attestationsCount),
 		seedEvent:        make(chan *prototype.Seed, seedCount),
+		evidenceEvent:    make(chan *prototype.SlashingEvidence, evidenceCount),
+		checkpointEvent:  make(chan *prototype.CheckpointVote, checkpointCount),
 
 		// timeout channels
 		waitSeed:     make(chan struct{}, 1),
 		finishVoting: make(chan struct{}, 1),
+		backupTurn:   make(chan uint64, 1),
 
 		// feeds
 		proposeFeed:     cfg.ProposeFeed,
@@ -499,6 +813,8 @@ func New(cliCtx *cli.Context, cfg *Config) (*Service, error) {
 		blockFeed:       cfg.BlockFeed,
 		stateFeed:       cfg.StateFeed,
 		seedFeed:        cfg.SeedFeed,
+		evidenceFeed:    cfg.EvidenceFeed,
+		checkpointFeed:  cfg.CheckpointFeed,
 
 		// engine
 		backend: engine,
@@ -506,7 +822,16 @@ func New(cliCtx *cli.Context, cfg *Config) (*Service, error) {
 		// validator loop
 		blockVoting: map[string]*voting{},
 
+		// seed
 		seedMap: map[string]*prototype.Seed{},
+		secrets: map[string]*seedSecret{},
+
+		// slashing
+		proposals: map[string]*prototype.SignedHeader{},
+		evidence:  map[string]*prototype.SlashingEvidence{},
+
+		// finality
+		checkpointVotes: map[string]*prototype.CheckpointVote{},
 	}
 
 	return srv, nil
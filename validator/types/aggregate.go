@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	"github.com/raidoNetwork/RDO_v2/shared/common"
+	"github.com/raidoNetwork/RDO_v2/shared/crypto"
+	stypes "github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+// BlockSigns returns signatures of the block votes with given type. Aggregated votes are unpacked with the given block committee,
+// blocks created before the aggregation carry votes signatures with addresses.
+func BlockSigns(block *prototype.Block, committee []string, attestType AttestationType) ([]*prototype.Sign, error) {
+	if !stypes.HasAggregatedVotes(block) {
+		if attestType == Approve {
+			return block.Approvers, nil
+		}
+
+		return block.Slashers, nil
+	}
+
+	if attestType == Approve {
+		return stypes.UnpackVotes(block.Approvals, committee)
+	}
+
+	return stypes.UnpackVotes(block.Rejections, committee)
+}
+
+// RecoverVoters returns addresses of the validators signed aggregated votes of the block
+func RecoverVoters(block *prototype.Block, votes *prototype.AggregatedVotes, attestType AttestationType) []common.Address {
+	if votes == nil {
+		return []common.Address{}
+	}
+
+	root, err := AttestationRoot(block, attestType)
+	if err != nil {
+		return []common.Address{}
+	}
+
+	voters := make([]common.Address, 0, len(votes.Signatures))
+	for _, signature := range votes.Signatures {
+		pubKey, err := crypto.SigToPub(root, signature)
+		if err != nil {
+			continue
+		}
+
+		voters = append(voters, crypto.PubkeyToAddress(*pubKey))
+	}
+
+	return voters
+}
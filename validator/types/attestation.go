@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	"github.com/pkg/errors"
 	"github.com/raidoNetwork/RDO_v2/proto/prototype"
 	"github.com/raidoNetwork/RDO_v2/shared/common"
@@ -19,11 +21,21 @@ var mixMap = map[AttestationType][]byte{
 	Reject:  {2},
 }
 
+// Attestation is the vote of the committee member for the block header. Votes are matched with blocks by the block hash.
 type Attestation struct {
 	Validator common.Address `ssz-size:"20"`
-	Block     *prototype.Block
-	Signature *prototype.Sign
-	Type      AttestationType
+	BlockHash []byte         `ssz-size:"32"`
+	Header    *prototype.SignedHeader
+}
+
+// Type returns attestation vote type
+func (a *Attestation) Type() AttestationType {
+	return AttestationType(a.Header.Vote)
+}
+
+// Signature returns attester signature
+func (a *Attestation) Signature() *prototype.Sign {
+	return a.Header.Signature
 }
 
 func NewAttestation(block *prototype.Block, proposer Proposer, attestType AttestationType) (*Attestation, error) {
@@ -42,17 +54,33 @@ func NewAttestation(block *prototype.Block, proposer Proposer, attestType Attest
 
 	return &Attestation{
 		Validator: proposer.Addr(),
-		Block:     block,
-		Signature: sign,
-		Type:      attestType,
+		BlockHash: block.Hash,
+		Header:    stypes.NewSignedHeader(block, uint32(attestType), sign),
 	}, nil
 }
 
+// AttestationRoot returns digest signed by the attester of the given block with given attestation type
+func AttestationRoot(block *prototype.Block, attestType AttestationType) ([]byte, error) {
+	if _, exists := mixMap[attestType]; !exists {
+		return nil, errors.New("Unknown attestation type")
+	}
+
+	return stypes.GetBlockSigner().SigningRoot(stypes.GetBlockHeader(block), mixMap[attestType]), nil
+}
+
 func VerifyAttestationSign(att *Attestation) error {
+	if att.Header == nil || att.Header.Signature == nil {
+		return errors.New("Attestation has no signed header")
+	}
+
+	if !bytes.Equal(att.Validator.Bytes(), att.Header.Signature.Address) {
+		return errors.New("Attestation is signed by another validator")
+	}
+
 	return VerifyBlockSign(
-		stypes.NewHeader(att.Block),
-		att.Type,
-		att.Signature,
+		stypes.SignedBlockHeader(att.Header),
+		att.Type(),
+		att.Signature(),
 	)
 }
 
@@ -68,6 +96,6 @@ func VerifyBlockSign(header *stypes.BlockHeader, att AttestationType, sign *prot
 	)
 }
 
-func VerifySeedSign(seed *prototype.Seed) error {
-	return stypes.GetSeedSigner().Verify(seed)
+func VerifySeedSign(seed *prototype.Seed, num uint64) error {
+	return stypes.GetSeedSigner().Verify(seed, num)
 }
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 39b5c876c111dcae278b58cd6e461ed838b575ae5abb0936301a1fe36caf989d
+// Hash: 5bc9dc617b9beea0ceb2965522017b5b236f867f3f1d7a93264421a8fa8d542f
 // Version: 0.1.2-dev
 package types
 
@@ -16,7 +16,7 @@ func (a *Attestation) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the Attestation object to a target array
 func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(32)
+	offset := int(56)
 
 	// Field (0) 'Validator'
 	if size := len(a.Validator); size != 20 {
@@ -25,30 +25,22 @@ func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	dst = append(dst, a.Validator...)
 
-	// Offset (1) 'Block'
-	dst = ssz.WriteOffset(dst, offset)
-	if a.Block == nil {
-		a.Block = new(prototype.Block)
+	// Field (1) 'BlockHash'
+	if size := len(a.BlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Attestation.BlockHash", size, 32)
+		return
 	}
-	offset += a.Block.SizeSSZ()
+	dst = append(dst, a.BlockHash...)
 
-	// Offset (2) 'Signature'
+	// Offset (2) 'Header'
 	dst = ssz.WriteOffset(dst, offset)
-	if a.Signature == nil {
-		a.Signature = new(prototype.Sign)
-	}
-	offset += a.Signature.SizeSSZ()
-
-	// Field (3) 'Type'
-	dst = ssz.MarshalUint32(dst, uint32(a.Type))
-
-	// Field (1) 'Block'
-	if dst, err = a.Block.MarshalSSZTo(dst); err != nil {
-		return
+	if a.Header == nil {
+		a.Header = new(prototype.SignedHeader)
 	}
+	offset += a.Header.SizeSSZ()
 
-	// Field (2) 'Signature'
-	if dst, err = a.Signature.MarshalSSZTo(dst); err != nil {
+	// Field (2) 'Header'
+	if dst, err = a.Header.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
@@ -59,12 +51,12 @@ func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 32 {
+	if size < 56 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o1, o2 uint64
+	var o2 uint64
 
 	// Field (0) 'Validator'
 	if cap(a.Validator) == 0 {
@@ -72,41 +64,28 @@ func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 	}
 	a.Validator = append(a.Validator, buf[0:20]...)
 
-	// Offset (1) 'Block'
-	if o1 = ssz.ReadOffset(buf[20:24]); o1 > size {
-		return ssz.ErrOffset
-	}
-
-	if o1 < 32 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (1) 'BlockHash'
+	if cap(a.BlockHash) == 0 {
+		a.BlockHash = make([]byte, 0, len(buf[20:52]))
 	}
+	a.BlockHash = append(a.BlockHash, buf[20:52]...)
 
-	// Offset (2) 'Signature'
-	if o2 = ssz.ReadOffset(buf[24:28]); o2 > size || o1 > o2 {
+	// Offset (2) 'Header'
+	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size {
 		return ssz.ErrOffset
 	}
 
-	// Field (3) 'Type'
-	a.Type = AttestationType(ssz.UnmarshallUint32(buf[28:32]))
-
-	// Field (1) 'Block'
-	{
-		buf = tail[o1:o2]
-		if a.Block == nil {
-			a.Block = new(prototype.Block)
-		}
-		if err = a.Block.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	if o2 < 56 {
+		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Field (2) 'Signature'
+	// Field (2) 'Header'
 	{
 		buf = tail[o2:]
-		if a.Signature == nil {
-			a.Signature = new(prototype.Sign)
+		if a.Header == nil {
+			a.Header = new(prototype.SignedHeader)
 		}
-		if err = a.Signature.UnmarshalSSZ(buf); err != nil {
+		if err = a.Header.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
@@ -115,19 +94,13 @@ func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the Attestation object
 func (a *Attestation) SizeSSZ() (size int) {
-	size = 32
-
-	// Field (1) 'Block'
-	if a.Block == nil {
-		a.Block = new(prototype.Block)
-	}
-	size += a.Block.SizeSSZ()
+	size = 56
 
-	// Field (2) 'Signature'
-	if a.Signature == nil {
-		a.Signature = new(prototype.Sign)
+	// Field (2) 'Header'
+	if a.Header == nil {
+		a.Header = new(prototype.SignedHeader)
 	}
-	size += a.Signature.SizeSSZ()
+	size += a.Header.SizeSSZ()
 
 	return
 }
@@ -148,19 +121,18 @@ func (a *Attestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	}
 	hh.PutBytes(a.Validator)
 
-	// Field (1) 'Block'
-	if err = a.Block.HashTreeRootWith(hh); err != nil {
+	// Field (1) 'BlockHash'
+	if size := len(a.BlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Attestation.BlockHash", size, 32)
 		return
 	}
+	hh.PutBytes(a.BlockHash)
 
-	// Field (2) 'Signature'
-	if err = a.Signature.HashTreeRootWith(hh); err != nil {
+	// Field (2) 'Header'
+	if err = a.Header.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (3) 'Type'
-	hh.PutUint32(uint32(a.Type))
-
 	hh.Merkleize(indx)
 	return
 }
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/raidoNetwork/RDO_v2/proto/prototype"
+	stypes "github.com/raidoNetwork/RDO_v2/shared/types"
+)
+
+var ErrNoConflict = errors.New("Signed messages don't conflict")
+
+// NewProposalEvidence creates evidence of two different block headers signed by the same proposer for the same slot
+func NewProposalEvidence(first, second *prototype.SignedHeader) (*prototype.SlashingEvidence, error) {
+	evidence := &prototype.SlashingEvidence{
+		Type:   stypes.DoubleProposal,
+		First:  first,
+		Second: second,
+	}
+
+	if err := VerifyEvidence(evidence); err != nil {
+		return nil, err
+	}
+
+	return evidence, nil
+}
+
+// NewVoteEvidence creates evidence of approve and reject of the same block signed by the same attester
+func NewVoteEvidence(first, second *Attestation) (*prototype.SlashingEvidence, error) {
+	evidence := &prototype.SlashingEvidence{
+		Type:   stypes.DoubleVote,
+		First:  first.Header,
+		Second: second.Header,
+	}
+
+	if err := VerifyEvidence(evidence); err != nil {
+		return nil, err
+	}
+
+	return evidence, nil
+}
+
+// VerifyEvidence checks that evidence contains two conflicting messages of the same validator with valid signatures
+func VerifyEvidence(evidence *prototype.SlashingEvidence) error {
+	first, second := evidence.First, evidence.Second
+	if first == nil || second == nil || first.Signature == nil || second.Signature == nil {
+		return errors.New("Evidence has no signed headers")
+	}
+
+	if !bytes.Equal(first.Signature.Address, second.Signature.Address) {
+		return errors.New("Evidence headers are signed by different validators")
+	}
+
+	if first.Slot != second.Slot {
+		return errors.New("Evidence headers have different slots")
+	}
+
+	sameHeader := stypes.SameSignedHeader(first, second)
+
+	switch evidence.Type {
+	case stypes.DoubleProposal:
+		if sameHeader {
+			return ErrNoConflict
+		}
+
+		signer := stypes.GetBlockSigner()
+		for _, sh := range []*prototype.SignedHeader{first, second} {
+			if err := signer.Verify(stypes.SignedBlockHeader(sh), sh.Signature); err != nil {
+				return errors.Wrap(err, "Bad proposal signature")
+			}
+		}
+	case stypes.DoubleVote:
+		if !sameHeader || first.Vote == second.Vote {
+			return ErrNoConflict
+		}
+
+		for _, sh := range []*prototype.SignedHeader{first, second} {
+			if err := VerifyBlockSign(stypes.SignedBlockHeader(sh), AttestationType(sh.Vote), sh.Signature); err != nil {
+				return errors.Wrap(err, "Bad vote signature")
+			}
+		}
+	default:
+		return errors.Errorf("Unknown evidence type %d", evidence.Type)
+	}
+
+	return nil
+}
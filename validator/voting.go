@@ -1,9 +1,12 @@
 package validator
 
-import "time"
+import (
+	"time"
+
+	"github.com/raidoNetwork/RDO_v2/validator/types"
+)
 
 type voting struct {
-	approved map[string]struct{}
-	rejected map[string]struct{}
-	started  time.Time
+	votes   map[string]*types.Attestation // the first vote of each validator
+	started time.Time
 }